@@ -33,11 +33,14 @@ var (
 )
 
 type Afp struct {
-	TPacket  *afpacket.TPacket
-	finished chan struct{}
-	consumer flow.Consumer
-	flows4   flow.Map4
-	flows6   flow.Map6
+	TPacket    *afpacket.TPacket
+	finished   chan struct{}
+	consumer   flow.Consumer
+	accounting flow.Accounting
+	flows4     flow.Map4
+	flows6     flow.Map6
+	frags      *frags
+	decap      bool
 }
 
 func (h *Afp) newAfpacketHandle(device string, timeout time.Duration) error {
@@ -76,9 +79,15 @@ func (h *Afp) Finalize() error {
 
 func (h *Afp) Init(consumer flow.Consumer) error {
 	h.consumer = consumer
+	var err error
+	if h.accounting, err = flow.AccountingMode(); err != nil {
+		return err
+	}
 	h.finished = make(chan struct{})
 	h.flows4 = make(flow.Map4)
 	h.flows6 = make(flow.Map6)
+	h.frags = newFrags()
+	h.decap = flow.Decap()
 	if err := h.newAfpacketHandle(*iface, time.Duration(100)*time.Millisecond); err != nil {
 		return fmt.Errorf("afpacket library initialization failed: %w", err)
 	}
@@ -108,47 +117,86 @@ func (h *Afp) Run(ctx context.Context, flush <-chan (chan<- error)) {
 		source := gopacket.ZeroCopyPacketDataSource(h)
 		parser4 := gopacket.NewDecodingLayerParser(layers.LayerTypeIPv4,
 			&ip4, &tcp, &udp)
+		// The upper layer of IPv6 is found by ipv6Upper, gopacket
+		// stops at the first extension header it does not know.
 		parser6 := gopacket.NewDecodingLayerParser(layers.LayerTypeIPv6,
-			&ip6, &tcp, &udp)
+			&ip6)
 		parser4.IgnoreUnsupported = true
 		parser6.IgnoreUnsupported = true
 		decoded := make([]gopacket.LayerType, 0, 10)
 		for {
 			data, _, err = source.ZeroCopyReadPacketData()
 			if err == nil {
+				// For tunneled packets, the outer IP packet is
+				// counted and the headers before the inner IP
+				// header are outerHdr bytes long.
+				var (
+					tun      flow.Tunnel
+					outerLen int
+					outerHdr int
+				)
+				if h.decap {
+					if inner, t, l, hdr, ok := decap(data); ok {
+						data, tun, outerLen, outerHdr = inner, t, l, hdr
+					}
+				}
 				err := parser4.DecodeLayers(data, &decoded)
 				if err == nil && hasLayer(decoded, layers.LayerTypeIPv4) {
 					s := flow.Sample4{
-						Proto: uint8(ip4.Protocol),
+						Proto:  uint8(ip4.Protocol),
+						Tunnel: tun,
 					}
 					copy(s.SrcIP[:4], ip4.SrcIP.To4())
 					copy(s.DstIP[:4], ip4.DstIP.To4())
-					if hasLayer(decoded, layers.LayerTypeTCP) {
+					l4HdrLen := 0
+					fragment := ip4.Flags&layers.IPv4MoreFragments != 0 ||
+						ip4.FragOffset != 0
+					if fragment && (ip4.Protocol == layers.IPProtocolTCP ||
+						ip4.Protocol == layers.IPProtocolUDP ||
+						ip4.Protocol == layers.IPProtocolICMPv4) {
+						s.SrcPort, s.DstPort, l4HdrLen = h.frags.ports(&ip4)
+					} else if ip4.Protocol == layers.IPProtocolICMPv4 {
+						s.SrcPort, s.DstPort, _, _ = ports(ip4.Protocol, ip4.Payload)
+					} else if hasLayer(decoded, layers.LayerTypeTCP) {
 						s.SrcPort = uint16(tcp.SrcPort)
 						s.DstPort = uint16(tcp.DstPort)
+						l4HdrLen = int(tcp.DataOffset) * 4
 					} else if hasLayer(decoded, layers.LayerTypeUDP) {
 						s.SrcPort = uint16(udp.SrcPort)
 						s.DstPort = uint16(udp.DstPort)
+						l4HdrLen = 8
 					}
-					h.flows4[s] += uint64(len(data))
+					ipLen := int(ip4.Length)
+					if tun.Type != flow.TunnelNone {
+						ipLen = outerLen
+					}
+					h.flows4[s] += h.accounting.Bytes(ipLen,
+						outerHdr+int(ip4.IHL)*4, l4HdrLen)
 				} else {
 					err := parser6.DecodeLayers(data, &decoded)
 					if err == nil && hasLayer(decoded, layers.LayerTypeIPv6) {
+						next := data[ip6HdrLen:]
+						proto, off, frag := ipv6Upper(ip6.NextHeader, next)
 						s := flow.Sample6{
-							Proto: uint8(ip6.NextHeader),
+							Proto:  uint8(proto),
+							Tunnel: tun,
 						}
 						copy(s.SrcIP[:16], ip6.SrcIP)
 						copy(s.DstIP[:16], ip6.DstIP)
-						if hasLayer(decoded, layers.LayerTypeTCP) {
-							s.SrcPort = uint16(tcp.SrcPort)
-							s.DstPort = uint16(tcp.DstPort)
-							s.Proto = 6
-						} else if hasLayer(decoded, layers.LayerTypeUDP) {
-							s.SrcPort = uint16(udp.SrcPort)
-							s.DstPort = uint16(udp.DstPort)
-							s.Proto = 17
+						l4HdrLen := 0
+						if !frag && off <= len(next) {
+							if src, dst, n, ok := ports(proto, next[off:]); ok {
+								s.SrcPort = src
+								s.DstPort = dst
+								l4HdrLen = n
+							}
+						}
+						ipLen := int(ip6.Length) + ip6HdrLen
+						if tun.Type != flow.TunnelNone {
+							ipLen = outerLen
 						}
-						h.flows6[s] += uint64(len(data))
+						h.flows6[s] += h.accounting.Bytes(ipLen,
+							outerHdr+ip6HdrLen+off, l4HdrLen)
 					}
 				}
 			}
@@ -169,7 +217,9 @@ func (h *Afp) Run(ctx context.Context, flush <-chan (chan<- error)) {
 			case chErr := <-flush:
 				chErr <- h.consumer.Push(time.Now(),
 					nil, h.flows4,
-					nil, h.flows6)
+					nil, h.flows6,
+					flow.Stats{UnmatchedFrags: h.frags.unmatched})
+				h.frags.unmatched = 0
 				h.flows4 = make(flow.Map4)
 				h.flows6 = make(flow.Map6)
 			default:
@@ -179,6 +229,12 @@ func (h *Afp) Run(ctx context.Context, flush <-chan (chan<- error)) {
 	}()
 }
 
+// TracksFragments tells that IPv4 fragments are accounted to the
+// ports of their datagram.
+func (h *Afp) TracksFragments() bool {
+	return true
+}
+
 func New() *Afp {
 	return &Afp{}
 }
@@ -0,0 +1,89 @@
+package afp
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"container/list"
+
+	"github.com/google/gopacket/layers"
+)
+
+// Datagrams whose ports are remembered, like FRAG_ENTRIES of ebpf3.
+const fragEntries = 1024
+
+// fragKey identifies a fragmented IPv4 datagram.
+type fragKey struct {
+	src, dst [4]byte
+	id       uint16
+	proto    layers.IPProtocol
+}
+
+type fragPorts struct {
+	key      fragKey
+	src, dst uint16
+}
+
+// frags remembers the ports of the first fragment of the most recent
+// fragmented datagrams, so that the other fragments are accounted to
+// the same flow.
+type frags struct {
+	lru  *list.List
+	byID map[fragKey]*list.Element
+	// unmatched counts the fragments whose first fragment was not
+	// seen.
+	unmatched uint64
+}
+
+func newFrags() *frags {
+	return &frags{
+		lru:  list.New(),
+		byID: make(map[fragKey]*list.Element),
+	}
+}
+
+// ports returns the ports of fragment ip, a TCP, UDP or ICMP one, and
+// the length of its L4 header: only the first fragment has it.
+func (f *frags) ports(ip *layers.IPv4) (src, dst uint16, hdrLen int) {
+	k := fragKey{id: ip.Id, proto: ip.Protocol}
+	copy(k.src[:], ip.SrcIP.To4())
+	copy(k.dst[:], ip.DstIP.To4())
+	if ip.FragOffset == 0 {
+		var ok bool
+		if src, dst, hdrLen, ok = ports(ip.Protocol, ip.Payload); !ok {
+			return 0, 0, 0
+		}
+		if e, ok := f.byID[k]; ok {
+			f.lru.MoveToFront(e)
+			p := e.Value.(*fragPorts)
+			p.src, p.dst = src, dst
+			return src, dst, hdrLen
+		}
+		if f.lru.Len() >= fragEntries {
+			e := f.lru.Back()
+			delete(f.byID, e.Value.(*fragPorts).key)
+			f.lru.Remove(e)
+		}
+		f.byID[k] = f.lru.PushFront(&fragPorts{key: k, src: src, dst: dst})
+		return src, dst, hdrLen
+	}
+	e, ok := f.byID[k]
+	if !ok {
+		f.unmatched++
+		return 0, 0, 0
+	}
+	f.lru.MoveToFront(e)
+	p := e.Value.(*fragPorts)
+	return p.src, p.dst, 0
+}
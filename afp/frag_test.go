@@ -0,0 +1,123 @@
+package afp
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/gopacket/layers"
+)
+
+// fragment returns fragment off, in 8 bytes units, of UDP datagram id
+// from src.
+func fragment(src net.IP, id uint16, off uint16, payload []byte) *layers.IPv4 {
+	return &layers.IPv4{
+		BaseLayer:  layers.BaseLayer{Payload: payload},
+		SrcIP:      src,
+		DstIP:      net.IPv4(10, 0, 0, 2),
+		Id:         id,
+		Protocol:   layers.IPProtocolUDP,
+		FragOffset: off,
+	}
+}
+
+func TestFragsPorts(t *testing.T) {
+	udp := []byte{0x00, 0x35, 0xd4, 0x31, 0, 8, 0, 0}
+	src := net.IPv4(10, 0, 0, 1)
+	type step struct {
+		ip       *layers.IPv4
+		src, dst uint16
+		hdrLen   int
+	}
+	tests := []struct {
+		name      string
+		steps     []step
+		unmatched uint64
+	}{
+		{"first then later", []step{
+			{fragment(src, 1, 0, udp), 53, 54321, 8},
+			{fragment(src, 1, 185, []byte{1, 2, 3}), 53, 54321, 0},
+			{fragment(src, 1, 370, []byte{1, 2, 3}), 53, 54321, 0},
+		}, 0},
+		{"later without first", []step{
+			{fragment(src, 1, 185, []byte{1, 2, 3}), 0, 0, 0},
+			{fragment(src, 2, 185, []byte{1, 2, 3}), 0, 0, 0},
+		}, 2},
+		{"other datagram", []step{
+			{fragment(src, 1, 0, udp), 53, 54321, 8},
+			{fragment(src, 2, 185, []byte{1, 2, 3}), 0, 0, 0},
+			{fragment(net.IPv4(10, 0, 0, 3), 1, 185, []byte{1, 2, 3}), 0, 0, 0},
+		}, 2},
+		{"truncated first", []step{
+			{fragment(src, 1, 0, udp[:4]), 0, 0, 0},
+			{fragment(src, 1, 185, []byte{1, 2, 3}), 0, 0, 0},
+		}, 1},
+		{"first again", []step{
+			{fragment(src, 1, 0, udp), 53, 54321, 8},
+			{fragment(src, 1, 0, []byte{0, 1, 0, 2, 0, 8, 0, 0}), 1, 2, 8},
+			{fragment(src, 1, 185, []byte{1, 2, 3}), 1, 2, 0},
+		}, 0},
+	}
+	for _, tt := range tests {
+		f := newFrags()
+		for i, s := range tt.steps {
+			src, dst, hdrLen := f.ports(s.ip)
+			if src != s.src || dst != s.dst || hdrLen != s.hdrLen {
+				t.Errorf("%s: step %d: ports() = %d, %d, %d, want %d, %d, %d", tt.name, i,
+					src, dst, hdrLen, s.src, s.dst, s.hdrLen)
+			}
+		}
+		if f.unmatched != tt.unmatched {
+			t.Errorf("%s: unmatched = %d, want %d", tt.name, f.unmatched, tt.unmatched)
+		}
+	}
+}
+
+func TestFragsEviction(t *testing.T) {
+	udp := []byte{0x00, 0x35, 0xd4, 0x31, 0, 8, 0, 0}
+	src := net.IPv4(10, 0, 0, 1)
+	later := []byte{1, 2, 3}
+	f := newFrags()
+	for id := 0; id < fragEntries; id++ {
+		f.ports(fragment(src, uint16(id), 0, udp))
+	}
+	// Datagram 0 becomes the most recent, 1 is evicted by the next.
+	if sp, _, _ := f.ports(fragment(src, 0, 185, later)); sp != 53 {
+		t.Errorf("datagram 0 not found before eviction")
+	}
+	f.ports(fragment(src, fragEntries, 0, udp))
+	if f.lru.Len() != fragEntries || len(f.byID) != fragEntries {
+		t.Errorf("%d entries, %d in index, want %d", f.lru.Len(), len(f.byID), fragEntries)
+	}
+	tests := []struct {
+		id    uint16
+		found bool
+	}{
+		{0, true},
+		{1, false},
+		{2, true},
+		{fragEntries, true},
+	}
+	for _, tt := range tests {
+		sp, _, _ := f.ports(fragment(src, tt.id, 185, later))
+		if found := sp == 53; found != tt.found {
+			t.Errorf("datagram %d: found %t, want %t", tt.id, found, tt.found)
+		}
+	}
+	if f.unmatched != 1 {
+		t.Errorf("unmatched = %d, want 1", f.unmatched)
+	}
+}
@@ -0,0 +1,69 @@
+package afp
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"encoding/binary"
+
+	"github.com/google/gopacket/layers"
+)
+
+const (
+	// Size of the fixed IPv6 header.
+	ip6HdrLen = 40
+	// Extension headers walked at most, like the eBPF producers do.
+	maxExtHdrs = 6
+)
+
+// ipv6Upper walks the extension headers in b, the packet after the
+// fixed IPv6 header, starting with next. It returns the upper layer
+// protocol, its offset in b and whether the packet is a fragment,
+// whose upper layer header may be missing.
+func ipv6Upper(next layers.IPProtocol, b []byte) (layers.IPProtocol, int, bool) {
+	off := 0
+	for i := 0; i < maxExtHdrs && off+2 <= len(b); i++ {
+		switch next {
+		case layers.IPProtocolIPv6HopByHop, layers.IPProtocolIPv6Routing,
+			layers.IPProtocolIPv6Destination:
+			next, off = layers.IPProtocol(b[off]), off+(int(b[off+1])+1)*8
+		case layers.IPProtocolAH:
+			next, off = layers.IPProtocol(b[off]), off+(int(b[off+1])+2)*4
+		case layers.IPProtocolIPv6Fragment:
+			return layers.IPProtocol(b[off]), off + 8, true
+		default:
+			return next, off, false
+		}
+	}
+	return next, off, false
+}
+
+// ports returns the ports and the header length of the TCP or UDP
+// header at the start of b, ok is false if it is truncated. ICMP and
+// ICMPv6 have no ports: like NetFlow, the type and code are returned
+// as destination port and the header is accounted as payload.
+func ports(proto layers.IPProtocol, b []byte) (src, dst uint16, hdrLen int, ok bool) {
+	switch {
+	case proto == layers.IPProtocolTCP && len(b) >= 20:
+		hdrLen = int(b[12]>>4) * 4
+	case proto == layers.IPProtocolUDP && len(b) >= 8:
+		hdrLen = 8
+	case (proto == layers.IPProtocolICMPv4 || proto == layers.IPProtocolICMPv6) &&
+		len(b) >= 2:
+		return 0, binary.BigEndian.Uint16(b), 0, true
+	default:
+		return 0, 0, 0, false
+	}
+	return binary.BigEndian.Uint16(b), binary.BigEndian.Uint16(b[2:]), hdrLen, true
+}
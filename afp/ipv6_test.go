@@ -0,0 +1,121 @@
+package afp
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"testing"
+
+	"github.com/google/gopacket/layers"
+)
+
+// ext returns an extension header of n bytes followed by next, with
+// the length field as counted by the header type.
+func ext(next layers.IPProtocol, hdrLen byte, n int) []byte {
+	b := make([]byte, n)
+	b[0], b[1] = byte(next), hdrLen
+	return b
+}
+
+func cat(bs ...[]byte) []byte {
+	var r []byte
+	for _, b := range bs {
+		r = append(r, b...)
+	}
+	return r
+}
+
+func TestIPv6Upper(t *testing.T) {
+	tcp := make([]byte, 20)
+	var hops []byte
+	for i := 0; i < maxExtHdrs+1; i++ {
+		hops = append(hops, ext(layers.IPProtocolIPv6HopByHop, 0, 8)...)
+	}
+	tests := []struct {
+		name     string
+		next     layers.IPProtocol
+		b        []byte
+		wantNext layers.IPProtocol
+		wantOff  int
+		wantFrag bool
+	}{
+		{"no extension", layers.IPProtocolTCP, tcp, layers.IPProtocolTCP, 0, false},
+		{"hop-by-hop", layers.IPProtocolIPv6HopByHop,
+			cat(ext(layers.IPProtocolTCP, 0, 8), tcp), layers.IPProtocolTCP, 8, false},
+		{"routing", layers.IPProtocolIPv6Routing,
+			cat(ext(layers.IPProtocolUDP, 2, 24), tcp), layers.IPProtocolUDP, 24, false},
+		{"destination options", layers.IPProtocolIPv6Destination,
+			cat(ext(layers.IPProtocolTCP, 1, 16), tcp), layers.IPProtocolTCP, 16, false},
+		// The length of AH is in 4 bytes units, minus 2.
+		{"authentication", layers.IPProtocolAH,
+			cat(ext(layers.IPProtocolTCP, 4, 24), tcp), layers.IPProtocolTCP, 24, false},
+		{"fragment", layers.IPProtocolIPv6Fragment,
+			cat(ext(layers.IPProtocolUDP, 0, 8), tcp), layers.IPProtocolUDP, 8, true},
+		{"chain", layers.IPProtocolIPv6HopByHop,
+			cat(ext(layers.IPProtocolIPv6Routing, 0, 8), ext(layers.IPProtocolAH, 0, 8),
+				ext(layers.IPProtocolIPv6Destination, 1, 12), ext(layers.IPProtocolTCP, 0, 8), tcp),
+			layers.IPProtocolTCP, 36, false},
+		{"fragment after hop-by-hop", layers.IPProtocolIPv6HopByHop,
+			cat(ext(layers.IPProtocolIPv6Fragment, 0, 8), ext(layers.IPProtocolTCP, 0, 8)),
+			layers.IPProtocolTCP, 16, true},
+		{"too many headers", layers.IPProtocolIPv6HopByHop, cat(hops, tcp),
+			layers.IPProtocolIPv6HopByHop, maxExtHdrs * 8, false},
+		{"empty", layers.IPProtocolIPv6HopByHop, nil, layers.IPProtocolIPv6HopByHop, 0, false},
+		{"truncated header", layers.IPProtocolIPv6HopByHop, []byte{byte(layers.IPProtocolTCP)},
+			layers.IPProtocolIPv6HopByHop, 0, false},
+		// The offset of the upper layer can be past the end, ports
+		// then finds it truncated.
+		{"truncated after header", layers.IPProtocolIPv6Routing, ext(layers.IPProtocolTCP, 1, 8),
+			layers.IPProtocolTCP, 16, false},
+	}
+	for _, tt := range tests {
+		next, off, frag := ipv6Upper(tt.next, tt.b)
+		if next != tt.wantNext || off != tt.wantOff || frag != tt.wantFrag {
+			t.Errorf("%s: ipv6Upper() = %v, %d, %t, want %v, %d, %t", tt.name,
+				next, off, frag, tt.wantNext, tt.wantOff, tt.wantFrag)
+		}
+	}
+}
+
+func TestPorts(t *testing.T) {
+	tcp := make([]byte, 32)
+	copy(tcp, []byte{0x1f, 0x90, 0xc3, 0x50})
+	tcp[12] = 8 << 4
+	udp := []byte{0x00, 0x35, 0xd4, 0x31, 0, 8, 0, 0}
+	tests := []struct {
+		name     string
+		proto    layers.IPProtocol
+		b        []byte
+		src, dst uint16
+		hdrLen   int
+		ok       bool
+	}{
+		{"tcp", layers.IPProtocolTCP, tcp, 8080, 50000, 32, true},
+		{"tcp truncated", layers.IPProtocolTCP, tcp[:19], 0, 0, 0, false},
+		{"udp", layers.IPProtocolUDP, udp, 53, 54321, 8, true},
+		{"udp truncated", layers.IPProtocolUDP, udp[:7], 0, 0, 0, false},
+		{"icmp", layers.IPProtocolICMPv4, []byte{8, 0, 0xf7, 0xff}, 0, 0x0800, 0, true},
+		{"icmpv6", layers.IPProtocolICMPv6, []byte{1, 4}, 0, 0x0104, 0, true},
+		{"icmp truncated", layers.IPProtocolICMPv4, []byte{8}, 0, 0, 0, false},
+		{"no ports", layers.IPProtocolGRE, tcp, 0, 0, 0, false},
+		{"empty", layers.IPProtocolTCP, nil, 0, 0, 0, false},
+	}
+	for _, tt := range tests {
+		src, dst, hdrLen, ok := ports(tt.proto, tt.b)
+		if src != tt.src || dst != tt.dst || hdrLen != tt.hdrLen || ok != tt.ok {
+			t.Errorf("%s: ports() = %d, %d, %d, %t, want %d, %d, %d, %t", tt.name,
+				src, dst, hdrLen, ok, tt.src, tt.dst, tt.hdrLen, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package afp
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"encoding/binary"
+
+	"github.com/chripell/flowsnoop/flow"
+	"github.com/google/gopacket/layers"
+)
+
+// GRE flags and version.
+const (
+	greCsum    = 0x8000
+	greKey     = 0x2000
+	greSeq     = 0x1000
+	greVersion = 0x0007
+)
+
+// decap looks for a tunnel in the IP packet b, like the decap function
+// of ebpf3. If b is VXLAN, Geneve, GRE or IPIP carrying IP it returns
+// the inner IP packet, the tunnel, the length of the outer IP packet
+// and of the headers before the inner one, with ok set.
+func decap(b []byte) (inner []byte, tun flow.Tunnel, outerLen, outerHdr int, ok bool) {
+	var (
+		next layers.IPProtocol
+		off  int
+	)
+	if len(b) < 1 {
+		return
+	}
+	switch b[0] >> 4 {
+	case 4:
+		if len(b) < 20 {
+			return
+		}
+		// A fragment, the tunnel header may be missing.
+		if binary.BigEndian.Uint16(b[6:8])&0x3fff != 0 {
+			return
+		}
+		next = layers.IPProtocol(b[9])
+		outerLen = int(binary.BigEndian.Uint16(b[2:4]))
+		tun.Src = flow.MapIPv4(b[12:16])
+		tun.Dst = flow.MapIPv4(b[16:20])
+		off = int(b[0]&0x0f) * 4
+	case 6:
+		if len(b) < ip6HdrLen {
+			return
+		}
+		next = layers.IPProtocol(b[6])
+		outerLen = int(binary.BigEndian.Uint16(b[4:6])) + ip6HdrLen
+		copy(tun.Src[:], b[8:24])
+		copy(tun.Dst[:], b[24:40])
+		off = ip6HdrLen
+	default:
+		return
+	}
+	var proto layers.EthernetType
+	switch next {
+	case layers.IPProtocolUDP:
+		if len(b) < off+8 {
+			return
+		}
+		port := binary.BigEndian.Uint16(b[off+2:])
+		off += 8
+		if len(b) < off+8 {
+			return
+		}
+		switch port {
+		case flow.VXLANPort:
+			tun.Type = flow.TunnelVXLAN
+			tun.ID = binary.BigEndian.Uint32(b[off+4:]) >> 8
+			proto = layers.EthernetTypeTransparentEthernetBridging
+			off += 8
+		case flow.GenevePort:
+			tun.Type = flow.TunnelGeneve
+			tun.ID = binary.BigEndian.Uint32(b[off+4:]) >> 8
+			proto = layers.EthernetType(binary.BigEndian.Uint16(b[off+2:]))
+			off += 8 + int(b[off]&0x3f)*4
+		default:
+			return
+		}
+	case layers.IPProtocolGRE:
+		if len(b) < off+4 {
+			return
+		}
+		flags := binary.BigEndian.Uint16(b[off:])
+		// Version 1 is PPTP, not a tunnel of IP.
+		if flags&greVersion != 0 {
+			return
+		}
+		tun.Type = flow.TunnelGRE
+		proto = layers.EthernetType(binary.BigEndian.Uint16(b[off+2:]))
+		off += 4
+		if flags&greCsum != 0 {
+			off += 4
+		}
+		if flags&greKey != 0 {
+			if len(b) < off+4 {
+				return
+			}
+			tun.ID = binary.BigEndian.Uint32(b[off:])
+			off += 4
+		}
+		if flags&greSeq != 0 {
+			off += 4
+		}
+	case layers.IPProtocolIPv4:
+		tun.Type = flow.TunnelIPIP
+		proto = layers.EthernetTypeIPv4
+	case layers.IPProtocolIPv6:
+		tun.Type = flow.TunnelIPIP
+		proto = layers.EthernetTypeIPv6
+	default:
+		return
+	}
+	if proto == layers.EthernetTypeTransparentEthernetBridging {
+		if len(b) < off+14 {
+			return
+		}
+		proto = layers.EthernetType(binary.BigEndian.Uint16(b[off+12:]))
+		off += 14
+	}
+	if (proto != layers.EthernetTypeIPv4 && proto != layers.EthernetTypeIPv6) ||
+		len(b) < off {
+		return
+	}
+	return b[off:], tun, outerLen, off, true
+}
@@ -0,0 +1,284 @@
+// Package cgroups tells which systemd unit, container or Kubernetes
+// pod a cgroup v2 or a process belongs to.
+package cgroups
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Kind is the kind of a Service.
+type Kind uint8
+
+const (
+	// KindUnknown is for cgroups that are none of the others, the
+	// name is their path.
+	KindUnknown Kind = iota
+	// KindSystemd is a systemd service or scope, the name is the
+	// unit.
+	KindSystemd
+	// KindDocker is a Docker container, the name is its ID.
+	KindDocker
+	// KindContainerd is a containerd container, the name is its ID.
+	KindContainerd
+	// KindPod is a Kubernetes pod, the name is its UID. It wins
+	// over the containers of the pod.
+	KindPod
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindUnknown:
+		return "cgroup"
+	case KindSystemd:
+		return "unit"
+	case KindDocker:
+		return "docker"
+	case KindContainerd:
+		return "containerd"
+	case KindPod:
+		return "pod"
+	}
+	return fmt.Sprintf("kind(%d)", uint8(k))
+}
+
+// Service is what a cgroup belongs to.
+type Service struct {
+	Kind Kind
+	Name string
+}
+
+// String returns something like "unit nginx.service".
+func (s Service) String() string {
+	return s.Kind.String() + " " + s.Name
+}
+
+var (
+	// Kubernetes pods, with the systemd cgroup driver
+	// (kubepods-burstable-pod<uid>.slice, dashes of the UID
+	// replaced by underscores) or the cgroupfs one (pod<uid>).
+	podRe = regexp.MustCompile(`^(?:kubepods-.*-)?pod([0-9a-f_-]{36})(?:\.slice)?$`)
+	// Docker containers: docker-<id>.scope or docker/<id>.
+	dockerRe = regexp.MustCompile(`^docker-([0-9a-f]{64})\.scope$`)
+	// containerd containers: cri-containerd-<id>.scope.
+	containerdRe = regexp.MustCompile(`^cri-containerd-([0-9a-f]{64})\.scope$`)
+	containerID  = regexp.MustCompile(`^[0-9a-f]{64}$`)
+)
+
+// Classify returns the service of the cgroup at path, relative to
+// the root of the hierarchy, from the names of its directories. A pod
+// wins over everything, otherwise the innermost container or systemd
+// unit does.
+func Classify(path string) Service {
+	dirs := strings.Split(strings.Trim(path, "/"), "/")
+	for _, d := range dirs {
+		if m := podRe.FindStringSubmatch(d); m != nil {
+			return Service{KindPod, strings.Replace(m[1], "_", "-", -1)}
+		}
+	}
+	for i := len(dirs) - 1; i >= 0; i-- {
+		d := dirs[i]
+		if m := dockerRe.FindStringSubmatch(d); m != nil {
+			return Service{KindDocker, m[1]}
+		}
+		if m := containerdRe.FindStringSubmatch(d); m != nil {
+			return Service{KindContainerd, m[1]}
+		}
+		if i > 0 && dirs[i-1] == "docker" && containerID.MatchString(d) {
+			return Service{KindDocker, d}
+		}
+		if strings.HasSuffix(d, ".service") || strings.HasSuffix(d, ".scope") {
+			return Service{KindSystemd, d}
+		}
+	}
+	return Service{KindUnknown, "/" + strings.Join(dirs, "/")}
+}
+
+// Rescans of the hierarchy for unknown cgroups are at least
+// minRescan apart, and they happen at least every maxAge to drop the
+// cgroups and processes that are gone.
+const (
+	minRescan = time.Second
+	maxAge    = 30 * time.Second
+)
+
+// Resolver maps cgroup IDs and pids to their Service. The ID of a
+// cgroup v2 is the inode number of its directory: the resolver walks
+// the hierarchy to find it and caches what it found until the cgroup
+// disappears.
+type Resolver struct {
+	root string
+	proc string
+	mu   sync.Mutex
+	// ids are the cgroups found by the last scan, by ID, with
+	// their path.
+	ids map[uint64]string
+	// pids are the cgroup paths of the processes seen since the
+	// last scan.
+	pids     map[uint32]string
+	services map[string]Service
+	scanned  time.Time
+}
+
+// NewResolver returns a resolver for the cgroup v2 hierarchy mounted
+// at root and the processes in proc. The hierarchy of a hybrid setup
+// is found in the unified directory of root.
+func NewResolver(root, proc string) *Resolver {
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		if _, err := os.Stat(filepath.Join(root, "unified", "cgroup.controllers")); err == nil {
+			root = filepath.Join(root, "unified")
+		}
+	}
+	return &Resolver{
+		root:     root,
+		proc:     proc,
+		ids:      make(map[uint64]string),
+		pids:     make(map[uint32]string),
+		services: make(map[string]Service),
+	}
+}
+
+// New returns a resolver for the hierarchy in /sys/fs/cgroup.
+func New() *Resolver {
+	return NewResolver("/sys/fs/cgroup", "/proc")
+}
+
+// scan walks the hierarchy from scratch. The services of the cgroups
+// gone are dropped and the processes are forgotten, so that the ones
+// gone or moved are looked up again.
+func (r *Resolver) scan() {
+	ids := make(map[uint64]string, len(r.ids))
+	filepath.Walk(r.root, func(path string, fi os.FileInfo, err error) error {
+		// Cgroups vanish while we walk, skip them.
+		if err != nil || !fi.IsDir() {
+			return nil
+		}
+		st, ok := fi.Sys().(*syscall.Stat_t)
+		if !ok {
+			return nil
+		}
+		rel, err := filepath.Rel(r.root, path)
+		if err != nil {
+			return nil
+		}
+		ids[st.Ino] = "/" + strings.TrimPrefix(rel, ".")
+		return nil
+	})
+	r.ids = ids
+	live := make(map[string]bool, len(ids))
+	for _, path := range ids {
+		live[path] = true
+	}
+	for path := range r.services {
+		if !live[path] {
+			delete(r.services, path)
+		}
+	}
+	r.pids = make(map[uint32]string)
+	r.scanned = time.Now()
+}
+
+// expire rescans if the last scan is older than maxAge, or older
+// than minRescan if force is set.
+func (r *Resolver) expire(force bool) bool {
+	age := time.Since(r.scanned)
+	if age < minRescan || (!force && age < maxAge) {
+		return false
+	}
+	r.scan()
+	return true
+}
+
+func (r *Resolver) service(path string) Service {
+	s, ok := r.services[path]
+	if !ok {
+		s = Classify(path)
+		r.services[path] = s
+	}
+	return s
+}
+
+// Cgroup returns the service of the cgroup with ID id.
+func (r *Resolver) Cgroup(id uint64) (Service, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.expire(false)
+	path, ok := r.ids[id]
+	if !ok && r.expire(true) {
+		path, ok = r.ids[id]
+	}
+	if !ok {
+		return Service{}, false
+	}
+	return r.service(path), true
+}
+
+// cgroupOf reads the cgroup v2 of process pid, the line starting
+// with 0:: of its cgroup file.
+func (r *Resolver) cgroupOf(pid uint32) (string, error) {
+	b, err := ioutil.ReadFile(filepath.Join(r.proc, strconv.FormatUint(uint64(pid), 10), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		if l := sc.Text(); strings.HasPrefix(l, "0::") {
+			return l[3:], nil
+		}
+	}
+	return "", fmt.Errorf("process %d is in no cgroup v2", pid)
+}
+
+// PID returns the service of process pid.
+func (r *Resolver) PID(pid uint32) (Service, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.expire(false)
+	path, ok := r.pids[pid]
+	if !ok {
+		var err error
+		if path, err = r.cgroupOf(pid); err != nil {
+			return Service{}, false
+		}
+		r.pids[pid] = path
+	}
+	return r.service(path), true
+}
+
+// Resolve returns the service of a flow from its cgroup ID, which
+// outlives the process, or from its pid.
+func (r *Resolver) Resolve(cgroup uint64, pid uint32) (Service, bool) {
+	if cgroup != 0 {
+		if s, ok := r.Cgroup(cgroup); ok {
+			return s, true
+		}
+	}
+	if pid != 0 {
+		return r.PID(pid)
+	}
+	return Service{}, false
+}
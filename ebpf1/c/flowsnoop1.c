@@ -19,6 +19,48 @@
 #include <net/sock.h>
 #include <bcc/proto.h>
 
+/* Keep in sync with flow.Accounting. */
+#define ACCOUNT_L3 0
+#define ACCOUNT_L2 1
+#define ACCOUNT_PAYLOAD 2
+#define ETH_HLEN 14
+
+/* Keep in sync with flow.SampleMode. */
+#define SAMPLE_RANDOM 0
+#define SAMPLE_DETERMINISTIC 1
+
+/*
+ * Overflow counters, OVERFLOW_FIELDS for each generation and IP
+ * version. Keep in sync with flow.OverflowCounter.
+ */
+#define OVERFLOW_BYTES 0
+#define OVERFLOW_PACKETS 1
+#define OVERFLOW_FLOWS 2
+#define OVERFLOW_FIELDS 3
+#define OVERFLOW_COUNTERS (2 * 2 * OVERFLOW_FIELDS)
+
+/* Recently lost flows remembered to count distinct ones. */
+#define LOST_ENTRIES 1024
+
+/* IPv6 extension headers, walked up to MAX_EXT_HDRS of them. */
+#define NEXTHDR_HOP 0
+#define NEXTHDR_ROUTING 43
+#define NEXTHDR_FRAGMENT 44
+#define NEXTHDR_AUTH 51
+#define NEXTHDR_DEST 60
+#define MAX_EXT_HDRS 6
+
+/*
+ * Sequence number of the generation of maps in use, bit 0 selects the
+ * maps. Incremented by the Go side at every switch.
+ */
+BPF_ARRAY(flowsnoop_switch, u32, 1);
+/*
+ * Number of programs running on each generation of the maps. The Go
+ * side reads a generation only once its counter is zero.
+ */
+BPF_ARRAY(active, u64, 2);
+
 struct conn_s{
   u32 src_ip;
   u32 dst_ip;
@@ -27,6 +69,7 @@ struct conn_s{
   u8 protocol;
 };
 BPF_HISTOGRAM(connections, struct conn_s, BUCKETS);
+BPF_HISTOGRAM(bconnections, struct conn_s, BUCKETS);
 
 struct conn6_s{
   u8 src_ip[16];
@@ -36,6 +79,45 @@ struct conn6_s{
   u8 protocol;
 };
 BPF_HISTOGRAM(connections6, struct conn6_s, BUCKETS);
+BPF_HISTOGRAM(bconnections6, struct conn6_s, BUCKETS);
+
+/* Flows lost in a generation, the value is unused. */
+struct lost4_s {
+  struct conn_s conn;
+  u32 seq;
+};
+BPF_TABLE("lru_hash", struct lost4_s, u8, lost4, LOST_ENTRIES);
+
+struct lost6_s {
+  struct conn6_s conn;
+  u32 seq;
+};
+BPF_TABLE("lru_hash", struct lost6_s, u8, lost6, LOST_ENTRIES);
+
+/* Shared among CPUs like active. */
+BPF_ARRAY(overflow, u64, OVERFLOW_COUNTERS);
+
+/* Packets seen by each CPU, for deterministic sampling. */
+BPF_PERCPU_ARRAY(sample, u64, 1);
+
+/*
+ * Adds len bytes to flow key of table, evaluates to -1 if the table
+ * is full. If another CPU adds the flow first, insert fails but the
+ * second lookup succeeds.
+ */
+#define ADD_FLOW(table, key, len) ({			\
+  int ret = 0;						\
+  u64 nval = len;					\
+  u64 *oval = table.lookup(key);			\
+  if (!oval && table.insert(key, &nval) != 0) {		\
+    oval = table.lookup(key);				\
+    if (!oval)						\
+      ret = -1;						\
+  }							\
+  if (oval)						\
+    lock_xadd(oval, len);				\
+  ret;							\
+})
 
 static inline struct tcphdr *skb_to_tcphdr(const struct sk_buff *skb)
 {
@@ -55,48 +137,224 @@ static inline struct ipv6hdr *skb_to_ipv6hdr(const struct sk_buff *skb)
   return (struct ipv6hdr *)(skb->head + skb->network_header);
 }
 
-static int do_count4(struct sk_buff *skb, int len) {
+/*
+ * Marks a program as busy on the current generation of the maps and
+ * returns its sequence number, with the counter to decrement when
+ * done in cnt. The switch is read again after the increment: if it
+ * moved, the Go side may have already seen the counter at zero, so we
+ * move to the new generation. It won't switch again before draining
+ * it.
+ */
+static u32 enter_gen(u64 **cnt) {
+  int zero = 0;
+  u32 seq, gen;
+  u32 *sw = flowsnoop_switch.lookup(&zero);
+  u64 *c;
+  *cnt = 0;
+  if (!sw)
+    return 0;
+  seq = *(volatile u32 *)sw;
+  gen = seq & 1;
+  c = active.lookup(&gen);
+  if (!c)
+    return seq;
+  __sync_fetch_and_add(c, 1);
+  if ((*(volatile u32 *)sw & 1) != gen) {
+    __sync_fetch_and_add(c, -1);
+    seq = *(volatile u32 *)sw;
+    gen = seq & 1;
+    c = active.lookup(&gen);
+    if (!c)
+      return seq;
+    __sync_fetch_and_add(c, 1);
+  }
+  *cnt = c;
+  return seq;
+}
+
+/*
+ * Tells if the packet is counted: 1 in SAMPLE_RATE of them, picked at
+ * random or every Nth on this CPU. The Go side scales the counts back
+ * up.
+ */
+static int sampled(void) {
+  u32 rate = SAMPLE_RATE, mode = SAMPLE_MODE;
+  int zero = 0;
+  u64 *seen;
+  if (rate <= 1)
+    return 1;
+  if (mode == SAMPLE_RANDOM)
+    return bpf_get_prandom_u32() % rate == 0;
+  seen = sample.lookup(&zero);
+  if (!seen)
+    return 1;
+  return (*seen)++ % rate == 0;
+}
+
+static void add_counter(u32 idx, u64 n) {
+  u64 *cnt = overflow.lookup(&idx);
+  if (cnt)
+    __sync_fetch_and_add(cnt, n);
+}
+
+/*
+ * Accounts a packet whose flow did not fit in the maps of generation
+ * seq. new_flow tells if it is the first packet of the flow lost in
+ * this generation.
+ */
+static void account_overflow(u32 seq, u32 v6, u64 len, int new_flow) {
+  u32 idx = (((seq & 1) << 1) | v6) * OVERFLOW_FIELDS;
+  add_counter(idx + OVERFLOW_BYTES, len);
+  add_counter(idx + OVERFLOW_PACKETS, 1);
+  if (new_flow)
+    add_counter(idx + OVERFLOW_FLOWS, 1);
+}
+
+/* Bytes to count for a packet ip_len long, following flow.Accounting. */
+static u64 account_len(u32 ip_len, u32 ip_hdr_len, u32 l4_hdr_len) {
+  u32 mode = ACCOUNTING;
+  if (mode == ACCOUNT_L2)
+    return ip_len + ETH_HLEN;
+  if (mode == ACCOUNT_PAYLOAD) {
+    if (ip_len < ip_hdr_len + l4_hdr_len)
+      return 0;
+    return ip_len - ip_hdr_len - l4_hdr_len;
+  }
+  return ip_len;
+}
+
+/* Length of the TCP or UDP header at l4. */
+static u32 l4_hdrlen(unsigned char *l4, u8 protocol) {
+  u8 doff = 0;
+  if (protocol == 17)
+    return sizeof(struct udphdr);
+  bpf_probe_read(&doff, 1, l4 + 12);
+  return (doff >> 4) * 4;
+}
+
+/*
+ * ICMP and ICMPv6 have no ports. Like NetFlow, flows get the type and
+ * code of the message at l4 as destination port: the two bytes are
+ * already in network byte order.
+ */
+static u16 icmp_port(unsigned char *l4) {
+  u16 port = 0;
+  bpf_probe_read(&port, sizeof(port), l4);
+  return port;
+}
+
+/*
+ * Walks the extension headers of the IPv6 packet at ip, whose first
+ * next header is nexthdr. Returns the upper layer protocol and its
+ * offset from ip in off. frag is set for fragments, whose upper layer
+ * header may be missing.
+ */
+static u8 ipv6_upper(unsigned char *ip, u8 nexthdr, u32 *off, int *frag) {
+  u32 o = sizeof(struct ipv6hdr);
+  u8 h[2];
+  int i;
+  *frag = 0;
+#pragma unroll
+  for (i = 0; i < MAX_EXT_HDRS; i++) {
+    if (nexthdr != NEXTHDR_HOP && nexthdr != NEXTHDR_ROUTING &&
+        nexthdr != NEXTHDR_DEST && nexthdr != NEXTHDR_AUTH &&
+        nexthdr != NEXTHDR_FRAGMENT)
+      break;
+    if (bpf_probe_read(h, sizeof(h), ip + o) != 0)
+      break;
+    if (nexthdr == NEXTHDR_FRAGMENT) {
+      *frag = 1;
+      nexthdr = h[0];
+      o += 8;
+      break;
+    }
+    if (nexthdr == NEXTHDR_AUTH)
+      o += (h[1] + 2) * 4;
+    else
+      o += (h[1] + 1) * 8;
+    nexthdr = h[0];
+  }
+  *off = o;
+  return nexthdr;
+}
+
+static int do_count4(struct sk_buff *skb, u32 seq) {
   struct iphdr *ip = skb_to_iphdr(skb);
   unsigned char *pc = (unsigned char *) ip;
   struct conn_s conn = {};
+  u32 l4_len = 0;
+  u64 len;
+  int full;
   if ((pc[0] & 0xf0) != 0x40)	/* IPv4 only */
     return -1;
   conn.protocol = ip->protocol;
   conn.src_ip = ip->saddr;
   conn.dst_ip = ip->daddr;
   if ((ip->protocol == 6 || ip->protocol == 17) &&
-      skb->transport_header != 0) {
+      (ntohs(ip->frag_off) & 0x1fff) == 0 && skb->transport_header != 0) {
     struct tcphdr *tcp = skb_to_tcphdr(skb);
     conn.src_port = tcp->source;
     conn.dst_port = tcp->dest;
+    l4_len = l4_hdrlen((unsigned char *)tcp, ip->protocol);
+  } else if (ip->protocol == 1 && (ntohs(ip->frag_off) & 0x1fff) == 0) {
+    conn.src_port = 0;
+    conn.dst_port = icmp_port(pc + (pc[0] & 0x0f) * 4);
   } else {
     conn.src_port = 0;
     conn.dst_port = 0;
   }
-  connections.increment(conn, len);
+  len = account_len(ntohs(ip->tot_len), (pc[0] & 0x0f) * 4, l4_len);
+  if (seq & 1)
+    full = ADD_FLOW(bconnections, &conn, len);
+  else
+    full = ADD_FLOW(connections, &conn, len);
+  if (full) {
+    struct lost4_s lost = {};
+    u8 one = 1;
+    lost.conn = conn;
+    lost.seq = seq;
+    account_overflow(seq, 0, len, lost4.insert(&lost, &one) == 0);
+  }
   return 0;
 }
 
-static int do_count6(struct sk_buff *skb, int len) {
+static int do_count6(struct sk_buff *skb, u32 seq) {
   struct ipv6hdr *ip = skb_to_ipv6hdr(skb);
   unsigned char *pc = (unsigned char *) ip;
   struct conn6_s conn = {};
+  u32 l4_len = 0, off;
+  u64 len;
+  int full, frag;
   if ((pc[0] & 0xf0) != 0x60)	/* IPv6 only */
     return -1;
-  /* TODO: check this, it is not correct in all cases. */
-  conn.protocol = ip->nexthdr;
+  conn.protocol = ipv6_upper(pc, ip->nexthdr, &off, &frag);
   bpf_probe_read(conn.src_ip, 16, &ip->saddr);
   bpf_probe_read(conn.dst_ip, 16, &ip->daddr);
-  if ((conn.protocol == 6 || conn.protocol == 17) &&
-      skb->transport_header != 0) {
-    struct tcphdr *tcp = skb_to_tcphdr(skb);
-    conn.src_port = tcp->source;
-    conn.dst_port = tcp->dest;
+  if ((conn.protocol == 6 || conn.protocol == 17) && !frag) {
+    __be16 ports[2] = {};
+    bpf_probe_read(ports, sizeof(ports), pc + off);
+    conn.src_port = ports[0];
+    conn.dst_port = ports[1];
+    l4_len = l4_hdrlen(pc + off, conn.protocol);
+  } else if (conn.protocol == 58 && !frag) {
+    conn.src_port = 0;
+    conn.dst_port = icmp_port(pc + off);
   } else {
     conn.src_port = 0;
     conn.dst_port = 0;
   }
-  connections6.increment(conn, len);
+  len = account_len(ntohs(ip->payload_len) + sizeof(*ip), off, l4_len);
+  if (seq & 1)
+    full = ADD_FLOW(bconnections6, &conn, len);
+  else
+    full = ADD_FLOW(connections6, &conn, len);
+  if (full) {
+    struct lost6_s lost = {};
+    u8 one = 1;
+    lost.conn = conn;
+    lost.seq = seq;
+    account_overflow(seq, 1, len, lost6.insert(&lost, &one) == 0);
+  }
   return 0;
 }
 
@@ -108,16 +366,21 @@ static int equal(char *src, char *dst, int n) {
   return 0;
 }
 
-static void do_count(struct sk_buff *skb, int len, char *dev) {
+static void do_count(struct sk_buff *skb, char *dev) {
+  u64 *cnt;
+  u32 seq;
   DEVS;
   if (CMPS) /* connected by && */
     return;
   if (0 == skb->network_header)
     return;
-  if (0 == do_count4(skb, len))
-    return;
-  if (0 == do_count6(skb, len))
+  if (!sampled())
     return;
+  seq = enter_gen(&cnt);
+  if (0 != do_count4(skb, seq))
+    do_count6(skb, seq);
+  if (cnt)
+    __sync_fetch_and_add(cnt, -1);
 }
 
 TRACEPOINT_PROBE(net, netif_receive_skb) {
@@ -125,7 +388,7 @@ TRACEPOINT_PROBE(net, netif_receive_skb) {
   char dev[16];
   struct sk_buff *skb = (struct sk_buff *) args->skbaddr;
   TP_DATA_LOC_READ_CONST(dev, name, 16);
-  do_count(skb, args->len, dev);
+  do_count(skb, dev);
   return 0;
 };
 
@@ -134,6 +397,6 @@ TRACEPOINT_PROBE(net, net_dev_start_xmit) {
   char dev[16];
   struct sk_buff *skb = (struct sk_buff *) args->skbaddr;
   TP_DATA_LOC_READ_CONST(dev, name, 16);
-  do_count(skb, args->len - args->network_offset, dev);
+  do_count(skb, dev);
   return 0;
 };
@@ -0,0 +1,508 @@
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include <uapi/linux/ptrace.h>
+#include <uapi/linux/in.h>
+#include <uapi/linux/in6.h>
+#include <linux/socket.h>
+#include <linux/tcp.h>
+#include <net/sock.h>
+#include <bcc/proto.h>
+
+/*
+ * Overflow counters, OVERFLOW_FIELDS for each generation and IP
+ * version. Keep in sync with flow.OverflowCounter.
+ */
+#define OVERFLOW_BYTES 0
+#define OVERFLOW_PACKETS 1
+#define OVERFLOW_FLOWS 2
+#define OVERFLOW_FIELDS 3
+#define OVERFLOW_COUNTERS (2 * 2 * OVERFLOW_FIELDS)
+
+/* Recently lost flows remembered to count distinct ones. */
+#define LOST_ENTRIES 1024
+
+/* Send and receive calls between their entry and their return. */
+#define CALL_ENTRIES 10240
+
+/* TCP sockets whose owner is remembered for their retransmits. */
+#define OWNER_ENTRIES 10240
+
+#define PROTO_TCP 6
+#define PROTO_UDP 17
+
+/*
+ * Sequence number of the generation of maps in use, bit 0 selects the
+ * maps. Incremented by the Go side at every switch.
+ */
+BPF_ARRAY(flowsnoop_switch, u32, 1);
+/*
+ * Number of programs running on each generation of the maps. The Go
+ * side reads a generation only once its counter is zero.
+ */
+BPF_ARRAY(active, u64, 2);
+
+/* The process a flow is accounted to. Keep in sync with flow.Process. */
+struct proc_s {
+  u32 pid;
+  u32 pad;
+  u64 cgroup;
+  char comm[16];
+};
+
+/*
+ * The 5-tuple is laid out like in flowsnoop1.c, so that the Go side
+ * decodes it the same way, and is followed by the process.
+ */
+struct conn_s{
+  u32 src_ip;
+  u32 dst_ip;
+  u16 src_port;
+  u16 dst_port;
+  u8 protocol;
+  u8 pad[3];
+  struct proc_s proc;
+};
+
+/*
+ * The bytes of a flow, followed by its TCP quality. Keep in sync with
+ * flow.Quality.
+ */
+struct val_s {
+  u64 bytes;
+  u32 retrans;
+  /* Smoothed RTT in microseconds at the last call, 0 if unknown. */
+  u32 srtt_us;
+};
+BPF_HASH(connections, struct conn_s, struct val_s, BUCKETS);
+BPF_HASH(bconnections, struct conn_s, struct val_s, BUCKETS);
+
+struct conn6_s{
+  u8 src_ip[16];
+  u8 dst_ip[16];
+  u16 src_port;
+  u16 dst_port;
+  u8 protocol;
+  u8 pad[3];
+  struct proc_s proc;
+};
+BPF_HASH(connections6, struct conn6_s, struct val_s, BUCKETS);
+BPF_HASH(bconnections6, struct conn6_s, struct val_s, BUCKETS);
+
+/* Flows lost in a generation, the value is unused. */
+struct lost4_s {
+  struct conn_s conn;
+  u32 seq;
+};
+BPF_TABLE("lru_hash", struct lost4_s, u8, lost4, LOST_ENTRIES);
+
+struct lost6_s {
+  struct conn6_s conn;
+  u32 seq;
+};
+BPF_TABLE("lru_hash", struct lost6_s, u8, lost6, LOST_ENTRIES);
+
+/* Shared among CPUs like active. */
+BPF_ARRAY(overflow, u64, OVERFLOW_COUNTERS);
+
+/*
+ * Arguments of the calls in progress, by thread, for the probes on
+ * their return. Returns missed by the kretprobes leave entries behind:
+ * the table is an LRU one so they go away.
+ */
+struct call_s {
+  struct sock *sk;
+  struct msghdr *msg;
+  u8 protocol;
+  u8 send;
+};
+BPF_TABLE("lru_hash", u64, struct call_s, calls, CALL_ENTRIES);
+
+/*
+ * The last process that sent or received on a TCP socket, by socket.
+ * Retransmits happen in softirq context, on behalf of whatever
+ * process is running, and are accounted to it.
+ */
+BPF_TABLE("lru_hash", u64, struct proc_s, owners, OWNER_ENTRIES);
+
+/* An end of a socket, IPv4 addresses are IPv4-mapped. */
+struct end_s {
+  u8 addr[16];
+  __be16 port;
+};
+
+/*
+ * Adds val to flow key of table, evaluates to -1 if the table is
+ * full. If another CPU adds the flow first, insert fails but the
+ * second lookup succeeds. The RTT is overwritten, racing CPUs report
+ * the same socket anyway.
+ */
+#define ADD_FLOW(table, key, val) ({			\
+  int ret = 0;						\
+  struct val_s *oval = table.lookup(key);		\
+  if (!oval && table.insert(key, val) != 0) {		\
+    oval = table.lookup(key);				\
+    if (!oval)						\
+      ret = -1;						\
+  }							\
+  if (oval) {						\
+    lock_xadd(&oval->bytes, (val)->bytes);		\
+    if ((val)->retrans)					\
+      lock_xadd(&oval->retrans, (val)->retrans);	\
+    if ((val)->srtt_us)					\
+      oval->srtt_us = (val)->srtt_us;			\
+  }							\
+  ret;							\
+})
+
+/*
+ * Marks a program as busy on the current generation of the maps and
+ * returns its sequence number, with the counter to decrement when
+ * done in cnt. See flowsnoop1.c.
+ */
+static u32 enter_gen(u64 **cnt) {
+  int zero = 0;
+  u32 seq, gen;
+  u32 *sw = flowsnoop_switch.lookup(&zero);
+  u64 *c;
+  *cnt = 0;
+  if (!sw)
+    return 0;
+  seq = *(volatile u32 *)sw;
+  gen = seq & 1;
+  c = active.lookup(&gen);
+  if (!c)
+    return seq;
+  __sync_fetch_and_add(c, 1);
+  if ((*(volatile u32 *)sw & 1) != gen) {
+    __sync_fetch_and_add(c, -1);
+    seq = *(volatile u32 *)sw;
+    gen = seq & 1;
+    c = active.lookup(&gen);
+    if (!c)
+      return seq;
+    __sync_fetch_and_add(c, 1);
+  }
+  *cnt = c;
+  return seq;
+}
+
+static void add_counter(u32 idx, u64 n) {
+  u64 *cnt = overflow.lookup(&idx);
+  if (cnt)
+    __sync_fetch_and_add(cnt, n);
+}
+
+/*
+ * Accounts a call whose flow did not fit in the maps of generation
+ * seq. new_flow tells if it is the first call of the flow lost in
+ * this generation. Calls are counted as packets.
+ */
+static void account_overflow(u32 seq, u32 v6, u64 len, int new_flow) {
+  u32 idx = (((seq & 1) << 1) | v6) * OVERFLOW_FIELDS;
+  add_counter(idx + OVERFLOW_BYTES, len);
+  add_counter(idx + OVERFLOW_PACKETS, 1);
+  if (new_flow)
+    add_counter(idx + OVERFLOW_FLOWS, 1);
+}
+
+static void map_ipv4(u8 *addr, u32 ip) {
+  __builtin_memset(addr, 0, 10);
+  addr[10] = 0xff;
+  addr[11] = 0xff;
+  __builtin_memcpy(addr + 12, &ip, 4);
+}
+
+static int is_ipv4(u8 *addr) {
+  int i;
+#pragma unroll
+  for (i = 0; i < 10; i++)
+    if (addr[i])
+      return 0;
+  return addr[10] == 0xff && addr[11] == 0xff;
+}
+
+static int is_unspecified(u8 *addr) {
+  int i;
+#pragma unroll
+  for (i = 0; i < 16; i++)
+    if (addr[i])
+      return 0;
+  return 1;
+}
+
+/*
+ * Reads the local and the remote end of sk. Kernel memory is read
+ * explicitly: BCC does not follow pointers kept in maps.
+ */
+static void sock_ends(struct sock *sk, struct end_s *local, struct end_s *remote) {
+  u16 family = 0, num = 0;
+  u32 ip = 0;
+  bpf_probe_read(&family, sizeof(family), &sk->__sk_common.skc_family);
+  if (family == AF_INET) {
+    bpf_probe_read(&ip, sizeof(ip), &sk->__sk_common.skc_rcv_saddr);
+    map_ipv4(local->addr, ip);
+    bpf_probe_read(&ip, sizeof(ip), &sk->__sk_common.skc_daddr);
+    map_ipv4(remote->addr, ip);
+  } else {
+    bpf_probe_read(local->addr, 16, &sk->__sk_common.skc_v6_rcv_saddr);
+    bpf_probe_read(remote->addr, 16, &sk->__sk_common.skc_v6_daddr);
+  }
+  bpf_probe_read(&num, sizeof(num), &sk->__sk_common.skc_num);
+  local->port = htons(num);
+  bpf_probe_read(&remote->port, sizeof(remote->port), &sk->__sk_common.skc_dport);
+}
+
+/*
+ * Reads the address of msg, if any, into remote: the destination of
+ * sendto() and the source filled by recvfrom() on unconnected UDP
+ * sockets.
+ */
+static void msg_end(struct msghdr *msg, struct end_s *remote) {
+  struct sockaddr *sa = 0;
+  u16 family = 0;
+  u32 ip = 0;
+  bpf_probe_read(&sa, sizeof(sa), &msg->msg_name);
+  if (!sa)
+    return;
+  bpf_probe_read(&family, sizeof(family), &sa->sa_family);
+  if (family == AF_INET) {
+    struct sockaddr_in *sin = (struct sockaddr_in *)sa;
+    bpf_probe_read(&ip, sizeof(ip), &sin->sin_addr.s_addr);
+    map_ipv4(remote->addr, ip);
+    bpf_probe_read(&remote->port, sizeof(remote->port), &sin->sin_port);
+  } else if (family == AF_INET6) {
+    struct sockaddr_in6 *sin6 = (struct sockaddr_in6 *)sa;
+    bpf_probe_read(remote->addr, 16, &sin6->sin6_addr);
+    bpf_probe_read(&remote->port, sizeof(remote->port), &sin6->sin6_port);
+  }
+}
+
+static void fill_proc(struct proc_s *proc) {
+  proc->pid = bpf_get_current_pid_tgid() >> 32;
+  proc->cgroup = bpf_get_current_cgroup_id();
+  bpf_get_current_comm(&proc->comm, sizeof(proc->comm));
+}
+
+/*
+ * Adds val to the flow from src to dst of proc. Flows that do not fit
+ * are accounted as overflow, unless they only carry retransmits.
+ */
+static void count4(struct end_s *src, struct end_s *dst, u8 protocol,
+                   struct proc_s *proc, struct val_s *val, u32 seq) {
+  struct conn_s conn;
+  int full;
+  __builtin_memset(&conn, 0, sizeof(conn));
+  __builtin_memcpy(&conn.src_ip, src->addr + 12, 4);
+  __builtin_memcpy(&conn.dst_ip, dst->addr + 12, 4);
+  conn.src_port = src->port;
+  conn.dst_port = dst->port;
+  conn.protocol = protocol;
+  conn.proc = *proc;
+  if (seq & 1)
+    full = ADD_FLOW(bconnections, &conn, val);
+  else
+    full = ADD_FLOW(connections, &conn, val);
+  if (full && val->bytes) {
+    struct lost4_s lost = {};
+    u8 one = 1;
+    lost.conn = conn;
+    lost.seq = seq;
+    account_overflow(seq, 0, val->bytes, lost4.insert(&lost, &one) == 0);
+  }
+}
+
+static void count6(struct end_s *src, struct end_s *dst, u8 protocol,
+                   struct proc_s *proc, struct val_s *val, u32 seq) {
+  struct conn6_s conn;
+  int full;
+  __builtin_memset(&conn, 0, sizeof(conn));
+  __builtin_memcpy(conn.src_ip, src->addr, 16);
+  __builtin_memcpy(conn.dst_ip, dst->addr, 16);
+  conn.src_port = src->port;
+  conn.dst_port = dst->port;
+  conn.protocol = protocol;
+  conn.proc = *proc;
+  if (seq & 1)
+    full = ADD_FLOW(bconnections6, &conn, val);
+  else
+    full = ADD_FLOW(connections6, &conn, val);
+  if (full && val->bytes) {
+    struct lost6_s lost = {};
+    u8 one = 1;
+    lost.conn = conn;
+    lost.seq = seq;
+    account_overflow(seq, 1, val->bytes, lost6.insert(&lost, &one) == 0);
+  }
+}
+
+/*
+ * Adds val to the flow of sk, from the local end to the remote one if
+ * send is set, the other way otherwise. IPv6 sockets talking to
+ * IPv4-mapped addresses make IPv4 flows.
+ */
+static void count(struct end_s *local, struct end_s *remote, u8 protocol, int send,
+                  struct proc_s *proc, struct val_s *val) {
+  u64 *cnt;
+  u32 seq = enter_gen(&cnt);
+  if (is_ipv4(remote->addr) && (is_ipv4(local->addr) || is_unspecified(local->addr))) {
+    if (send)
+      count4(local, remote, protocol, proc, val, seq);
+    else
+      count4(remote, local, protocol, proc, val, seq);
+  } else {
+    if (send)
+      count6(local, remote, protocol, proc, val, seq);
+    else
+      count6(remote, local, protocol, proc, val, seq);
+  }
+  if (cnt)
+    __sync_fetch_and_add(cnt, -1);
+}
+
+/*
+ * Accounts len bytes of payload sent or received on sk by the current
+ * process. TCP sockets record their smoothed RTT and remember their
+ * owner for the retransmits.
+ */
+static void account(struct sock *sk, struct msghdr *msg, u8 protocol, int send, u64 len) {
+  struct end_s local = {}, remote = {};
+  struct val_s val = {};
+  struct proc_s proc = {};
+  sock_ends(sk, &local, &remote);
+  if (protocol == PROTO_UDP && msg)
+    msg_end(msg, &remote);
+  fill_proc(&proc);
+  val.bytes = len;
+  if (protocol == PROTO_TCP) {
+    struct tcp_sock *tp = (struct tcp_sock *)sk;
+    u64 id = (u64)sk;
+    u32 srtt = 0;
+    /* srtt_us is scaled by 8. */
+    bpf_probe_read(&srtt, sizeof(srtt), &tp->srtt_us);
+    val.srtt_us = srtt >> 3;
+    owners.update(&id, &proc);
+  }
+  count(&local, &remote, protocol, send, &proc, &val);
+}
+
+/*
+ * Remembers the arguments of a call for its return. udpv6_sendmsg()
+ * calls udp_sendmsg() for IPv4-mapped destinations: the inner call
+ * finds the outer one with the same msg and leaves it alone, so that
+ * the bytes are counted once.
+ */
+static void enter_call(struct sock *sk, struct msghdr *msg, u8 protocol, u8 send) {
+  u64 id = bpf_get_current_pid_tgid();
+  struct call_s call = {};
+  struct call_s *outer = calls.lookup(&id);
+  if (outer && outer->msg == msg)
+    return;
+  call.sk = sk;
+  call.msg = msg;
+  call.protocol = protocol;
+  call.send = send;
+  calls.update(&id, &call);
+}
+
+/* Accounts the bytes returned by a call remembered by enter_call(). */
+static void exit_call(struct pt_regs *ctx) {
+  u64 id = bpf_get_current_pid_tgid();
+  int ret = PT_REGS_RC(ctx);
+  struct call_s *call = calls.lookup(&id);
+  if (!call)
+    return;
+  if (ret > 0)
+    account(call->sk, call->msg, call->protocol, call->send, ret);
+  calls.delete(&id);
+}
+
+/*
+ * Counts a retransmitted segment in the flow sent by sk, accounted to
+ * the last process that used it, if any.
+ */
+TRACEPOINT_PROBE(tcp, tcp_retransmit_skb) {
+  struct sock *sk = (struct sock *)args->skaddr;
+  struct end_s local = {}, remote = {};
+  struct val_s val = {};
+  struct proc_s proc = {};
+  u64 id = (u64)args->skaddr;
+  struct proc_s *owner = owners.lookup(&id);
+  if (owner)
+    proc = *owner;
+  sock_ends(sk, &local, &remote);
+  val.retrans = 1;
+  count(&local, &remote, PROTO_TCP, 1, &proc, &val);
+  return 0;
+}
+
+int kprobe__tcp_sendmsg(struct pt_regs *ctx, struct sock *sk, struct msghdr *msg) {
+  enter_call(sk, msg, PROTO_TCP, 1);
+  return 0;
+}
+
+int kretprobe__tcp_sendmsg(struct pt_regs *ctx) {
+  exit_call(ctx);
+  return 0;
+}
+
+/*
+ * Called once the data read by a recv*() call was copied to user
+ * space, copied is its length.
+ */
+int kprobe__tcp_cleanup_rbuf(struct pt_regs *ctx, struct sock *sk, int copied) {
+  if (copied > 0)
+    account(sk, 0, PROTO_TCP, 0, copied);
+  return 0;
+}
+
+int kprobe__udp_sendmsg(struct pt_regs *ctx, struct sock *sk, struct msghdr *msg) {
+  enter_call(sk, msg, PROTO_UDP, 1);
+  return 0;
+}
+
+int kretprobe__udp_sendmsg(struct pt_regs *ctx) {
+  exit_call(ctx);
+  return 0;
+}
+
+int kprobe__udpv6_sendmsg(struct pt_regs *ctx, struct sock *sk, struct msghdr *msg) {
+  enter_call(sk, msg, PROTO_UDP, 1);
+  return 0;
+}
+
+int kretprobe__udpv6_sendmsg(struct pt_regs *ctx) {
+  exit_call(ctx);
+  return 0;
+}
+
+int kprobe__udp_recvmsg(struct pt_regs *ctx, struct sock *sk, struct msghdr *msg) {
+  enter_call(sk, msg, PROTO_UDP, 0);
+  return 0;
+}
+
+int kretprobe__udp_recvmsg(struct pt_regs *ctx) {
+  exit_call(ctx);
+  return 0;
+}
+
+int kprobe__udpv6_recvmsg(struct pt_regs *ctx, struct sock *sk, struct msghdr *msg) {
+  enter_call(sk, msg, PROTO_UDP, 0);
+  return 0;
+}
+
+int kretprobe__udpv6_recvmsg(struct pt_regs *ctx) {
+  exit_call(ctx);
+  return 0;
+}
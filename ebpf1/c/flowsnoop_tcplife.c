@@ -0,0 +1,160 @@
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include <uapi/linux/ptrace.h>
+#include <linux/tcp.h>
+#include <net/sock.h>
+#include <net/tcp_states.h>
+#include <bcc/proto.h>
+
+/* Keep in sync with flow.ConnEvent. */
+#define EVENT_OPEN 0
+#define EVENT_CLOSE 1
+
+/* Connections followed at the same time. */
+#define BIRTH_ENTRIES 10240
+
+/* The process of a connection. Keep in sync with flow.Process. */
+struct proc_s {
+  u32 pid;
+  u32 pad;
+  u64 cgroup;
+  char comm[16];
+};
+
+/*
+ * When a connection was opened, 0 if it was before we started, and
+ * the process it belongs to, by socket. LRU, so that sockets whose
+ * close we missed go away.
+ */
+struct birth_s {
+  u64 ts;
+  struct proc_s proc;
+};
+BPF_TABLE("lru_hash", u64, struct birth_s, birth, BIRTH_ENTRIES);
+
+/*
+ * An open or close event, s is the local end and d the remote one.
+ * Keep in sync with unpackConnection.
+ */
+struct event_s {
+  u64 ts;
+  u64 duration;
+  u64 bytes_acked;
+  u64 bytes_received;
+  u8 saddr[16];
+  u8 daddr[16];
+  u16 sport;
+  u16 dport;
+  u8 family;
+  u8 event;
+  u8 oldstate;
+  u8 pad;
+  struct proc_s proc;
+};
+BPF_RINGBUF_OUTPUT(events, RING_PAGES);
+
+/* Events lost because the ring buffer was full. */
+BPF_ARRAY(lost, u64, 1);
+
+static void fill_proc(struct proc_s *proc) {
+  proc->pid = bpf_get_current_pid_tgid() >> 32;
+  proc->cgroup = bpf_get_current_cgroup_id();
+  bpf_get_current_comm(&proc->comm, sizeof(proc->comm));
+}
+
+TRACEPOINT_PROBE(sock, inet_sock_set_state) {
+  // args is from /sys/kernel/debug/tracing/events/sock/inet_sock_set_state/format
+  u64 sk = (u64)args->skaddr;
+  int oldstate = args->oldstate, newstate = args->newstate;
+  struct tcp_sock *tp = (struct tcp_sock *)args->skaddr;
+  struct event_s ev = {};
+  struct birth_s *b;
+  if (args->protocol != IPPROTO_TCP)
+    return 0;
+  /*
+   * Listening sockets are not connections, but the ones they accept
+   * start from their state.
+   */
+  if (newstate == TCP_LISTEN ||
+      (oldstate == TCP_LISTEN && newstate == TCP_CLOSE))
+    return 0;
+  b = birth.lookup(&sk);
+  if (!b && newstate != TCP_CLOSE) {
+    struct birth_s nb = {};
+    if (oldstate == TCP_CLOSE || oldstate == TCP_LISTEN)
+      nb.ts = bpf_ktime_get_ns();
+    birth.update(&sk, &nb);
+    b = birth.lookup(&sk);
+  }
+  /*
+   * Most transitions happen in softirq context, on behalf of whatever
+   * process was running. The ones to SYN_SENT, FIN_WAIT1 and LAST_ACK
+   * are done by connect() and close() of the owner. Accepted
+   * connections get it from kretprobe__inet_csk_accept.
+   */
+  if (b && b->proc.pid == 0 && (newstate == TCP_SYN_SENT ||
+                                newstate == TCP_FIN_WAIT1 ||
+                                newstate == TCP_LAST_ACK))
+    fill_proc(&b->proc);
+  if (newstate != TCP_ESTABLISHED && newstate != TCP_CLOSE)
+    return 0;
+  ev.ts = bpf_ktime_get_ns();
+  ev.family = args->family;
+  ev.sport = args->sport;
+  ev.dport = args->dport;
+  ev.oldstate = oldstate;
+  if (args->family == AF_INET) {
+    bpf_probe_read(ev.saddr, 4, args->saddr);
+    bpf_probe_read(ev.daddr, 4, args->daddr);
+  } else {
+    bpf_probe_read(ev.saddr, 16, args->saddr_v6);
+    bpf_probe_read(ev.daddr, 16, args->daddr_v6);
+  }
+  if (b)
+    ev.proc = b->proc;
+  if (newstate == TCP_ESTABLISHED) {
+    ev.event = EVENT_OPEN;
+  } else {
+    ev.event = EVENT_CLOSE;
+    if (b && b->ts)
+      ev.duration = ev.ts - b->ts;
+    bpf_probe_read(&ev.bytes_acked, sizeof(ev.bytes_acked), &tp->bytes_acked);
+    bpf_probe_read(&ev.bytes_received, sizeof(ev.bytes_received), &tp->bytes_received);
+    birth.delete(&sk);
+  }
+  if (events.ringbuf_output(&ev, sizeof(ev), 0) != 0) {
+    int zero = 0;
+    u64 *n = lost.lookup(&zero);
+    if (n)
+      __sync_fetch_and_add(n, 1);
+  }
+  return 0;
+}
+
+/*
+ * Accepted connections are established in softirq context, before
+ * accept() returns them: their process is taken here, in time for
+ * their close event.
+ */
+int kretprobe__inet_csk_accept(struct pt_regs *ctx) {
+  u64 sk = PT_REGS_RC(ctx);
+  struct birth_s *b;
+  if (!sk)
+    return 0;
+  b = birth.lookup(&sk);
+  if (b && b->proc.pid == 0)
+    fill_proc(&b->proc);
+  return 0;
+}
@@ -20,16 +20,16 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/chripell/flowsnoop/flow"
 	bpf "github.com/iovisor/gobpf/bcc"
-	"gopkg.in/restruct.v1"
 )
 
-//go:generate esc -o flowsnoop1.go -pkg ebpf1 -private c/flowsnoop1.c
+//go:generate esc -o flowsnoop1.go -pkg ebpf1 -private c/flowsnoop1.c c/flowsnoop_sock.c c/flowsnoop_tcplife.c
 //
 // Note that we need:
 // go get -u github.com/mjibson/esc
@@ -37,13 +37,38 @@ import (
 // go generate
 
 type Ebpf1 struct {
+	// name is the name of the producer, used in logs.
+	name string
+	// source is the path of the eBPF program in _escStatic.
+	source  string
+	buckets *int
+	// attach loads and attaches the programs of m.
+	attach func(m *bpf.Module) error
+	// sockets is set if the programs see sockets: their keys are
+	// followed by the process and the counts are payload bytes,
+	// never sampled.
+	sockets  bool
 	m        *bpf.Module
 	consumer flow.Consumer
 	table    *bpf.Table
+	btable   *bpf.Table
 	table6   *bpf.Table
-	finished chan struct{}
+	btable6  *bpf.Table
+	sw       *bpf.Table
+	active   *bpf.Table
+	overflow *bpf.Table
+	// Sequence number of the generation in use, bit 0 selects
+	// the tables.
+	seq       uint32
+	overflowR flow.OverflowReader
+	sampling  flow.Sampling
+	finished  chan struct{}
 }
 
+// Programs run to completion in microseconds, if a generation is busy
+// for longer something is wrong with the counters.
+const drainTimeout = time.Second
+
 func tracepointProbe(category, event string) string {
 	return fmt.Sprintf("tracepoint__%s__%s", category, event)
 }
@@ -67,9 +92,19 @@ var (
 	buckets = flag.Int("ebpf1_buckets", 1024, "buckets for in-kernel tables.")
 )
 
+// attachTracepoints attaches the programs of flowsnoop1.c.
+func attachTracepoints(m *bpf.Module) error {
+	for _, probe := range []string{"netif_receive_skb", "net_dev_start_xmit"} {
+		if err := LoadAttach(m, "net", probe); err != nil {
+			return fmt.Errorf("error loading/attaching probe %s: %w", probe, err)
+		}
+	}
+	return nil
+}
+
 func (ebpf *Ebpf1) Init(consumer flow.Consumer) error {
 	ebpf.consumer = consumer
-	f, err := _escStatic.Open("/c/flowsnoop1.c")
+	f, err := _escStatic.Open(ebpf.source)
 	if err != nil {
 		return fmt.Errorf("cannot open ebpf source: %w", err)
 	}
@@ -77,8 +112,22 @@ func (ebpf *Ebpf1) Init(consumer flow.Consumer) error {
 	if err != nil {
 		return fmt.Errorf("cannot read ebpf source: %w", err)
 	}
+	accounting, err := flow.AccountingMode()
+	if err != nil {
+		return err
+	}
+	if ebpf.sockets {
+		if accounting != flow.AccountPayload {
+			return fmt.Errorf("%s counts the payload of sockets, run it with -accounting l4", ebpf.name)
+		}
+	} else if ebpf.sampling, err = flow.SamplingMode(); err != nil {
+		return err
+	}
 	src := string(bsrc)
-	src = strings.Replace(src, "BUCKETS", strconv.Itoa(*buckets), -1)
+	src = strings.Replace(src, "BUCKETS", strconv.Itoa(*ebpf.buckets), -1)
+	src = strings.Replace(src, "ACCOUNTING", strconv.Itoa(int(accounting)), -1)
+	src = strings.Replace(src, "SAMPLE_RATE", strconv.Itoa(int(ebpf.sampling.Rate)), -1)
+	src = strings.Replace(src, "SAMPLE_MODE", strconv.Itoa(int(ebpf.sampling.Mode)), -1)
 	var (
 		devs []string
 		cmps []string
@@ -96,19 +145,66 @@ func (ebpf *Ebpf1) Init(consumer flow.Consumer) error {
 	src = strings.Replace(src, "DEVS;", strings.Join(devs, "\n"), -1)
 	src = strings.Replace(src, "CMPS", strings.Join(cmps, "&&"), -1)
 	ebpf.m = bpf.NewModule(src, []string{})
-	for _, probe := range []string{"netif_receive_skb", "net_dev_start_xmit"} {
-		if err := LoadAttach(ebpf.m, "net", probe); err != nil {
-			return fmt.Errorf("error loading/attaching probe %s: %w", probe, err)
-		}
+	if err := ebpf.attach(ebpf.m); err != nil {
+		return err
 	}
-	tableId := ebpf.m.TableId("connections")
-	ebpf.table = bpf.NewTable(tableId, ebpf.m)
-	tableId6 := ebpf.m.TableId("connections6")
-	ebpf.table6 = bpf.NewTable(tableId6, ebpf.m)
+	ebpf.table = bpf.NewTable(ebpf.m.TableId("connections"), ebpf.m)
+	ebpf.btable = bpf.NewTable(ebpf.m.TableId("bconnections"), ebpf.m)
+	ebpf.table6 = bpf.NewTable(ebpf.m.TableId("connections6"), ebpf.m)
+	ebpf.btable6 = bpf.NewTable(ebpf.m.TableId("bconnections6"), ebpf.m)
+	ebpf.sw = bpf.NewTable(ebpf.m.TableId("flowsnoop_switch"), ebpf.m)
+	ebpf.active = bpf.NewTable(ebpf.m.TableId("active"), ebpf.m)
+	ebpf.overflow = bpf.NewTable(ebpf.m.TableId("overflow"), ebpf.m)
 	ebpf.finished = make(chan struct{})
 	return nil
 }
 
+func arrayKey(idx uint32) []byte {
+	k := make([]byte, 4)
+	binary.LittleEndian.PutUint32(k, idx)
+	return k
+}
+
+// lookupArray reads entry idx of array table.
+func lookupArray(table *bpf.Table, idx uint32) (uint64, error) {
+	v, err := table.Get(arrayKey(idx))
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(v), nil
+}
+
+// switchTo redirects accounting to the generation with sequence
+// number seq and waits for all the eBPF programs still accounting
+// into the other one to finish. Programs run to completion without
+// sleeping, so this is usually immediate.
+func (ebpf *Ebpf1) switchTo(seq uint32) error {
+	leaf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(leaf, seq)
+	if err := ebpf.sw.Set(arrayKey(0), leaf); err != nil {
+		return fmt.Errorf("table switch failed: %w", err)
+	}
+	prev := (seq & 1) ^ 1
+	start := time.Now()
+	for spins := 0; ; spins++ {
+		busy, err := lookupArray(ebpf.active, prev)
+		if err != nil {
+			return fmt.Errorf("cannot read active counters: %w", err)
+		}
+		if busy == 0 {
+			return nil
+		}
+		if time.Since(start) > drainTimeout {
+			return fmt.Errorf("generation %d still busy after %v", prev, drainTimeout)
+		}
+		if spins < 100 {
+			runtime.Gosched()
+		} else {
+			time.Sleep(10 * time.Microsecond)
+		}
+	}
+}
+
 func (ebpf *Ebpf1) Run(ctx context.Context, flush <-chan (chan<- error)) {
 	go func() {
 		defer close(ebpf.finished)
@@ -124,48 +220,97 @@ func (ebpf *Ebpf1) Run(ctx context.Context, flush <-chan (chan<- error)) {
 			case chErr = <-flush:
 				break
 			}
+			// Select which tables we are reading and
+			// redirect recording to the other ones.
+			prev := ebpf.seq & 1
+			read4, read6 := ebpf.table, ebpf.table6
+			if prev == 1 {
+				read4, read6 = ebpf.btable, ebpf.btable6
+			}
+			tick := time.Now()
+			ebpf.seq++
+			if err := ebpf.switchTo(ebpf.seq); err != nil {
+				chErr <- err
+				return
+			}
+			stats, err := ebpf.overflowR.Read(prev, func(idx uint32) (uint64, error) {
+				return lookupArray(ebpf.overflow, idx)
+			})
+			if err != nil {
+				chErr <- err
+				return
+			}
 			// IPv4
-			for it := ebpf.table.Iter(); it.Next(); {
+			for it := read4.Iter(); it.Next(); {
 				var fl flow.Sample4
-				if err := restruct.Unpack(it.Key(), binary.BigEndian, &fl); err != nil {
+				if err := fl.Unpack(it.Key()); err != nil {
 					chErr <- fmt.Errorf("unpacking of flow failed: %v", err)
 					return
 				}
-				flows4 = append(flows4, flow.Sample4L{
+				if ebpf.sockets {
+					if err := fl.Process.Unpack(it.Key()[process4Off:]); err != nil {
+						chErr <- fmt.Errorf("unpacking of process failed: %v", err)
+						return
+					}
+				}
+				fll := flow.Sample4L{
 					Flow: fl,
 					Tot:  binary.LittleEndian.Uint64(it.Leaf()),
-				})
+				}
+				if ebpf.sockets {
+					if err := fll.Quality.Unpack(it.Leaf()[8:]); err != nil {
+						chErr <- fmt.Errorf("unpacking of quality failed: %v", err)
+						return
+					}
+				}
+				flows4 = append(flows4, fll)
 			}
-			if err := ebpf.table.Iter().Err(); err != nil {
+			if err := read4.Iter().Err(); err != nil {
 				chErr <- fmt.Errorf("error iterating table: %w\n", err)
 				return
 			}
-			if err := ebpf.table.DeleteAll(); err != nil {
+			if err := read4.DeleteAll(); err != nil {
 				chErr <- fmt.Errorf("error deleting table: %w\n", err)
 				return
 			}
 			// IPv6
-			for it := ebpf.table6.Iter(); it.Next(); {
+			for it := read6.Iter(); it.Next(); {
 				var fl flow.Sample6
-				if err := restruct.Unpack(it.Key(), binary.BigEndian, &fl); err != nil {
+				if err := fl.Unpack(it.Key()); err != nil {
 					chErr <- fmt.Errorf("unpacking of flow6 failed: %v", err)
 					return
 				}
-				flows6 = append(flows6, flow.Sample6L{
+				if ebpf.sockets {
+					if err := fl.Process.Unpack(it.Key()[process6Off:]); err != nil {
+						chErr <- fmt.Errorf("unpacking of process6 failed: %v", err)
+						return
+					}
+				}
+				fll := flow.Sample6L{
 					Flow: fl,
 					Tot:  binary.LittleEndian.Uint64(it.Leaf()),
-				})
+				}
+				if ebpf.sockets {
+					if err := fll.Quality.Unpack(it.Leaf()[8:]); err != nil {
+						chErr <- fmt.Errorf("unpacking of quality failed: %v", err)
+						return
+					}
+				}
+				flows6 = append(flows6, fll)
 			}
-			if err := ebpf.table6.Iter().Err(); err != nil {
+			if err := read6.Iter().Err(); err != nil {
 				chErr <- fmt.Errorf("error iterating table6: %w\n", err)
 				return
 			}
-			if err := ebpf.table6.DeleteAll(); err != nil {
+			if err := read6.DeleteAll(); err != nil {
 				chErr <- fmt.Errorf("error deleting table6: %w\n", err)
 				return
 			}
+			ebpf.sampling.Scale(&stats, flows4, flows6)
+			flows4, flows6 = stats.AddOther(flows4, flows6)
+			stats.LogOverflow(ebpf.name)
 			// Push to consumer
-			if err := ebpf.consumer.Push(time.Now(), flows4, nil, flows6, nil); err != nil {
+			if err := ebpf.consumer.Push(tick, flows4, nil, flows6, nil, stats); err != nil {
 				chErr <- fmt.Errorf("error from consumer: %w\n", err)
 				return
 			}
@@ -183,5 +328,10 @@ func (ebpf *Ebpf1) Finalize() error {
 }
 
 func New() *Ebpf1 {
-	return &Ebpf1{}
+	return &Ebpf1{
+		name:    "ebpf1",
+		source:  "/c/flowsnoop1.c",
+		buckets: buckets,
+		attach:  attachTracepoints,
+	}
 }
@@ -0,0 +1,125 @@
+package ebpf1
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Bits of the length in the header of ring buffer records.
+const (
+	ringBusy    = 1 << 31
+	ringDiscard = 1 << 30
+	ringHdrLen  = 8
+)
+
+// ringBuf reads a BPF_MAP_TYPE_RINGBUF map, which gobpf does not
+// support. The first page of the map is the position of the consumer,
+// written by us. It is followed by the position of the producer and
+// by the data, mapped twice in a row so that records wrapping around
+// are contiguous.
+type ringBuf struct {
+	epfd int
+	cons []byte
+	prod []byte
+	// data is the part of prod after the producer page.
+	data []byte
+	mask uint64
+}
+
+// newRingBuf maps the ring buffer map fd, whose data are size bytes,
+// a power of 2 number of pages.
+func newRingBuf(fd, size int) (*ringBuf, error) {
+	ps := os.Getpagesize()
+	r := &ringBuf{
+		epfd: -1,
+		mask: uint64(size - 1),
+	}
+	var err error
+	if r.cons, err = unix.Mmap(fd, 0, ps, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED); err != nil {
+		return nil, fmt.Errorf("cannot map ring buffer consumer page: %w", err)
+	}
+	if r.prod, err = unix.Mmap(fd, int64(ps), ps+2*size, unix.PROT_READ, unix.MAP_SHARED); err != nil {
+		r.Close()
+		return nil, fmt.Errorf("cannot map ring buffer data: %w", err)
+	}
+	r.data = r.prod[ps:]
+	if r.epfd, err = unix.EpollCreate1(unix.EPOLL_CLOEXEC); err != nil {
+		r.Close()
+		return nil, fmt.Errorf("cannot create epoll: %w", err)
+	}
+	ev := unix.EpollEvent{Events: unix.EPOLLIN, Fd: int32(fd)}
+	if err := unix.EpollCtl(r.epfd, unix.EPOLL_CTL_ADD, fd, &ev); err != nil {
+		r.Close()
+		return nil, fmt.Errorf("cannot poll ring buffer: %w", err)
+	}
+	return r, nil
+}
+
+// wait waits up to timeout for records to read.
+func (r *ringBuf) wait(timeout time.Duration) error {
+	events := make([]unix.EpollEvent, 1)
+	_, err := unix.EpollWait(r.epfd, events, int(timeout/time.Millisecond))
+	if err != nil && err != unix.EINTR {
+		return fmt.Errorf("cannot poll ring buffer: %w", err)
+	}
+	return nil
+}
+
+// read calls f with the records committed so far and frees them. f
+// must not keep the slice.
+func (r *ringBuf) read(f func([]byte)) {
+	consPos := (*uint64)(unsafe.Pointer(&r.cons[0]))
+	prodPos := (*uint64)(unsafe.Pointer(&r.prod[0]))
+	cons := atomic.LoadUint64(consPos)
+	for {
+		prod := atomic.LoadUint64(prodPos)
+		if cons >= prod {
+			return
+		}
+		for cons < prod {
+			off := cons & r.mask
+			hdr := atomic.LoadUint32((*uint32)(unsafe.Pointer(&r.data[off])))
+			// Reserved but not committed yet.
+			if hdr&ringBusy != 0 {
+				return
+			}
+			n := uint64(hdr &^ (ringBusy | ringDiscard))
+			if hdr&ringDiscard == 0 {
+				f(r.data[off+ringHdrLen : off+ringHdrLen+n])
+			}
+			cons += (n + ringHdrLen + 7) &^ 7
+			atomic.StoreUint64(consPos, cons)
+		}
+	}
+}
+
+func (r *ringBuf) Close() {
+	if r.epfd >= 0 {
+		unix.Close(r.epfd)
+	}
+	if r.prod != nil {
+		unix.Munmap(r.prod)
+	}
+	if r.cons != nil {
+		unix.Munmap(r.cons)
+	}
+}
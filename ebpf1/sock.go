@@ -0,0 +1,87 @@
+package ebpf1
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"flag"
+	"fmt"
+
+	bpf "github.com/iovisor/gobpf/bcc"
+)
+
+// Offsets of the process in the keys of flowsnoop_sock.c, after the
+// 5-tuple padded to 8 bytes.
+const (
+	process4Off = 16
+	process6Off = 40
+)
+
+// Calls that can be sleeping in a probed function at the same time,
+// the returns of the others are missed.
+const maxActive = 4096
+
+var sockBuckets = flag.Int("sock_buckets", 1024, "buckets for in-kernel tables of sock.")
+
+// sockProbes are the functions probed by flowsnoop_sock.c, with
+// whether their return is probed too.
+var sockProbes = []struct {
+	fn  string
+	ret bool
+}{
+	{"tcp_sendmsg", true},
+	{"tcp_cleanup_rbuf", false},
+	{"udp_sendmsg", true},
+	{"udpv6_sendmsg", true},
+	{"udp_recvmsg", true},
+	{"udpv6_recvmsg", true},
+}
+
+// attachSockets attaches the programs of flowsnoop_sock.c: the
+// kprobes and the tracepoint counting retransmits.
+func attachSockets(m *bpf.Module) error {
+	for _, p := range sockProbes {
+		fd, err := m.LoadKprobe("kprobe__" + p.fn)
+		if err != nil {
+			return fmt.Errorf("loading kprobe %s failed: %w", p.fn, err)
+		}
+		if err := m.AttachKprobe(p.fn, fd, maxActive); err != nil {
+			return fmt.Errorf("attaching kprobe %s failed: %w", p.fn, err)
+		}
+		if !p.ret {
+			continue
+		}
+		if fd, err = m.LoadKprobe("kretprobe__" + p.fn); err != nil {
+			return fmt.Errorf("loading kretprobe %s failed: %w", p.fn, err)
+		}
+		if err := m.AttachKretprobe(p.fn, fd, maxActive); err != nil {
+			return fmt.Errorf("attaching kretprobe %s failed: %w", p.fn, err)
+		}
+	}
+	return LoadAttach(m, "tcp", "tcp_retransmit_skb")
+}
+
+// NewSock returns the sock producer. It accounts the bytes sent and
+// received by TCP and UDP sockets to their flow and to the process
+// doing it, with kprobes on the socket calls, and the TCP quality of
+// the flows.
+func NewSock() *Ebpf1 {
+	return &Ebpf1{
+		name:    "sock",
+		source:  "/c/flowsnoop_sock.c",
+		buckets: sockBuckets,
+		attach:  attachSockets,
+		sockets: true,
+	}
+}
@@ -0,0 +1,247 @@
+package ebpf1
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"context"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chripell/flowsnoop/flow"
+	bpf "github.com/iovisor/gobpf/bcc"
+	"golang.org/x/sys/unix"
+)
+
+// TCP states of the kernel, in include/net/tcp_states.h.
+const (
+	tcpSynSent   = 2
+	tcpSynRecv   = 3
+	tcpFinWait1  = 4
+	tcpFinWait2  = 5
+	tcpClose     = 7
+	tcpCloseWait = 8
+	tcpLastAck   = 9
+	tcpClosing   = 11
+)
+
+// Size of struct event_s and offset of its process.
+const (
+	eventLen        = 104
+	eventProcessOff = 72
+)
+
+// closeReason tells why a connection closed from the state it was in
+// before.
+func closeReason(oldstate uint8) flow.CloseReason {
+	switch oldstate {
+	case tcpFinWait1, tcpFinWait2, tcpClosing:
+		return flow.CloseLocal
+	case tcpLastAck:
+		return flow.CloseRemote
+	case tcpSynSent, tcpSynRecv:
+		return flow.CloseFailed
+	}
+	// Established or close wait: nobody finished the shutdown.
+	return flow.CloseReset
+}
+
+// TCPLife produces the TCP connections opened and closed, from the
+// sock:inet_sock_set_state tracepoint and a kretprobe on
+// inet_csk_accept for the process of accepted connections. The eBPF program sends them
+// through a ring buffer, they are passed to the consumer at every
+// flush, after an empty set of flows.
+type TCPLife struct {
+	m        *bpf.Module
+	consumer flow.Consumer
+	ring     *ringBuf
+	lost     *bpf.Table
+	// lostSeen is the count of lost events already logged.
+	lostSeen uint64
+	// boot is the wall clock time of the monotonic clock zero,
+	// the clock of the event timestamps.
+	boot     time.Time
+	mu       sync.Mutex
+	conns    []flow.Connection
+	finished sync.WaitGroup
+}
+
+var ringPages = flag.Int("tcplife_pages", 64,
+	"pages of the ring buffer of connection events of tcplife, a power of 2.")
+
+func (tl *TCPLife) Init(consumer flow.Consumer) error {
+	tl.consumer = consumer
+	if n := *ringPages; n <= 0 || n&(n-1) != 0 {
+		return fmt.Errorf("tcplife_pages must be a power of 2: %d", n)
+	}
+	f, err := _escStatic.Open("/c/flowsnoop_tcplife.c")
+	if err != nil {
+		return fmt.Errorf("cannot open ebpf source: %w", err)
+	}
+	bsrc, err := ioutil.ReadAll(f)
+	if err != nil {
+		return fmt.Errorf("cannot read ebpf source: %w", err)
+	}
+	src := strings.Replace(string(bsrc), "RING_PAGES", strconv.Itoa(*ringPages), -1)
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		return fmt.Errorf("cannot read monotonic clock: %w", err)
+	}
+	tl.boot = time.Now().Add(-time.Duration(ts.Nano()))
+	tl.m = bpf.NewModule(src, []string{})
+	if err := LoadAttach(tl.m, "sock", "inet_sock_set_state"); err != nil {
+		return err
+	}
+	fd, err := tl.m.LoadKprobe("kretprobe__inet_csk_accept")
+	if err != nil {
+		return fmt.Errorf("loading kretprobe inet_csk_accept failed: %w", err)
+	}
+	if err := tl.m.AttachKretprobe("inet_csk_accept", fd, maxActive); err != nil {
+		return fmt.Errorf("attaching kretprobe inet_csk_accept failed: %w", err)
+	}
+	events := bpf.NewTable(tl.m.TableId("events"), tl.m)
+	fd, ok := events.Config()["fd"].(int)
+	if !ok {
+		return fmt.Errorf("no fd for the events ring buffer")
+	}
+	if tl.ring, err = newRingBuf(fd, *ringPages*os.Getpagesize()); err != nil {
+		return err
+	}
+	tl.lost = bpf.NewTable(tl.m.TableId("lost"), tl.m)
+	return nil
+}
+
+// unpackConnection decodes struct event_s.
+func (tl *TCPLife) unpackConnection(b []byte) (flow.Connection, error) {
+	if len(b) < eventLen {
+		return flow.Connection{}, fmt.Errorf("connection event too short: %d bytes", len(b))
+	}
+	ts := binary.LittleEndian.Uint64(b[0:8])
+	c := flow.Connection{
+		Event:         flow.ConnEvent(b[69]),
+		Time:          tl.boot.Add(time.Duration(ts)),
+		Duration:      time.Duration(binary.LittleEndian.Uint64(b[8:16])),
+		BytesAcked:    binary.LittleEndian.Uint64(b[16:24]),
+		BytesReceived: binary.LittleEndian.Uint64(b[24:32]),
+		SrcPort:       binary.LittleEndian.Uint16(b[64:66]),
+		DstPort:       binary.LittleEndian.Uint16(b[66:68]),
+	}
+	if b[68] == unix.AF_INET {
+		c.Src = flow.MapIPv4(b[32:36])
+		c.Dst = flow.MapIPv4(b[48:52])
+	} else {
+		copy(c.Src[:], b[32:48])
+		copy(c.Dst[:], b[48:64])
+	}
+	if c.Event == flow.ConnClose {
+		c.Reason = closeReason(b[70])
+	}
+	if err := c.Process.Unpack(b[eventProcessOff:]); err != nil {
+		return flow.Connection{}, err
+	}
+	return c, nil
+}
+
+// readEvents moves the events from the ring buffer to conns until ctx
+// is done.
+func (tl *TCPLife) readEvents(ctx context.Context) {
+	defer tl.finished.Done()
+	for ctx.Err() == nil {
+		if err := tl.ring.wait(100 * time.Millisecond); err != nil {
+			log.Printf("tcplife: %v", err)
+			return
+		}
+		tl.ring.read(func(b []byte) {
+			c, err := tl.unpackConnection(b)
+			if err != nil {
+				log.Printf("tcplife: %v", err)
+				return
+			}
+			tl.mu.Lock()
+			tl.conns = append(tl.conns, c)
+			tl.mu.Unlock()
+		})
+	}
+}
+
+// logLost logs the events lost since the last call.
+func (tl *TCPLife) logLost() error {
+	n, err := lookupArray(tl.lost, 0)
+	if err != nil {
+		return fmt.Errorf("cannot read lost events: %w", err)
+	}
+	if n > tl.lostSeen {
+		log.Printf("tcplife: %d connection events lost, the ring buffer is full: increase -tcplife_pages",
+			n-tl.lostSeen)
+		tl.lostSeen = n
+	}
+	return nil
+}
+
+func (tl *TCPLife) Run(ctx context.Context, flush <-chan (chan<- error)) {
+	tl.finished.Add(2)
+	go tl.readEvents(ctx)
+	go func() {
+		defer tl.finished.Done()
+		for {
+			var chErr chan<- error
+			select {
+			case <-ctx.Done():
+				return
+			case chErr = <-flush:
+				break
+			}
+			tick := time.Now()
+			tl.mu.Lock()
+			conns := tl.conns
+			tl.conns = nil
+			tl.mu.Unlock()
+			if err := tl.logLost(); err != nil {
+				chErr <- err
+				return
+			}
+			if err := tl.consumer.Push(tick, nil, nil, nil, nil, flow.Stats{}); err != nil {
+				chErr <- fmt.Errorf("error from consumer: %w\n", err)
+				return
+			}
+			if cc, ok := tl.consumer.(flow.ConnectionConsumer); ok {
+				if err := cc.PushConnections(tick, conns); err != nil {
+					chErr <- fmt.Errorf("error from consumer: %w\n", err)
+					return
+				}
+			}
+			chErr <- nil
+		}
+	}()
+}
+
+func (tl *TCPLife) Finalize() error {
+	tl.finished.Wait()
+	tl.ring.Close()
+	tl.m.Close()
+	return nil
+}
+
+// NewTCPLife returns the tcplife producer.
+func NewTCPLife() *TCPLife {
+	return &TCPLife{}
+}
@@ -30,18 +30,55 @@
 #define ETH_P_IPV6 0x86DD
 #endif
 
-#define TP_DATA_LOC_READ_CONST(dst, field, length)                             \
-  do {                                                                         \
-    unsigned short __offset = ctx->__data_loc_##field & 0xFFFF;                \
-    bpf_probe_read((void *)dst, length, (char *)ctx + __offset);               \
-  } while (0);
-
-const volatile char targ_iface[16] = {
-    0,
-};
+#ifndef ETH_HLEN
+#define ETH_HLEN 14
+#endif
+
+/* IPv6 extension headers, walked up to MAX_EXT_HDRS of them. */
+#define NEXTHDR_HOP 0
+#define NEXTHDR_ROUTING 43
+#define NEXTHDR_FRAGMENT 44
+#define NEXTHDR_AUTH 51
+#define NEXTHDR_DEST 60
+#define MAX_EXT_HDRS 6
+
+/* Keep in sync with flow.Accounting. */
+#define ACCOUNT_L3 0
+#define ACCOUNT_L2 1
+#define ACCOUNT_PAYLOAD 2
+
+/* Selected interfaces, when not all of them. */
+#define MAX_IFACES 1024
+
+/* If zero, only the interfaces in allowed are watched. */
+const volatile __u32 all_ifaces = 1;
+/*
+ * Overflow counters, OVERFLOW_FIELDS for each generation and IP
+ * version. Keep in sync with flow.OverflowCounter.
+ */
+#define OVERFLOW_BYTES 0
+#define OVERFLOW_PACKETS 1
+#define OVERFLOW_FLOWS 2
+#define OVERFLOW_FIELDS 3
+#define OVERFLOW_COUNTERS (2 * 2 * OVERFLOW_FIELDS)
+
+const volatile __u32 accounting = ACCOUNT_L3;
+
+/* Keep in sync with flow.SampleMode. */
+#define SAMPLE_RANDOM 0
+#define SAMPLE_DETERMINISTIC 1
+
+/* Count 1 in sample_rate packets, picked following sample_mode. */
+const volatile __u32 sample_rate = 1;
+const volatile __u32 sample_mode = SAMPLE_RANDOM;
+/*
+ * Sequence number of the generation of maps in use, bit 0 selects the
+ * maps. Incremented by the Go side at every switch.
+ */
 volatile int use_map = 0;
 
-#define BUCKETS 10240
+/* Recently lost flows remembered to count distinct ones. */
+#define LOST_ENTRIES 1024
 
 struct conn_s {
   u32 src_ip;
@@ -50,12 +87,32 @@ struct conn_s {
   u16 dst_port;
   u8 protocol;
 };
-struct connections_s {
-  __uint(type, BPF_MAP_TYPE_HASH);
-  __uint(max_entries, BUCKETS);
-  __type(key, struct conn_s);
+/* Keep in sync with key4Len. */
+_Static_assert(sizeof(struct conn_s) == 16, "conn_s size changed");
+
+/*
+ * Flow maps of both generations. The Go side creates the hash maps,
+ * with -ebpf2_buckets entries, and installs them here, replacing them
+ * with bigger ones when they overflow.
+ */
+struct {
+  __uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
+  __uint(max_entries, 2);
+  __uint(key_size, sizeof(u32));
+  __uint(value_size, sizeof(u32));
+} connections SEC(".maps");
+
+/*
+ * Number of programs running on each generation of the maps, per
+ * CPU. The Go side reads a generation only once its counters are all
+ * zero.
+ */
+struct {
+  __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+  __uint(max_entries, 2);
+  __type(key, u32);
   __type(value, u64);
-} connections SEC(".maps"), bconnections SEC(".maps");
+} active SEC(".maps");
 
 struct conn6_s {
   u8 src_ip[16];
@@ -64,25 +121,61 @@ struct conn6_s {
   u16 dst_port;
   u8 protocol;
 };
-struct connections6_s {
-  __uint(type, BPF_MAP_TYPE_HASH);
-  __uint(max_entries, BUCKETS);
-  __type(key, struct conn6_s);
+/* Keep in sync with key6Len. */
+_Static_assert(sizeof(struct conn6_s) == 38, "conn6_s size changed");
+
+struct {
+  __uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
+  __uint(max_entries, 2);
+  __uint(key_size, sizeof(u32));
+  __uint(value_size, sizeof(u32));
+} connections6 SEC(".maps");
+
+/* Flows lost in a generation, the value is unused. */
+struct lost4_s {
+  struct conn_s conn;
+  u32 seq;
+};
+struct {
+  __uint(type, BPF_MAP_TYPE_LRU_HASH);
+  __uint(max_entries, LOST_ENTRIES);
+  __type(key, struct lost4_s);
+  __type(value, u8);
+} lost4 SEC(".maps");
+
+struct lost6_s {
+  struct conn6_s conn;
+  u32 seq;
+};
+struct {
+  __uint(type, BPF_MAP_TYPE_LRU_HASH);
+  __uint(max_entries, LOST_ENTRIES);
+  __type(key, struct lost6_s);
+  __type(value, u8);
+} lost6 SEC(".maps");
+
+struct {
+  __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+  __uint(max_entries, OVERFLOW_COUNTERS);
+  __type(key, u32);
   __type(value, u64);
-} connections6 SEC(".maps"), bconnections6 SEC(".maps");
+} overflow SEC(".maps");
 
-static int is_equal(char *got, const volatile char *want, int n) {
-  int i;
-  if (want[0] == '\0')
-    return 1;
-  for (i = 0; i < n; i++) {
-    if (got[i] != want[i])
-      return 0;
-    if (got[i] == '\0')
-      return 1;
-  }
-  return 0;
-}
+/* Packets seen by each CPU, for deterministic sampling. */
+struct {
+  __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+  __uint(max_entries, 1);
+  __type(key, u32);
+  __type(value, u64);
+} sample SEC(".maps");
+
+/* ifindexes of the interfaces watched, filled by the Go side. */
+struct {
+  __uint(type, BPF_MAP_TYPE_HASH);
+  __uint(max_entries, MAX_IFACES);
+  __type(key, u32);
+  __type(value, u8);
+} allowed SEC(".maps");
 
 static inline struct tcphdr *skb_to_tcphdr(const struct sk_buff *skb) {
   return (struct tcphdr *)(BPF_CORE_READ(skb, head) +
@@ -104,111 +197,284 @@ static inline struct ethhdr *skb_to_ethhdr(const struct sk_buff *skb) {
                             BPF_CORE_READ(skb, mac_header));
 }
 
-static int do_count4(struct sk_buff *skb, int len) {
+/* Bytes to count for a packet ip_len long, following flow.Accounting. */
+static __always_inline u64 account_len(u32 ip_len, u32 ip_hdr_len,
+                                       u32 l4_hdr_len) {
+  if (accounting == ACCOUNT_L2)
+    return ip_len + ETH_HLEN;
+  if (accounting == ACCOUNT_PAYLOAD) {
+    if (ip_len < ip_hdr_len + l4_hdr_len)
+      return 0;
+    return ip_len - ip_hdr_len - l4_hdr_len;
+  }
+  return ip_len;
+}
+
+/* Length of the TCP or UDP header at l4, doff is a bitfield so it is
+ * read from the raw header. */
+static __always_inline u32 l4_hdrlen(u8 *l4, u8 protocol) {
+  u8 doff = 0;
+  if (protocol == 17)
+    return sizeof(struct udphdr);
+  bpf_probe_read(&doff, 1, l4 + 12);
+  return (doff >> 4) * 4;
+}
+
+/*
+ * ICMP and ICMPv6 have no ports. Like NetFlow, flows get the type and
+ * code of the message at l4 as destination port: the two bytes are
+ * already in network byte order.
+ */
+static __always_inline u16 icmp_port(u8 *l4) {
+  u16 port = 0;
+  bpf_probe_read(&port, sizeof(port), l4);
+  return port;
+}
+
+/*
+ * Walks the extension headers of the IPv6 packet at ip, whose first
+ * next header is nexthdr. Returns the upper layer protocol and its
+ * offset from ip in off. frag is set for fragments, whose upper layer
+ * header may be missing.
+ */
+static __always_inline u8 ipv6_upper(u8 *ip, u8 nexthdr, u32 *off,
+                                     int *frag) {
+  u32 o = sizeof(struct ipv6hdr);
+  u8 h[2];
+  int i;
+  *frag = 0;
+#pragma unroll
+  for (i = 0; i < MAX_EXT_HDRS; i++) {
+    if (nexthdr != NEXTHDR_HOP && nexthdr != NEXTHDR_ROUTING &&
+        nexthdr != NEXTHDR_DEST && nexthdr != NEXTHDR_AUTH &&
+        nexthdr != NEXTHDR_FRAGMENT)
+      break;
+    if (bpf_probe_read(h, sizeof(h), ip + o) != 0)
+      break;
+    if (nexthdr == NEXTHDR_FRAGMENT) {
+      *frag = 1;
+      nexthdr = h[0];
+      o += 8;
+      break;
+    }
+    if (nexthdr == NEXTHDR_AUTH)
+      o += (h[1] + 2) * 4;
+    else
+      o += (h[1] + 1) * 8;
+    nexthdr = h[0];
+  }
+  *off = o;
+  return nexthdr;
+}
+
+/*
+ * Tells if the packet is counted: 1 in sample_rate of them, picked at
+ * random or every Nth on this CPU. The Go side scales the counts back
+ * up.
+ */
+static __always_inline int sampled(void) {
+  u32 zero = 0;
+  u64 *seen;
+  if (sample_rate <= 1)
+    return 1;
+  if (sample_mode == SAMPLE_RANDOM)
+    return bpf_get_prandom_u32() % sample_rate == 0;
+  seen = bpf_map_lookup_elem(&sample, &zero);
+  if (!seen)
+    return 1;
+  return (*seen)++ % sample_rate == 0;
+}
+
+/*
+ * Marks this CPU as busy on the current generation of the maps and
+ * returns its sequence number, with the counter to decrement when
+ * done in cnt. The switch is read again after the increment: if it
+ * moved, the Go side may have already seen the counter at zero, so we
+ * move to the new generation. It won't switch again before draining
+ * it.
+ */
+static __always_inline u32 enter_gen(u64 **cnt) {
+  u32 seq = use_map;
+  u32 gen = seq & 1;
+  u64 *c = bpf_map_lookup_elem(&active, &gen);
+  *cnt = 0;
+  if (!c)
+    return seq;
+  __sync_fetch_and_add(c, 1);
+  if ((use_map & 1) != gen) {
+    __sync_fetch_and_add(c, -1);
+    seq = use_map;
+    gen = seq & 1;
+    c = bpf_map_lookup_elem(&active, &gen);
+    if (!c)
+      return seq;
+    __sync_fetch_and_add(c, 1);
+  }
+  *cnt = c;
+  return seq;
+}
+
+/* Adds len bytes to flow key of table, returns -1 if the table is full. */
+static __always_inline int add_flow(void *table, void *key, u64 len) {
+  u64 *oval = bpf_map_lookup_elem(table, key);
+  u64 nval = len;
+  if (oval) {
+    __sync_fetch_and_add(oval, len);
+    return 0;
+  }
+  if (bpf_map_update_elem(table, key, &nval, BPF_NOEXIST) == 0)
+    return 0;
+  /* Either another CPU added it or the table is full. */
+  oval = bpf_map_lookup_elem(table, key);
+  if (!oval)
+    return -1;
+  __sync_fetch_and_add(oval, len);
+  return 0;
+}
+
+static __always_inline void add_counter(u32 idx, u64 n) {
+  u64 *cnt = bpf_map_lookup_elem(&overflow, &idx);
+  if (cnt)
+    __sync_fetch_and_add(cnt, n);
+}
+
+/*
+ * Accounts a packet whose flow did not fit in the maps of generation
+ * seq. new_flow tells if it is the first packet of the flow lost in
+ * this generation.
+ */
+static __always_inline void account_overflow(u32 seq, u32 v6, u64 len,
+                                             int new_flow) {
+  u32 idx = (((seq & 1) << 1) | v6) * OVERFLOW_FIELDS;
+  add_counter(idx + OVERFLOW_BYTES, len);
+  add_counter(idx + OVERFLOW_PACKETS, 1);
+  if (new_flow)
+    add_counter(idx + OVERFLOW_FLOWS, 1);
+}
+
+static int do_count4(struct sk_buff *skb, u32 seq) {
   struct iphdr *ip = skb_to_iphdr(skb);
   struct conn_s conn = {};
-  u64 *oval = 0;
+  u8 one = 1;
+  u64 len;
   u8 version;
-  struct connections_s *conn_table = &connections;
+  u16 tot_len, frag_off;
+  u32 l4_len = 0;
+  u32 gen = seq & 1;
+  void *conn_table = bpf_map_lookup_elem(&connections, &gen);
   bpf_probe_read(&version, 1, ip);
   if ((version & 0xf0) != 0x40) /* IPv4 only */
     return -1;
   BPF_CORE_READ_INTO(&conn.protocol, ip, protocol);
   BPF_CORE_READ_INTO(&conn.src_ip, ip, saddr);
   BPF_CORE_READ_INTO(&conn.dst_ip, ip, daddr);
+  BPF_CORE_READ_INTO(&tot_len, ip, tot_len);
+  BPF_CORE_READ_INTO(&frag_off, ip, frag_off);
   if ((conn.protocol == 6 || conn.protocol == 17) &&
+      (bpf_ntohs(frag_off) & 0x1fff) == 0 &&
       BPF_CORE_READ(skb, transport_header) != 0) {
     struct tcphdr *tcp = skb_to_tcphdr(skb);
     BPF_CORE_READ_INTO(&conn.src_port, tcp, source);
     BPF_CORE_READ_INTO(&conn.dst_port, tcp, dest);
+    l4_len = l4_hdrlen((u8 *)tcp, conn.protocol);
+  } else if (conn.protocol == 1 && (bpf_ntohs(frag_off) & 0x1fff) == 0) {
+    conn.dst_port = icmp_port((u8 *)ip + (version & 0x0f) * 4);
   }
-  if (use_map)
-    conn_table = &bconnections;
-  oval = bpf_map_lookup_elem(conn_table, &conn);
-  if (oval) {
-    __sync_fetch_and_add(oval, len);
-  } else {
-    u64 nval = len;
-    if (bpf_map_update_elem(conn_table, &conn, &nval, BPF_NOEXIST) == -1) {
-      oval = bpf_map_lookup_elem(conn_table, &conn);
-      if (oval)
-        __sync_fetch_and_add(oval, len);
-    }
+  len = account_len(bpf_ntohs(tot_len), (version & 0x0f) * 4, l4_len);
+  if (!conn_table)
+    return 0;
+  if (add_flow(conn_table, &conn, len) != 0) {
+    struct lost4_s lost = {};
+    lost.conn = conn;
+    lost.seq = seq;
+    account_overflow(seq, 0, len,
+                     bpf_map_update_elem(&lost4, &lost, &one,
+                                         BPF_NOEXIST) == 0);
   }
   return 0;
 }
 
-static int do_count6(struct sk_buff *skb, int len) {
+static int do_count6(struct sk_buff *skb, u32 seq) {
   struct ipv6hdr *ip = skb_to_ipv6hdr(skb);
   struct conn6_s conn = {};
-  u64 *oval = 0;
-  u64 nval = 0;
+  u8 one = 1;
+  u64 len;
   u8 version;
-  struct connections6_s *conn_table = &connections6;
+  u8 nexthdr;
+  u16 payload_len;
+  u32 l4_len = 0, off;
+  int frag;
+  u32 gen = seq & 1;
+  void *conn_table = bpf_map_lookup_elem(&connections6, &gen);
   bpf_probe_read(&version, 1, ip);
   if ((version & 0xf0) != 0x60) /* IPv6 only */
     return -1;
-  /* TODO: check this, it is not correct in all cases. */
-  BPF_CORE_READ_INTO(&conn.protocol, ip, nexthdr);
+  BPF_CORE_READ_INTO(&nexthdr, ip, nexthdr);
+  conn.protocol = ipv6_upper((u8 *)ip, nexthdr, &off, &frag);
   bpf_probe_read(conn.src_ip, 16, &ip->saddr);
   bpf_probe_read(conn.dst_ip, 16, &ip->daddr);
-  if ((conn.protocol == 6 || conn.protocol == 17) &&
-      BPF_CORE_READ(skb, transport_header) != 0) {
-    struct tcphdr *tcp = skb_to_tcphdr(skb);
-    BPF_CORE_READ_INTO(&conn.src_port, tcp, source);
-    BPF_CORE_READ_INTO(&conn.dst_port, tcp, dest);
+  BPF_CORE_READ_INTO(&payload_len, ip, payload_len);
+  if ((conn.protocol == 6 || conn.protocol == 17) && !frag) {
+    __be16 ports[2] = {};
+    bpf_probe_read(ports, sizeof(ports), (u8 *)ip + off);
+    conn.src_port = ports[0];
+    conn.dst_port = ports[1];
+    l4_len = l4_hdrlen((u8 *)ip + off, conn.protocol);
+  } else if (conn.protocol == 58 && !frag) {
+    conn.dst_port = icmp_port((u8 *)ip + off);
   }
-  if (use_map)
-    conn_table = &bconnections6;
-  oval = bpf_map_lookup_elem(conn_table, &conn);
-  if (oval) {
-    __sync_fetch_and_add(oval, len);
-  } else {
-    u64 nval = len;
-    if (bpf_map_update_elem(conn_table, &conn, &nval, BPF_NOEXIST) == -1) {
-      oval = bpf_map_lookup_elem(conn_table, &conn);
-      if (oval)
-        __sync_fetch_and_add(oval, len);
-    }
+  len = account_len(bpf_ntohs(payload_len) + sizeof(struct ipv6hdr), off,
+                    l4_len);
+  if (!conn_table)
+    return 0;
+  if (add_flow(conn_table, &conn, len) != 0) {
+    struct lost6_s lost = {};
+    lost.conn = conn;
+    lost.seq = seq;
+    account_overflow(seq, 1, len,
+                     bpf_map_update_elem(&lost6, &lost, &one,
+                                         BPF_NOEXIST) == 0);
   }
   return 0;
 }
 
-static __always_inline void do_count(struct sk_buff *skb, int len, char *dev) {
+static __always_inline void do_count(struct sk_buff *skb) {
   struct ethhdr *hdr = skb_to_ethhdr(skb);
   u16 prot = BPF_CORE_READ(hdr, h_proto);
-  if (!is_equal(dev, targ_iface, 16))
-    return;
+  u64 *cnt;
+  u32 seq;
+  if (!all_ifaces) {
+    u32 ifindex = BPF_CORE_READ(skb, dev, ifindex);
+    if (!bpf_map_lookup_elem(&allowed, &ifindex))
+      return;
+  }
   if (BPF_CORE_READ(skb, network_header) == 0)
     return;
+  if (!sampled())
+    return;
+  seq = enter_gen(&cnt);
   if (prot == bpf_htons(ETH_P_IP))
-    do_count4(skb, len);
+    do_count4(skb, seq);
   if (prot == bpf_htons(ETH_P_IPV6))
-    do_count6(skb, len);
+    do_count6(skb, seq);
+  if (cnt)
+    __sync_fetch_and_add(cnt, -1);
   return;
 }
 
 SEC("tracepoint/net/netif_receive_skb")
 int tracepoint__net_netif_receive_skb(
     struct trace_event_raw_net_dev_template *ctx) {
-  char dev[16] = {
-      0,
-  };
   struct sk_buff *skb = (struct sk_buff *)ctx->skbaddr;
-  TP_DATA_LOC_READ_CONST(dev, name, 16);
-  do_count(skb, ctx->len, dev);
+  do_count(skb);
   return 0;
 }
 
 SEC("tracepoint/net/net_dev_start_xmit")
 int tracepoint__net_net_dev_start_xmit(
     struct trace_event_raw_net_dev_start_xmit *ctx) {
-  char dev[16] = {
-      0,
-  };
   struct sk_buff *skb = (struct sk_buff *)ctx->skbaddr;
-  TP_DATA_LOC_READ_CONST(dev, name, 16);
-  do_count(skb, ctx->len - ctx->network_offset, dev);
+  do_count(skb);
   return 0;
 }
 
@@ -12,9 +12,13 @@ struct flowsnoop2 {
 	struct bpf_object *obj;
 	struct {
 		struct bpf_map *connections;
-		struct bpf_map *bconnections;
+		struct bpf_map *active;
 		struct bpf_map *connections6;
-		struct bpf_map *bconnections6;
+		struct bpf_map *lost4;
+		struct bpf_map *lost6;
+		struct bpf_map *overflow;
+		struct bpf_map *sample;
+		struct bpf_map *allowed;
 		struct bpf_map *rodata;
 		struct bpf_map *bss;
 	} maps;
@@ -30,7 +34,10 @@ struct flowsnoop2 {
 		volatile int use_map;
 	} *bss;
 	struct flowsnoop2__rodata {
-		char targ_iface[16];
+		__u32 all_ifaces;
+		__u32 accounting;
+		__u32 sample_rate;
+		__u32 sample_mode;
 	} *rodata;
 };
 
@@ -120,7 +127,7 @@ flowsnoop2__create_skeleton(struct flowsnoop2 *obj)
 	s->obj = &obj->obj;
 
 	/* maps */
-	s->map_cnt = 6;
+	s->map_cnt = 10;
 	s->map_skel_sz = sizeof(*s->maps);
 	s->maps = (struct bpf_map_skeleton *)calloc(s->map_cnt, s->map_skel_sz);
 	if (!s->maps)
@@ -129,22 +136,34 @@ flowsnoop2__create_skeleton(struct flowsnoop2 *obj)
 	s->maps[0].name = "connections";
 	s->maps[0].map = &obj->maps.connections;
 
-	s->maps[1].name = "bconnections";
-	s->maps[1].map = &obj->maps.bconnections;
+	s->maps[1].name = "active";
+	s->maps[1].map = &obj->maps.active;
 
 	s->maps[2].name = "connections6";
 	s->maps[2].map = &obj->maps.connections6;
 
-	s->maps[3].name = "bconnections6";
-	s->maps[3].map = &obj->maps.bconnections6;
+	s->maps[3].name = "lost4";
+	s->maps[3].map = &obj->maps.lost4;
 
-	s->maps[4].name = "flowsnoo.rodata";
-	s->maps[4].map = &obj->maps.rodata;
-	s->maps[4].mmaped = (void **)&obj->rodata;
+	s->maps[4].name = "lost6";
+	s->maps[4].map = &obj->maps.lost6;
 
-	s->maps[5].name = "flowsnoo.bss";
-	s->maps[5].map = &obj->maps.bss;
-	s->maps[5].mmaped = (void **)&obj->bss;
+	s->maps[5].name = "overflow";
+	s->maps[5].map = &obj->maps.overflow;
+
+	s->maps[6].name = "sample";
+	s->maps[6].map = &obj->maps.sample;
+
+	s->maps[7].name = "allowed";
+	s->maps[7].map = &obj->maps.allowed;
+
+	s->maps[8].name = "flowsnoo.rodata";
+	s->maps[8].map = &obj->maps.rodata;
+	s->maps[8].mmaped = (void **)&obj->rodata;
+
+	s->maps[9].name = "flowsnoo.bss";
+	s->maps[9].map = &obj->maps.bss;
+	s->maps[9].mmaped = (void **)&obj->bss;
 
 	/* programs */
 	s->prog_cnt = 2;
@@ -161,1149 +180,2476 @@ flowsnoop2__create_skeleton(struct flowsnoop2 *obj)
 	s->progs[1].prog = &obj->progs.tracepoint__net_net_dev_start_xmit;
 	s->progs[1].link = &obj->links.tracepoint__net_net_dev_start_xmit;
 
-	s->data_sz = 31136;
+	s->data_sz = 65376;
 	s->data = (void *)"\
 \x7f\x45\x4c\x46\x02\x01\x01\0\0\0\0\0\0\0\0\0\x01\0\xf7\0\x01\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\x60\x75\0\0\0\0\0\0\0\0\0\0\x40\0\0\0\0\0\x40\0\x11\0\
-\x10\0\xbf\x17\0\0\0\0\0\0\xb7\x01\0\0\0\0\0\0\x7b\x1a\xc0\xff\0\0\0\0\x7b\x1a\
-\xb8\xff\0\0\0\0\x79\x76\x08\0\0\0\0\0\x61\x71\x14\0\0\0\0\0\x57\x01\0\0\xff\
-\xff\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\
-\0\xb8\xff\xff\xff\xb7\x02\0\0\x10\0\0\0\x85\0\0\0\x04\0\0\0\x61\x71\x10\0\0\0\
-\0\0\x7b\x1a\xb0\xff\0\0\0\0\xb7\x01\0\0\xc0\0\0\0\xbf\x67\0\0\0\0\0\0\x0f\x17\
-\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x08\0\
-\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\xb6\0\0\0\xbf\x63\0\0\
-\0\0\0\0\x0f\x13\0\0\0\0\0\0\x79\xa8\xd0\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\
-\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\xd0\
-\xff\0\0\0\0\x0f\x18\0\0\0\0\0\0\xb7\x01\0\0\x0c\0\0\0\x0f\x18\0\0\0\0\0\0\xbf\
-\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x83\0\0\
-\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa8\xd0\xff\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\x71\x12\0\0\0\0\0\0\x15\x02\x61\0\0\0\0\0\x71\x12\0\0\0\0\0\0\x71\
-\xa1\xb8\xff\0\0\0\0\x1d\x21\x01\0\0\0\0\0\x05\0\x57\x01\0\0\0\0\x15\x01\x5c\0\
-\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x01\0\0\0\0\0\x71\xa1\xb9\
-\xff\0\0\0\0\x1d\x21\x01\0\0\0\0\0\x05\0\x50\x01\0\0\0\0\x15\x01\x55\0\0\0\0\0\
-\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x02\0\0\0\0\0\x71\xa1\xba\xff\0\0\
-\0\0\x5d\x21\x4a\x01\0\0\0\0\x15\x01\x4f\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\x71\x12\x03\0\0\0\0\0\x71\xa1\xbb\xff\0\0\0\0\x5d\x21\x44\x01\0\0\0\0\
-\x15\x01\x49\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x04\0\0\0\0\
-\0\x71\xa1\xbc\xff\0\0\0\0\x5d\x21\x3e\x01\0\0\0\0\x15\x01\x43\0\0\0\0\0\x18\
-\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x05\0\0\0\0\0\x71\xa1\xbd\xff\0\0\0\0\
-\x5d\x21\x38\x01\0\0\0\0\x15\x01\x3d\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\x71\x12\x06\0\0\0\0\0\x71\xa1\xbe\xff\0\0\0\0\x5d\x21\x32\x01\0\0\0\0\x15\
-\x01\x37\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x07\0\0\0\0\0\
-\x71\xa1\xbf\xff\0\0\0\0\x5d\x21\x2c\x01\0\0\0\0\x15\x01\x31\0\0\0\0\0\x18\x01\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x08\0\0\0\0\0\x71\xa1\xc0\xff\0\0\0\0\x5d\
-\x21\x26\x01\0\0\0\0\x15\x01\x2b\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\x71\x12\x09\0\0\0\0\0\x71\xa1\xc1\xff\0\0\0\0\x5d\x21\x20\x01\0\0\0\0\x15\x01\
-\x25\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x0a\0\0\0\0\0\x71\
-\xa1\xc2\xff\0\0\0\0\x5d\x21\x1a\x01\0\0\0\0\x15\x01\x1f\0\0\0\0\0\x18\x01\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x0b\0\0\0\0\0\x71\xa1\xc3\xff\0\0\0\0\x5d\x21\
-\x14\x01\0\0\0\0\x15\x01\x19\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\
-\x12\x0c\0\0\0\0\0\x71\xa1\xc4\xff\0\0\0\0\x5d\x21\x0e\x01\0\0\0\0\x15\x01\x13\
-\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x0d\0\0\0\0\0\x71\xa1\
-\xc5\xff\0\0\0\0\x5d\x21\x08\x01\0\0\0\0\x15\x01\x0d\0\0\0\0\0\x18\x01\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\x71\x12\x0e\0\0\0\0\0\x71\xa1\xc6\xff\0\0\0\0\x5d\x21\x02\
-\x01\0\0\0\0\x15\x01\x07\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x11\
-\x0f\0\0\0\0\0\x71\xa2\xc7\xff\0\0\0\0\x4f\x21\0\0\0\0\0\0\x57\x01\0\0\xff\0\0\
-\0\x55\x01\xfa\0\0\0\0\0\xb7\x01\0\0\xb4\0\0\0\xbf\x69\0\0\0\0\0\0\x0f\x19\0\0\
-\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\
-\xbf\x93\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\xd0\xff\0\0\0\0\x15\x01\xf0\0\
-\0\0\0\0\x15\x08\x66\0\x86\xdd\0\0\x55\x08\xee\0\x08\0\0\0\xbf\xa1\0\0\0\0\0\0\
-\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x08\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\
-\0\x71\0\0\0\x79\xa8\xd0\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\
-\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x93\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\
-\xd0\xff\0\0\0\0\xb7\x02\0\0\0\0\0\0\x7b\x2a\xd8\xff\0\0\0\0\x7b\x2a\xd0\xff\0\
-\0\0\0\x0f\x18\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xcf\xff\xff\xff\xb7\
-\x02\0\0\x01\0\0\0\xbf\x83\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x71\xa1\xcf\xff\0\0\
-\0\0\x57\x01\0\0\xf0\0\0\0\x55\x01\xd6\0\x40\0\0\0\xb7\x01\0\0\x09\0\0\0\xbf\
-\x83\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xdc\xff\
-\xff\xff\xb7\x02\0\0\x01\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x0c\0\0\0\xbf\
-\x83\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\
-\xff\xff\xb7\x02\0\0\x04\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x10\0\0\0\x0f\
-\x18\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd4\xff\xff\xff\xb7\x02\0\0\
-\x04\0\0\0\xbf\x83\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x71\xa1\xdc\xff\0\0\0\0\x15\
-\x01\x01\0\x11\0\0\0\x55\x01\x24\0\x06\0\0\0\xb7\x01\0\0\xb2\0\0\0\x0f\x16\0\0\
-\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xf8\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\
-\xbf\x63\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\xf8\xff\0\0\0\0\x15\x01\x1b\0\
-\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xf8\xff\xff\xff\xb7\x02\0\0\x08\0\0\0\
-\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x79\xa7\xf8\xff\0\0\0\0\xbf\xa1\0\0\0\
-\0\0\0\x07\x01\0\0\xf8\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x63\0\0\0\0\0\0\
-\x85\0\0\0\x71\0\0\0\x69\xa1\xf8\xff\0\0\0\0\x0f\x17\0\0\0\0\0\0\xb7\x01\0\0\0\
-\0\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\
-\xd8\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x02\0\0\
-\0\x0f\x17\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xda\xff\xff\xff\xb7\x02\
-\0\0\x02\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x18\x01\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\0\x61\x11\0\0\0\0\0\0\x18\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x15\x01\
-\x02\0\0\0\0\0\x18\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\
-\0\0\xd0\xff\xff\xff\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x79\xa7\xb0\xff\0\
-\0\0\0\x15\0\x6a\0\0\0\0\0\x67\x07\0\0\x20\0\0\0\xc7\x07\0\0\x20\0\0\0\x05\0\
-\x88\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x08\
-\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x79\xa8\xd0\xff\0\0\0\0\xbf\xa1\
-\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x93\0\0\0\0\
-\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\xd0\xff\0\0\0\0\xb7\x02\0\0\0\0\0\0\x6b\x2a\
-\xf4\xff\0\0\0\0\x63\x2a\xf0\xff\0\0\0\0\x7b\x2a\xe8\xff\0\0\0\0\x7b\x2a\xe0\
-\xff\0\0\0\0\x7b\x2a\xd8\xff\0\0\0\0\x7b\x2a\xd0\xff\0\0\0\0\x0f\x18\0\0\0\0\0\
-\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xcf\xff\xff\xff\xb7\x02\0\0\x01\0\0\0\xbf\
-\x83\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x71\xa1\xcf\xff\0\0\0\0\x57\x01\0\0\xf0\0\
-\0\0\x55\x01\x6d\0\x60\0\0\0\xb7\x01\0\0\x06\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\x13\
-\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xf4\xff\xff\xff\xb7\x02\0\0\x01\0\
-\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x08\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\x13\0\0\
-\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x10\0\0\0\
-\x85\0\0\0\x04\0\0\0\xb7\x01\0\0\x18\0\0\0\x0f\x18\0\0\0\0\0\0\xbf\xa1\0\0\0\0\
-\0\0\x07\x01\0\0\xe0\xff\xff\xff\xb7\x02\0\0\x10\0\0\0\xbf\x83\0\0\0\0\0\0\x85\
-\0\0\0\x04\0\0\0\x71\xa1\xf4\xff\0\0\0\0\x15\x01\x01\0\x11\0\0\0\x55\x01\x24\0\
-\x06\0\0\0\xb7\x01\0\0\xb2\0\0\0\x0f\x16\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\
-\x01\0\0\xf8\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x63\0\0\0\0\0\0\x85\0\0\0\
-\x71\0\0\0\x69\xa1\xf8\xff\0\0\0\0\x15\x01\x1b\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\
-\x07\x01\0\0\xf8\xff\xff\xff\xb7\x02\0\0\x08\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\
-\0\x71\0\0\0\x79\xa7\xf8\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xf8\xff\
-\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x63\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\
-\xf8\xff\0\0\0\0\x0f\x17\0\0\0\0\0\0\xb7\x01\0\0\0\0\0\0\xbf\x73\0\0\0\0\0\0\
-\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xf0\xff\xff\xff\xb7\x02\0\
-\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x02\0\0\0\x0f\x17\0\0\0\0\0\0\xbf\
-\xa1\0\0\0\0\0\0\x07\x01\0\0\xf2\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x73\0\0\
-\0\0\0\0\x85\0\0\0\x71\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\0\0\0\
-\0\0\0\x18\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x15\x01\x02\0\0\0\0\0\x18\x06\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\x61\
-\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x79\xa7\xb0\xff\0\0\0\0\x15\0\x12\0\0\0\0\0\
-\x05\0\x96\xff\0\0\0\0\x67\x07\0\0\x20\0\0\0\xc7\x07\0\0\x20\0\0\0\x7b\x7a\xf8\
-\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\xa3\0\0\0\0\0\
-\0\x07\x03\0\0\xf8\xff\xff\xff\xbf\x61\0\0\0\0\0\0\xb7\x04\0\0\x01\0\0\0\x85\0\
-\0\0\x02\0\0\0\x55\0\x17\0\xff\xff\xff\xff\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\
-\xff\xff\xff\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\x12\0\0\0\0\0\x05\0\
-\x10\0\0\0\0\0\x67\x07\0\0\x20\0\0\0\xc7\x07\0\0\x20\0\0\0\x7b\x7a\xf8\xff\0\0\
-\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\xa3\0\0\0\0\0\0\x07\
-\x03\0\0\xf8\xff\xff\xff\xbf\x61\0\0\0\0\0\0\xb7\x04\0\0\x01\0\0\0\x85\0\0\0\
-\x02\0\0\0\x55\0\x06\0\xff\xff\xff\xff\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\
-\xff\xff\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\x01\0\0\0\0\0\xdb\x70\0\
-\0\0\0\0\0\xb7\0\0\0\0\0\0\0\x95\0\0\0\0\0\0\0\xbf\x17\0\0\0\0\0\0\xb7\x01\0\0\
-\0\0\0\0\x7b\x1a\xc0\xff\0\0\0\0\x7b\x1a\xb8\xff\0\0\0\0\x79\x76\x10\0\0\0\0\0\
-\x61\x71\x08\0\0\0\0\0\x57\x01\0\0\xff\xff\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\
-\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xb8\xff\xff\xff\xb7\x02\0\0\x10\0\0\0\
-\x85\0\0\0\x04\0\0\0\x61\x71\x24\0\0\0\0\0\x7b\x1a\xb0\xff\0\0\0\0\x61\x71\x2c\
-\0\0\0\0\0\x7b\x1a\xa8\xff\0\0\0\0\xb7\x01\0\0\xc0\0\0\0\xbf\x67\0\0\0\0\0\0\
-\x0f\x17\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\
-\0\x08\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\xb6\0\0\0\xbf\
-\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\x79\xa8\xd0\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\
-\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\x69\
-\xa1\xd0\xff\0\0\0\0\x0f\x18\0\0\0\0\0\0\xb7\x01\0\0\x0c\0\0\0\x0f\x18\0\0\0\0\
-\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\
-\x83\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa8\xd0\xff\0\0\0\0\x18\x01\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\x71\x12\0\0\0\0\0\0\x15\x02\x61\0\0\0\0\0\x71\x12\0\0\0\0\0\
-\0\x71\xa1\xb8\xff\0\0\0\0\x1d\x21\x01\0\0\0\0\0\x05\0\x5b\x01\0\0\0\0\x15\x01\
-\x5c\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x01\0\0\0\0\0\x71\
-\xa1\xb9\xff\0\0\0\0\x1d\x21\x01\0\0\0\0\0\x05\0\x54\x01\0\0\0\0\x15\x01\x55\0\
-\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x02\0\0\0\0\0\x71\xa1\xba\
-\xff\0\0\0\0\x5d\x21\x4e\x01\0\0\0\0\x15\x01\x4f\0\0\0\0\0\x18\x01\0\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\x71\x12\x03\0\0\0\0\0\x71\xa1\xbb\xff\0\0\0\0\x5d\x21\x48\x01\
-\0\0\0\0\x15\x01\x49\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x04\
-\0\0\0\0\0\x71\xa1\xbc\xff\0\0\0\0\x5d\x21\x42\x01\0\0\0\0\x15\x01\x43\0\0\0\0\
-\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x05\0\0\0\0\0\x71\xa1\xbd\xff\0\
-\0\0\0\x5d\x21\x3c\x01\0\0\0\0\x15\x01\x3d\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\x71\x12\x06\0\0\0\0\0\x71\xa1\xbe\xff\0\0\0\0\x5d\x21\x36\x01\0\0\0\
-\0\x15\x01\x37\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x07\0\0\0\
-\0\0\x71\xa1\xbf\xff\0\0\0\0\x5d\x21\x30\x01\0\0\0\0\x15\x01\x31\0\0\0\0\0\x18\
-\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x08\0\0\0\0\0\x71\xa1\xc0\xff\0\0\0\0\
-\x5d\x21\x2a\x01\0\0\0\0\x15\x01\x2b\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\x71\x12\x09\0\0\0\0\0\x71\xa1\xc1\xff\0\0\0\0\x5d\x21\x24\x01\0\0\0\0\x15\
-\x01\x25\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x0a\0\0\0\0\0\
-\x71\xa1\xc2\xff\0\0\0\0\x5d\x21\x1e\x01\0\0\0\0\x15\x01\x1f\0\0\0\0\0\x18\x01\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x0b\0\0\0\0\0\x71\xa1\xc3\xff\0\0\0\0\x5d\
-\x21\x18\x01\0\0\0\0\x15\x01\x19\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\x71\x12\x0c\0\0\0\0\0\x71\xa1\xc4\xff\0\0\0\0\x5d\x21\x12\x01\0\0\0\0\x15\x01\
-\x13\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x0d\0\0\0\0\0\x71\
-\xa1\xc5\xff\0\0\0\0\x5d\x21\x0c\x01\0\0\0\0\x15\x01\x0d\0\0\0\0\0\x18\x01\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\x71\x12\x0e\0\0\0\0\0\x71\xa1\xc6\xff\0\0\0\0\x5d\x21\
-\x06\x01\0\0\0\0\x15\x01\x07\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x71\
-\x11\x0f\0\0\0\0\0\x71\xa2\xc7\xff\0\0\0\0\x4f\x21\0\0\0\0\0\0\x57\x01\0\0\xff\
-\0\0\0\x55\x01\xfe\0\0\0\0\0\xb7\x01\0\0\xb4\0\0\0\xbf\x69\0\0\0\0\0\0\x0f\x19\
-\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x02\0\
-\0\0\xbf\x93\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\xd0\xff\0\0\0\0\x15\x01\
-\xf4\0\0\0\0\0\x79\xa1\xb0\xff\0\0\0\0\x79\xa2\xa8\xff\0\0\0\0\x1f\x21\0\0\0\0\
-\0\0\x7b\x1a\xb0\xff\0\0\0\0\x15\x08\x66\0\x86\xdd\0\0\x55\x08\xee\0\x08\0\0\0\
-\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x08\0\0\0\xbf\x73\
-\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x79\xa8\xd0\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\
-\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x93\0\0\0\0\0\0\x85\0\0\
-\0\x71\0\0\0\x69\xa1\xd0\xff\0\0\0\0\xb7\x02\0\0\0\0\0\0\x7b\x2a\xd8\xff\0\0\0\
-\0\x7b\x2a\xd0\xff\0\0\0\0\x0f\x18\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\
-\xcf\xff\xff\xff\xb7\x02\0\0\x01\0\0\0\xbf\x83\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\
-\x71\xa1\xcf\xff\0\0\0\0\x57\x01\0\0\xf0\0\0\0\x55\x01\xd6\0\x40\0\0\0\xb7\x01\
-\0\0\x09\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\
+\0\0\0\0\0\0\0\0\0\0\0\x20\xfb\0\0\0\0\0\0\0\0\0\0\x40\0\0\0\0\0\x40\0\x11\0\
+\x01\0\x79\x16\x08\0\0\0\0\0\xb7\x01\0\0\xc0\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\x13\
+\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x08\0\
+\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\xb6\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\x13\0\0\
+\0\0\0\0\x79\xa7\x98\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\
+\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\x98\xff\0\0\0\0\x0f\x17\
+\0\0\0\0\0\0\xb7\x01\0\0\x0c\0\0\0\x0f\x17\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\
+\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\
+\x71\0\0\0\x69\xa7\x98\xff\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\
+\0\0\0\0\0\0\x55\x01\x16\0\0\0\0\0\xb7\x01\0\0\x10\0\0\0\xbf\x63\0\0\0\0\0\0\
+\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\
+\0\x08\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\0\x01\0\0\x79\xa3\x98\xff\0\0\0\0\
+\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xfc\xff\xff\xff\xb7\x02\0\
+\0\x04\0\0\0\x85\0\0\0\x71\0\0\0\x61\xa1\xfc\xff\0\0\0\0\x63\x1a\xd0\xff\0\0\0\
+\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\xf1\x01\0\0\0\0\xb7\x01\0\0\xb4\0\0\0\xbf\
+\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\
+\xff\xff\xb7\x08\0\0\x02\0\0\0\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\x69\
+\xa1\x98\xff\0\0\0\0\x15\x01\xe7\x01\0\0\0\0\xb7\x01\0\0\0\0\0\0\x63\x1a\x98\
+\xff\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\0\0\0\0\0\0\x2d\x18\
+\x16\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\0\0\0\0\0\0\x55\x01\
+\x0c\0\0\0\0\0\x85\0\0\0\x07\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\
+\0\0\0\0\0\0\x67\0\0\0\x20\0\0\0\x77\0\0\0\x20\0\0\0\xbf\x02\0\0\0\0\0\0\x3f\
+\x12\0\0\0\0\0\0\x2f\x12\0\0\0\0\0\0\x1f\x20\0\0\0\0\0\0\x15\0\x14\0\0\0\0\0\
+\x05\0\xd1\x01\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\x98\xff\xff\xff\x18\x01\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x55\0\x01\0\0\0\0\0\x05\0\x0c\
+\0\0\0\0\0\x79\x01\0\0\0\0\0\0\xbf\x12\0\0\0\0\0\0\x07\x02\0\0\x01\0\0\0\x7b\
+\x20\0\0\0\0\0\0\x18\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x22\0\0\0\0\0\0\xbf\
+\x13\0\0\0\0\0\0\x3f\x23\0\0\0\0\0\0\x2f\x23\0\0\0\0\0\0\x1f\x31\0\0\0\0\0\0\
+\x55\x01\xbe\x01\0\0\0\0\x18\x08\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x81\0\0\0\0\0\
+\0\x7b\x1a\x88\xff\0\0\0\0\x57\x01\0\0\x01\0\0\0\x63\x1a\x98\xff\0\0\0\0\xbf\
+\xa2\0\0\0\0\0\0\x07\x02\0\0\x98\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\x85\0\0\0\x01\0\0\0\xb7\x03\0\0\0\0\0\0\x15\0\x19\0\0\0\0\0\xb7\x01\0\0\x01\
+\0\0\0\xdb\x10\0\0\0\0\0\0\x61\x81\0\0\0\0\0\0\x57\x01\0\0\x01\0\0\0\x61\xa2\
+\x98\xff\0\0\0\0\xbf\x03\0\0\0\0\0\0\x1d\x21\x12\0\0\0\0\0\xb7\x01\0\0\xff\xff\
+\xff\xff\xdb\x10\0\0\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\0\0\0\
+\0\0\0\x7b\x1a\x88\xff\0\0\0\0\x57\x01\0\0\x01\0\0\0\x63\x1a\x98\xff\0\0\0\0\
+\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\x98\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\x85\0\0\0\x01\0\0\0\xb7\x03\0\0\0\0\0\0\x15\0\x03\0\0\0\0\0\xb7\x01\0\0\
+\x01\0\0\0\xdb\x10\0\0\0\0\0\0\xbf\x03\0\0\0\0\0\0\x15\x07\x70\0\x86\xdd\0\0\
+\x55\x07\x93\x01\x08\0\0\0\x7b\x3a\x80\xff\0\0\0\0\xb7\x01\0\0\xc0\0\0\0\xbf\
+\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\
+\xff\xff\xb7\x02\0\0\x08\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\xb4\0\0\0\xbf\
+\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\x79\xa8\x98\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\
+\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\x69\
+\xa7\x98\xff\0\0\0\0\xb7\x01\0\0\0\0\0\0\x7b\x1a\xd8\xff\0\0\0\0\x7b\x1a\xd0\
+\xff\0\0\0\0\x79\xa1\x88\xff\0\0\0\0\x57\x01\0\0\x01\0\0\0\xb7\x02\0\0\x01\0\0\
+\0\x73\x2a\xcf\xff\0\0\0\0\x63\x1a\xc8\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\
+\0\0\xc8\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\
+\xbf\x09\0\0\0\0\0\0\x0f\x78\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xce\
+\xff\xff\xff\xb7\x02\0\0\x01\0\0\0\xbf\x83\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x71\
+\xa1\xce\xff\0\0\0\0\x57\x01\0\0\xf0\0\0\0\x55\x01\x6a\x01\x40\0\0\0\xb7\x01\0\
+\0\x09\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\
 \x01\0\0\xdc\xff\xff\xff\xb7\x02\0\0\x01\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\
 \x0c\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\
 \0\0\xd0\xff\xff\xff\xb7\x02\0\0\x04\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x10\
-\0\0\0\x0f\x18\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd4\xff\xff\xff\xb7\
-\x02\0\0\x04\0\0\0\xbf\x83\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x71\xa1\xdc\xff\0\0\
-\0\0\x15\x01\x01\0\x11\0\0\0\x55\x01\x24\0\x06\0\0\0\xb7\x01\0\0\xb2\0\0\0\x0f\
-\x16\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xf8\xff\xff\xff\xb7\x02\0\0\
-\x02\0\0\0\xbf\x63\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\xf8\xff\0\0\0\0\x15\
-\x01\x1b\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xf8\xff\xff\xff\xb7\x02\0\0\
-\x08\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x79\xa7\xf8\xff\0\0\0\0\xbf\
-\xa1\0\0\0\0\0\0\x07\x01\0\0\xf8\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x63\0\0\
-\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\xf8\xff\0\0\0\0\x0f\x17\0\0\0\0\0\0\xb7\
-\x01\0\0\0\0\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\
-\x07\x01\0\0\xd8\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\
-\0\0\x02\0\0\0\x0f\x17\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xda\xff\xff\
-\xff\xb7\x02\0\0\x02\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x18\x01\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\0\0\0\0\0\0\x18\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\x15\x01\x02\0\0\0\0\0\x18\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xbf\xa2\0\0\0\0\0\
-\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x79\xa7\
-\xb0\xff\0\0\0\0\x15\0\x6a\0\0\0\0\0\x67\x07\0\0\x20\0\0\0\xc7\x07\0\0\x20\0\0\
-\0\x05\0\x88\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\
-\0\0\x08\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x79\xa8\xd0\xff\0\0\0\0\
-\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x93\
-\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\xd0\xff\0\0\0\0\xb7\x02\0\0\0\0\0\0\
-\x6b\x2a\xf4\xff\0\0\0\0\x63\x2a\xf0\xff\0\0\0\0\x7b\x2a\xe8\xff\0\0\0\0\x7b\
-\x2a\xe0\xff\0\0\0\0\x7b\x2a\xd8\xff\0\0\0\0\x7b\x2a\xd0\xff\0\0\0\0\x0f\x18\0\
-\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xcf\xff\xff\xff\xb7\x02\0\0\x01\0\0\
-\0\xbf\x83\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x71\xa1\xcf\xff\0\0\0\0\x57\x01\0\0\
-\xf0\0\0\0\x55\x01\x6d\0\x60\0\0\0\xb7\x01\0\0\x06\0\0\0\xbf\x83\0\0\0\0\0\0\
-\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xf4\xff\xff\xff\xb7\x02\0\
-\0\x01\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x08\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\
+\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\
+\xd4\xff\xff\xff\xb7\x02\0\0\x04\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x02\0\0\
+\0\xbf\x83\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xcc\
+\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x06\0\0\0\
+\xbf\x83\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x96\
+\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\x71\xa1\xdc\xff\0\0\0\0\
+\x15\x01\x01\0\x06\0\0\0\x55\x01\x0e\0\x11\0\0\0\x69\xa2\x96\xff\0\0\0\0\x57\
+\x02\0\0\x1f\xff\0\0\x55\x02\x0b\0\0\0\0\0\xb7\x01\0\0\xb2\0\0\0\xbf\x67\0\0\0\
+\0\0\0\x0f\x17\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\
+\x02\0\0\x02\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\x98\xff\0\0\
+\0\0\x55\x01\xe1\0\0\0\0\0\x71\xa1\xdc\xff\0\0\0\0\xb7\x07\0\0\0\0\0\0\x55\x01\
+\x0a\x01\x01\0\0\0\x69\xa1\x96\xff\0\0\0\0\x57\x01\0\0\x1f\xff\0\0\x55\x01\x07\
+\x01\0\0\0\0\x71\xa1\xce\xff\0\0\0\0\x67\x01\0\0\x02\0\0\0\x57\x01\0\0\x3c\0\0\
+\0\x0f\x18\0\0\0\0\0\0\xb7\x07\0\0\0\0\0\0\x6b\x7a\x98\xff\0\0\0\0\xbf\xa1\0\0\
+\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x83\0\0\0\0\0\0\
+\x85\0\0\0\x04\0\0\0\x69\xa1\x98\xff\0\0\0\0\x6b\x1a\xda\xff\0\0\0\0\x05\0\xf9\
+\0\0\0\0\0\x7b\x3a\x80\xff\0\0\0\0\xb7\x01\0\0\xc0\0\0\0\xbf\x63\0\0\0\0\0\0\
+\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\
+\0\x08\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\xb4\0\0\0\x0f\x16\0\0\0\0\0\0\x79\
+\xa7\x98\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\
+\0\x02\0\0\0\xbf\x63\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa6\x98\xff\0\0\0\0\
+\xb7\x08\0\0\0\0\0\0\x6b\x8a\xf4\xff\0\0\0\0\x63\x8a\xf0\xff\0\0\0\0\x7b\x8a\
+\xe8\xff\0\0\0\0\x7b\x8a\xe0\xff\0\0\0\0\x7b\x8a\xd8\xff\0\0\0\0\x7b\x8a\xd0\
+\xff\0\0\0\0\x79\xa1\x88\xff\0\0\0\0\x57\x01\0\0\x01\0\0\0\xb7\x02\0\0\x01\0\0\
+\0\x73\x2a\x96\xff\0\0\0\0\x63\x1a\xc8\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\
+\0\0\xc8\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\
+\xbf\x09\0\0\0\0\0\0\x0f\x67\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xcf\
+\xff\xff\xff\xb7\x02\0\0\x01\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x71\
+\xa1\xcf\xff\0\0\0\0\x57\x01\0\0\xf0\0\0\0\x55\x01\xf7\0\x60\0\0\0\x7b\x9a\x70\
+\xff\0\0\0\0\x79\xa1\x80\xff\0\0\0\0\xb7\x01\0\0\x06\0\0\0\xbf\x73\0\0\0\0\0\0\
+\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xce\xff\xff\xff\xb7\x02\0\
+\0\x01\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x28\0\0\0\x7b\x1a\x78\xff\0\0\0\0\
+\x71\xa6\xce\xff\0\0\0\0\x25\x06\x16\0\x3c\0\0\0\xb7\x01\0\0\x01\0\0\0\x6f\x61\
+\0\0\0\0\0\0\x18\x02\0\0\x01\0\0\0\0\0\0\0\0\x18\x08\x10\x5f\x21\0\0\0\0\0\0\
+\x55\x01\x01\0\0\0\0\0\x05\0\x0f\0\0\0\0\0\xbf\x73\0\0\0\0\0\0\x07\x03\0\0\x28\
+\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x09\0\0\x02\0\0\0\
+\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x04\0\0\0\x55\0\x07\0\0\0\0\0\x15\x06\xe1\0\
+\x33\0\0\0\xb7\x01\0\0\x28\0\0\0\x55\x06\xde\0\x2c\0\0\0\xb7\x08\0\0\x01\0\0\0\
+\x71\xa6\x98\xff\0\0\0\0\x07\x01\0\0\x08\0\0\0\x7b\x1a\x78\xff\0\0\0\0\x73\x6a\
+\xf4\xff\0\0\0\0\xb7\x01\0\0\x08\0\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\
+\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x10\0\0\0\x85\0\0\
+\0\x04\0\0\0\xb7\x01\0\0\x18\0\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\
+\xa1\0\0\0\0\0\0\x07\x01\0\0\xe0\xff\xff\xff\xb7\x02\0\0\x10\0\0\0\x85\0\0\0\
+\x04\0\0\0\xb7\x01\0\0\x04\0\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\
+\xa1\0\0\0\0\0\0\x07\x01\0\0\xcc\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\
+\x71\0\0\0\x71\xa1\xf4\xff\0\0\0\0\xb7\x02\0\0\x01\0\0\0\x55\x01\x01\0\x11\0\0\
+\0\xb7\x02\0\0\0\0\0\0\xb7\x03\0\0\x01\0\0\0\x55\x01\x01\0\x06\0\0\0\xb7\x03\0\
+\0\0\0\0\0\x55\x08\x1f\0\0\0\0\0\x5f\x23\0\0\0\0\0\0\x57\x03\0\0\x01\0\0\0\x55\
+\x03\x1c\0\0\0\0\0\x79\xa1\x78\xff\0\0\0\0\x0f\x17\0\0\0\0\0\0\xb7\x06\0\0\0\0\
+\0\0\x63\x6a\x98\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\
+\xb7\x02\0\0\x04\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x69\xa1\x98\xff\
+\0\0\0\0\x6b\x1a\xf0\xff\0\0\0\0\x69\xa1\x9a\xff\0\0\0\0\x6b\x1a\xf2\xff\0\0\0\
+\0\x71\xa1\xf4\xff\0\0\0\0\x73\x6a\xfc\xff\0\0\0\0\xb7\x06\0\0\x08\0\0\0\x79\
+\xa9\x80\xff\0\0\0\0\x15\x01\x1d\0\x11\0\0\0\x07\x07\0\0\x0c\0\0\0\xbf\xa1\0\0\
+\0\0\0\0\x07\x01\0\0\xfc\xff\xff\xff\xb7\x02\0\0\x01\0\0\0\xbf\x73\0\0\0\0\0\0\
+\x85\0\0\0\x04\0\0\0\x71\xa6\xfc\xff\0\0\0\0\x77\x06\0\0\x02\0\0\0\x57\x06\0\0\
+\x3c\0\0\0\x05\0\x13\0\0\0\0\0\xb7\x06\0\0\0\0\0\0\xb7\x02\0\0\x01\0\0\0\x79\
+\xa9\x80\xff\0\0\0\0\x55\x01\x01\0\x3a\0\0\0\xb7\x02\0\0\0\0\0\0\x4f\x28\0\0\0\
+\0\0\0\x57\x08\0\0\x01\0\0\0\x55\x08\x0b\0\0\0\0\0\x79\xa1\x78\xff\0\0\0\0\x0f\
+\x17\0\0\0\0\0\0\xb7\x06\0\0\0\0\0\0\x6b\x6a\x98\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\
+\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\
+\0\0\x04\0\0\0\x69\xa1\x98\xff\0\0\0\0\x6b\x1a\xf2\xff\0\0\0\0\x69\xa1\xcc\xff\
+\0\0\0\0\xdc\x01\0\0\x10\0\0\0\x18\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x23\0\0\
+\0\0\0\0\x55\x03\x04\0\x01\0\0\0\x07\x01\0\0\x36\0\0\0\xbf\x17\0\0\0\0\0\0\x79\
+\xa3\x70\xff\0\0\0\0\x05\0\x0b\0\0\0\0\0\x07\x01\0\0\x28\0\0\0\x61\x22\0\0\0\0\
+\0\0\xbf\x17\0\0\0\0\0\0\x79\xa3\x70\xff\0\0\0\0\x55\x02\x06\0\x02\0\0\0\x79\
+\xa2\x78\xff\0\0\0\0\x0f\x26\0\0\0\0\0\0\xb7\x07\0\0\0\0\0\0\x2d\x16\x02\0\0\0\
+\0\0\x1f\x61\0\0\0\0\0\0\xbf\x17\0\0\0\0\0\0\xbf\x36\0\0\0\0\0\0\x15\x06\x6d\0\
+\0\0\0\0\x67\x07\0\0\x20\0\0\0\x77\x07\0\0\x20\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\
+\x02\0\0\xd0\xff\xff\xff\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x7b\x7a\x98\
+\xff\0\0\0\0\x55\0\x0d\0\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\
+\xff\xbf\xa3\0\0\0\0\0\0\x07\x03\0\0\x98\xff\xff\xff\xbf\x61\0\0\0\0\0\0\xb7\
+\x04\0\0\x01\0\0\0\x85\0\0\0\x02\0\0\0\x15\0\x5d\0\0\0\0\0\xbf\xa2\0\0\0\0\0\0\
+\x07\x02\0\0\xd0\xff\xff\xff\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\x0b\
+\x01\0\0\0\0\xdb\x70\0\0\0\0\0\0\x05\0\x56\0\0\0\0\0\xb7\x01\0\0\xc0\0\0\0\x0f\
+\x16\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xbf\x78\0\0\0\
+\0\0\0\xb7\x07\0\0\x08\0\0\0\xb7\x02\0\0\x08\0\0\0\xbf\x63\0\0\0\0\0\0\x85\0\0\
+\0\x71\0\0\0\x79\xa6\x98\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\
+\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x83\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\
+\x98\xff\0\0\0\0\x0f\x16\0\0\0\0\0\0\xb7\x01\0\0\0\0\0\0\xbf\x63\0\0\0\0\0\0\
+\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd8\xff\xff\xff\xb7\x02\0\
+\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x02\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\
+\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xda\xff\xff\xff\xb7\x02\0\0\
+\x02\0\0\0\x85\0\0\0\x71\0\0\0\x71\xa1\xdc\xff\0\0\0\0\xb7\x02\0\0\0\0\0\0\x73\
+\x2a\x98\xff\0\0\0\0\x15\x01\x09\0\x11\0\0\0\x07\x06\0\0\x0c\0\0\0\xbf\xa1\0\0\
+\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x01\0\0\0\xbf\x63\0\0\0\0\0\0\
+\x85\0\0\0\x04\0\0\0\x71\xa7\x98\xff\0\0\0\0\x77\x07\0\0\x02\0\0\0\x57\x07\0\0\
+\x3c\0\0\0\x69\xa1\xcc\xff\0\0\0\0\xdc\x01\0\0\x10\0\0\0\x71\xa2\xce\xff\0\0\0\
+\0\x18\x03\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x34\0\0\0\0\0\0\x55\x04\x02\0\x01\0\
+\0\0\x07\x01\0\0\x0e\0\0\0\x05\0\x09\0\0\0\0\0\x61\x33\0\0\0\0\0\0\xbf\x18\0\0\
+\0\0\0\0\x55\x03\x07\0\x02\0\0\0\x67\x02\0\0\x02\0\0\0\x57\x02\0\0\x3c\0\0\0\
+\x0f\x27\0\0\0\0\0\0\xb7\x08\0\0\0\0\0\0\x2d\x17\x02\0\0\0\0\0\x1f\x71\0\0\0\0\
+\0\0\xbf\x18\0\0\0\0\0\0\x15\x09\x16\0\0\0\0\0\x67\x08\0\0\x20\0\0\0\x77\x08\0\
+\0\x20\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\x91\0\0\0\0\0\
+\0\x85\0\0\0\x01\0\0\0\x7b\x8a\x98\xff\0\0\0\0\x55\0\x0d\0\0\0\0\0\xbf\xa2\0\0\
+\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\xa3\0\0\0\0\0\0\x07\x03\0\0\x98\xff\
+\xff\xff\xbf\x91\0\0\0\0\0\0\xb7\x04\0\0\x01\0\0\0\x85\0\0\0\x02\0\0\0\x15\0\
+\x06\0\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\x91\0\0\0\0\
+\0\0\x85\0\0\0\x01\0\0\0\x15\0\xf1\0\0\0\0\0\xdb\x80\0\0\0\0\0\0\x79\xa3\x80\
+\xff\0\0\0\0\x15\x03\x02\0\0\0\0\0\xb7\x01\0\0\xff\xff\xff\xff\xdb\x13\0\0\0\0\
+\0\0\xb7\0\0\0\0\0\0\0\x95\0\0\0\0\0\0\0\xb7\x09\0\0\x03\0\0\0\x71\xa1\x99\xff\
+\0\0\0\0\x6f\x91\0\0\0\0\0\0\xbf\x19\0\0\0\0\0\0\x07\x01\0\0\x30\0\0\0\x7b\x1a\
+\x78\xff\0\0\0\0\x71\xa6\x98\xff\0\0\0\0\x25\x06\x1e\xff\x3c\0\0\0\xb7\x01\0\0\
+\x01\0\0\0\x6f\x61\0\0\0\0\0\0\x18\x02\0\0\x01\0\0\0\0\0\0\0\0\x18\x08\x10\x5f\
+\x21\0\0\0\0\0\0\x55\x01\x01\0\0\0\0\0\x05\0\x17\xff\0\0\0\0\xbf\x73\0\0\0\0\0\
+\0\x79\xa1\x78\xff\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\
+\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x04\0\0\0\x55\0\x0f\xff\0\0\0\
+\0\x79\xa1\x78\xff\0\0\0\0\x15\x06\x09\xff\x2c\0\0\0\xb7\x02\0\0\x02\0\0\0\x15\
+\x06\x01\0\x33\0\0\0\xb7\x02\0\0\x03\0\0\0\x71\xa1\x99\xff\0\0\0\0\x6f\x21\0\0\
+\0\0\0\0\xbf\x92\0\0\0\0\0\0\x0f\x12\0\0\0\0\0\0\x07\x02\0\0\x38\0\0\0\x71\xa6\
+\x98\xff\0\0\0\0\x7b\x2a\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x25\x06\x01\
+\xff\x3c\0\0\0\xb7\x01\0\0\x01\0\0\0\x6f\x61\0\0\0\0\0\0\x18\x02\0\0\x01\0\0\0\
+\0\0\0\0\0\x18\x08\x10\x5f\x21\0\0\0\0\0\0\x79\xa2\x68\xff\0\0\0\0\x7b\x2a\x78\
+\xff\0\0\0\0\x55\x01\x01\0\0\0\0\0\x05\0\xf8\xfe\0\0\0\0\xbf\x73\0\0\0\0\0\0\
+\x79\xa9\x68\xff\0\0\0\0\x0f\x93\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\
+\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x04\0\0\0\x7b\x9a\x78\xff\0\0\
+\0\0\x55\0\xef\xfe\0\0\0\0\x79\xa1\x68\xff\0\0\0\0\x15\x06\xe9\xfe\x2c\0\0\0\
+\xb7\x02\0\0\x02\0\0\0\x15\x06\x01\0\x33\0\0\0\xb7\x02\0\0\x03\0\0\0\x71\xa1\
+\x99\xff\0\0\0\0\x6f\x21\0\0\0\0\0\0\x79\xa2\x68\xff\0\0\0\0\x0f\x12\0\0\0\0\0\
+\0\x07\x02\0\0\x08\0\0\0\x71\xa6\x98\xff\0\0\0\0\x7b\x2a\x68\xff\0\0\0\0\x7b\
+\x2a\x78\xff\0\0\0\0\x25\x06\xe1\xfe\x3c\0\0\0\xb7\x01\0\0\x01\0\0\0\x6f\x61\0\
+\0\0\0\0\0\x18\x02\0\0\x01\0\0\0\0\0\0\0\0\x18\x08\x10\x5f\x21\0\0\0\0\0\0\x79\
+\xa2\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x55\x01\x01\0\0\0\0\0\x05\0\xd8\
+\xfe\0\0\0\0\xbf\x73\0\0\0\0\0\0\x79\xa9\x68\xff\0\0\0\0\x0f\x93\0\0\0\0\0\0\
+\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\
+\0\x04\0\0\0\x7b\x9a\x78\xff\0\0\0\0\x55\0\xcf\xfe\0\0\0\0\x79\xa1\x68\xff\0\0\
+\0\0\x15\x06\xc9\xfe\x2c\0\0\0\xb7\x02\0\0\x02\0\0\0\x15\x06\x01\0\x33\0\0\0\
+\xb7\x02\0\0\x03\0\0\0\x71\xa1\x99\xff\0\0\0\0\x6f\x21\0\0\0\0\0\0\x79\xa2\x68\
+\xff\0\0\0\0\x0f\x12\0\0\0\0\0\0\x07\x02\0\0\x08\0\0\0\x71\xa6\x98\xff\0\0\0\0\
+\x7b\x2a\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x25\x06\xc1\xfe\x3c\0\0\0\xb7\
+\x01\0\0\x01\0\0\0\x6f\x61\0\0\0\0\0\0\x18\x02\0\0\x01\0\0\0\0\0\0\0\0\x18\x08\
+\x10\x5f\x21\0\0\0\0\0\0\x79\xa2\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x55\
+\x01\x01\0\0\0\0\0\x05\0\xb8\xfe\0\0\0\0\xbf\x73\0\0\0\0\0\0\x79\xa9\x68\xff\0\
+\0\0\0\x0f\x93\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\
+\x02\0\0\x02\0\0\0\x7b\x2a\x60\xff\0\0\0\0\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x04\
+\0\0\0\x7b\x9a\x78\xff\0\0\0\0\x55\0\xad\xfe\0\0\0\0\x79\xa1\x68\xff\0\0\0\0\
+\x15\x06\xa7\xfe\x2c\0\0\0\x7b\x7a\x58\xff\0\0\0\0\x15\x06\x02\0\x33\0\0\0\xb7\
+\x01\0\0\x03\0\0\0\x7b\x1a\x60\xff\0\0\0\0\x71\xa1\x99\xff\0\0\0\0\x79\xa2\x60\
+\xff\0\0\0\0\x6f\x21\0\0\0\0\0\0\x79\xa2\x68\xff\0\0\0\0\x0f\x12\0\0\0\0\0\0\
+\x07\x02\0\0\x08\0\0\0\x71\xa6\x98\xff\0\0\0\0\x7b\x2a\x68\xff\0\0\0\0\x7b\x2a\
+\x78\xff\0\0\0\0\x79\xa7\x58\xff\0\0\0\0\x25\x06\x9c\xfe\x3c\0\0\0\xb7\x01\0\0\
+\x01\0\0\0\x6f\x61\0\0\0\0\0\0\x18\x02\0\0\x01\0\0\0\0\0\0\0\0\x18\x08\x10\x5f\
+\x21\0\0\0\0\0\0\x79\xa2\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x55\x01\x01\0\
+\0\0\0\0\x05\0\x93\xfe\0\0\0\0\xbf\x73\0\0\0\0\0\0\x79\xa9\x68\xff\0\0\0\0\x0f\
+\x93\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\
+\x02\0\0\0\x7b\x2a\x60\xff\0\0\0\0\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x04\0\0\0\
+\x7b\x9a\x78\xff\0\0\0\0\x55\0\x88\xfe\0\0\0\0\x79\xa1\x68\xff\0\0\0\0\x15\x06\
+\x82\xfe\x2c\0\0\0\x15\x06\x02\0\x33\0\0\0\xb7\x01\0\0\x03\0\0\0\x7b\x1a\x60\
+\xff\0\0\0\0\x71\xa1\x99\xff\0\0\0\0\x79\xa2\x60\xff\0\0\0\0\x6f\x21\0\0\0\0\0\
+\0\x79\xa2\x68\xff\0\0\0\0\x0f\x12\0\0\0\0\0\0\x71\xa6\x98\xff\0\0\0\0\x07\x02\
+\0\0\x08\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x79\xa7\x58\xff\0\0\0\0\x05\0\x79\xfe\0\
+\0\0\0\xb7\x01\0\0\0\0\0\0\x6b\x1a\xbe\xff\0\0\0\0\x79\xa1\xd0\xff\0\0\0\0\x7b\
+\x1a\x98\xff\0\0\0\0\x79\xa1\xd8\xff\0\0\0\0\x7b\x1a\xa0\xff\0\0\0\0\x79\xa1\
+\xe0\xff\0\0\0\0\x7b\x1a\xa8\xff\0\0\0\0\x79\xa1\xe8\xff\0\0\0\0\x7b\x1a\xb0\
+\xff\0\0\0\0\x61\xa1\xf0\xff\0\0\0\0\x63\x1a\xb8\xff\0\0\0\0\x69\xa1\xf4\xff\0\
+\0\0\0\x6b\x1a\xbc\xff\0\0\0\0\x79\xa8\x88\xff\0\0\0\0\x63\x8a\xc0\xff\0\0\0\0\
+\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\x98\xff\xff\xff\xbf\xa3\0\0\0\0\0\0\x07\x03\0\
+\0\x96\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xb7\x04\0\0\x01\0\0\0\
+\x85\0\0\0\x02\0\0\0\xbf\x09\0\0\0\0\0\0\x67\x08\0\0\x01\0\0\0\x57\x08\0\0\x02\
+\0\0\0\x47\x08\0\0\x01\0\0\0\x27\x08\0\0\x03\0\0\0\xbf\x86\0\0\0\0\0\0\x63\x8a\
+\xfc\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xfc\xff\xff\xff\x18\x01\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\x01\0\0\0\0\0\xdb\x70\0\0\0\0\
+\0\0\xbf\x61\0\0\0\0\0\0\x07\x01\0\0\x01\0\0\0\x63\x1a\xfc\xff\0\0\0\0\xbf\xa2\
+\0\0\0\0\0\0\x07\x02\0\0\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x85\0\0\0\x01\0\0\0\x15\0\x02\0\0\0\0\0\xb7\x01\0\0\x01\0\0\0\xdb\x10\0\0\0\0\
+\0\0\x55\x09\x1b\xff\0\0\0\0\x07\x06\0\0\x02\0\0\0\x63\x6a\xfc\xff\0\0\0\0\xbf\
+\xa2\0\0\0\0\0\0\x07\x02\0\0\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\x85\0\0\0\x01\0\0\0\x15\0\x13\xff\0\0\0\0\xb7\x01\0\0\x01\0\0\0\xdb\x10\0\0\
+\0\0\0\0\x05\0\x10\xff\0\0\0\0\x79\xa1\xd8\xff\0\0\0\0\x7b\x1a\xa0\xff\0\0\0\0\
+\x79\xa1\xd0\xff\0\0\0\0\x7b\x1a\x98\xff\0\0\0\0\x79\xa7\x88\xff\0\0\0\0\x63\
+\x7a\xa8\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\x98\xff\xff\xff\xbf\xa3\0\
+\0\0\0\0\0\x07\x03\0\0\xcf\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xb7\
+\x04\0\0\x01\0\0\0\x85\0\0\0\x02\0\0\0\xbf\x09\0\0\0\0\0\0\x67\x07\0\0\x01\0\0\
+\0\x57\x07\0\0\x02\0\0\0\x27\x07\0\0\x03\0\0\0\xbf\x76\0\0\0\0\0\0\x63\x7a\xfc\
+\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\x01\0\0\0\0\0\xdb\x80\0\0\0\0\0\0\
+\xbf\x61\0\0\0\0\0\0\x47\x01\0\0\x01\0\0\0\x63\x1a\xfc\xff\0\0\0\0\xbf\xa2\0\0\
+\0\0\0\0\x07\x02\0\0\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\
+\0\0\x01\0\0\0\x15\0\x02\0\0\0\0\0\xb7\x01\0\0\x01\0\0\0\xdb\x10\0\0\0\0\0\0\
+\x55\x09\xe9\xfe\0\0\0\0\x07\x06\0\0\x02\0\0\0\x63\x6a\xfc\xff\0\0\0\0\xbf\xa2\
+\0\0\0\0\0\0\x07\x02\0\0\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x85\0\0\0\x01\0\0\0\x15\0\xe1\xfe\0\0\0\0\x05\0\xcd\xff\0\0\0\0\x79\x16\x10\0\
+\0\0\0\0\xb7\x01\0\0\xc0\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\
+\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x08\0\0\0\x85\0\0\0\x71\0\
+\0\0\xb7\x01\0\0\xb6\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\x79\xa7\x98\
+\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\
+\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\x98\xff\0\0\0\0\x0f\x17\0\0\0\0\0\0\xb7\x01\0\
+\0\x0c\0\0\0\x0f\x17\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\
+\xff\xb7\x02\0\0\x02\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa7\x98\
+\xff\0\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\0\0\0\0\0\0\x55\x01\
+\x16\0\0\0\0\0\xb7\x01\0\0\x10\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\
+\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x08\0\0\0\x85\0\0\
+\0\x71\0\0\0\xb7\x01\0\0\0\x01\0\0\x79\xa3\x98\xff\0\0\0\0\x0f\x13\0\0\0\0\0\0\
+\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xfc\xff\xff\xff\xb7\x02\0\0\x04\0\0\0\x85\0\0\
+\0\x71\0\0\0\x61\xa1\xfc\xff\0\0\0\0\x63\x1a\xd0\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\
+\0\x07\x02\0\0\xd0\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\
+\x01\0\0\0\x15\0\xf1\x01\0\0\0\0\xb7\x01\0\0\xb4\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\
+\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x08\0\0\
+\x02\0\0\0\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\x98\xff\0\0\0\0\
+\x15\x01\xe7\x01\0\0\0\0\xb7\x01\0\0\0\0\0\0\x63\x1a\x98\xff\0\0\0\0\x18\x01\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\0\0\0\0\0\0\x2d\x18\x16\0\0\0\0\0\x18\x01\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\0\0\0\0\0\0\x55\x01\x0c\0\0\0\0\0\x85\0\0\0\
+\x07\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\0\0\0\0\0\0\x67\0\0\0\
+\x20\0\0\0\x77\0\0\0\x20\0\0\0\xbf\x02\0\0\0\0\0\0\x3f\x12\0\0\0\0\0\0\x2f\x12\
+\0\0\0\0\0\0\x1f\x20\0\0\0\0\0\0\x15\0\x14\0\0\0\0\0\x05\0\xd1\x01\0\0\0\0\xbf\
+\xa2\0\0\0\0\0\0\x07\x02\0\0\x98\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\x85\0\0\0\x01\0\0\0\x55\0\x01\0\0\0\0\0\x05\0\x0c\0\0\0\0\0\x79\x01\0\0\0\0\
+\0\0\xbf\x12\0\0\0\0\0\0\x07\x02\0\0\x01\0\0\0\x7b\x20\0\0\0\0\0\0\x18\x02\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\x61\x22\0\0\0\0\0\0\xbf\x13\0\0\0\0\0\0\x3f\x23\0\0\0\
+\0\0\0\x2f\x23\0\0\0\0\0\0\x1f\x31\0\0\0\0\0\0\x55\x01\xbe\x01\0\0\0\0\x18\x08\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x81\0\0\0\0\0\0\x7b\x1a\x88\xff\0\0\0\0\x57\
+\x01\0\0\x01\0\0\0\x63\x1a\x98\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\x98\
+\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\xb7\x03\0\
+\0\0\0\0\0\x15\0\x19\0\0\0\0\0\xb7\x01\0\0\x01\0\0\0\xdb\x10\0\0\0\0\0\0\x61\
+\x81\0\0\0\0\0\0\x57\x01\0\0\x01\0\0\0\x61\xa2\x98\xff\0\0\0\0\xbf\x03\0\0\0\0\
+\0\0\x1d\x21\x12\0\0\0\0\0\xb7\x01\0\0\xff\xff\xff\xff\xdb\x10\0\0\0\0\0\0\x18\
+\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x11\0\0\0\0\0\0\x7b\x1a\x88\xff\0\0\0\0\
+\x57\x01\0\0\x01\0\0\0\x63\x1a\x98\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\
+\x98\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\xb7\
+\x03\0\0\0\0\0\0\x15\0\x03\0\0\0\0\0\xb7\x01\0\0\x01\0\0\0\xdb\x10\0\0\0\0\0\0\
+\xbf\x03\0\0\0\0\0\0\x15\x07\x70\0\x86\xdd\0\0\x55\x07\x93\x01\x08\0\0\0\x7b\
+\x3a\x80\xff\0\0\0\0\xb7\x01\0\0\xc0\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\
+\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x08\0\0\0\x85\
+\0\0\0\x71\0\0\0\xb7\x01\0\0\xb4\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\
+\x79\xa8\x98\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\
+\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa7\x98\xff\0\0\0\0\xb7\x01\0\0\0\0\
+\0\0\x7b\x1a\xd8\xff\0\0\0\0\x7b\x1a\xd0\xff\0\0\0\0\x79\xa1\x88\xff\0\0\0\0\
+\x57\x01\0\0\x01\0\0\0\xb7\x02\0\0\x01\0\0\0\x73\x2a\xcf\xff\0\0\0\0\x63\x1a\
+\xc8\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xc8\xff\xff\xff\x18\x01\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\xbf\x09\0\0\0\0\0\0\x0f\x78\0\0\0\0\
+\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xce\xff\xff\xff\xb7\x02\0\0\x01\0\0\0\xbf\
+\x83\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x71\xa1\xce\xff\0\0\0\0\x57\x01\0\0\xf0\0\
+\0\0\x55\x01\x6a\x01\x40\0\0\0\xb7\x01\0\0\x09\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\
+\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xdc\xff\xff\xff\xb7\x02\0\0\
+\x01\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x0c\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\
 \x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\
-\x10\0\0\0\x85\0\0\0\x04\0\0\0\xb7\x01\0\0\x18\0\0\0\x0f\x18\0\0\0\0\0\0\xbf\
-\xa1\0\0\0\0\0\0\x07\x01\0\0\xe0\xff\xff\xff\xb7\x02\0\0\x10\0\0\0\xbf\x83\0\0\
-\0\0\0\0\x85\0\0\0\x04\0\0\0\x71\xa1\xf4\xff\0\0\0\0\x15\x01\x01\0\x11\0\0\0\
-\x55\x01\x24\0\x06\0\0\0\xb7\x01\0\0\xb2\0\0\0\x0f\x16\0\0\0\0\0\0\xbf\xa1\0\0\
-\0\0\0\0\x07\x01\0\0\xf8\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x63\0\0\0\0\0\0\
-\x85\0\0\0\x71\0\0\0\x69\xa1\xf8\xff\0\0\0\0\x15\x01\x1b\0\0\0\0\0\xbf\xa1\0\0\
-\0\0\0\0\x07\x01\0\0\xf8\xff\xff\xff\xb7\x02\0\0\x08\0\0\0\xbf\x73\0\0\0\0\0\0\
-\x85\0\0\0\x71\0\0\0\x79\xa7\xf8\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\
-\xf8\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x63\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\
-\x69\xa1\xf8\xff\0\0\0\0\x0f\x17\0\0\0\0\0\0\xb7\x01\0\0\0\0\0\0\xbf\x73\0\0\0\
-\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xf0\xff\xff\xff\xb7\
-\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x02\0\0\0\x0f\x17\0\0\0\0\0\
-\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xf2\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\
-\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\
-\x11\0\0\0\0\0\0\x18\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x15\x01\x02\0\0\0\0\0\x18\
-\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\
-\xff\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x79\xa7\xb0\xff\0\0\0\0\x15\0\x12\
-\0\0\0\0\0\x05\0\x96\xff\0\0\0\0\x67\x07\0\0\x20\0\0\0\xc7\x07\0\0\x20\0\0\0\
-\x7b\x7a\xf8\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\
-\xa3\0\0\0\0\0\0\x07\x03\0\0\xf8\xff\xff\xff\xbf\x61\0\0\0\0\0\0\xb7\x04\0\0\
-\x01\0\0\0\x85\0\0\0\x02\0\0\0\x55\0\x17\0\xff\xff\xff\xff\xbf\xa2\0\0\0\0\0\0\
-\x07\x02\0\0\xd0\xff\xff\xff\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\x12\
-\0\0\0\0\0\x05\0\x10\0\0\0\0\0\x67\x07\0\0\x20\0\0\0\xc7\x07\0\0\x20\0\0\0\x7b\
-\x7a\xf8\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\xa3\0\
-\0\0\0\0\0\x07\x03\0\0\xf8\xff\xff\xff\xbf\x61\0\0\0\0\0\0\xb7\x04\0\0\x01\0\0\
-\0\x85\0\0\0\x02\0\0\0\x55\0\x06\0\xff\xff\xff\xff\xbf\xa2\0\0\0\0\0\0\x07\x02\
-\0\0\xd0\xff\xff\xff\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\x01\0\0\0\0\
-\0\xdb\x70\0\0\0\0\0\0\xb7\0\0\0\0\0\0\0\x95\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\0\x47\x50\x4c\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x04\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x10\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\
+\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xd4\xff\xff\xff\xb7\x02\0\0\
+\x04\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x02\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\
+\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xcc\xff\xff\xff\xb7\x02\0\0\
+\x02\0\0\0\x85\0\0\0\x71\0\0\0\xb7\x01\0\0\x06\0\0\0\xbf\x83\0\0\0\0\0\0\x0f\
+\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x96\xff\xff\xff\xb7\x02\0\0\
+\x02\0\0\0\x85\0\0\0\x71\0\0\0\x71\xa1\xdc\xff\0\0\0\0\x15\x01\x01\0\x06\0\0\0\
+\x55\x01\x0e\0\x11\0\0\0\x69\xa2\x96\xff\0\0\0\0\x57\x02\0\0\x1f\xff\0\0\x55\
+\x02\x0b\0\0\0\0\0\xb7\x01\0\0\xb2\0\0\0\xbf\x67\0\0\0\0\0\0\x0f\x17\0\0\0\0\0\
+\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\
+\x73\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\x98\xff\0\0\0\0\x55\x01\xe1\0\0\0\
+\0\0\x71\xa1\xdc\xff\0\0\0\0\xb7\x07\0\0\0\0\0\0\x55\x01\x0a\x01\x01\0\0\0\x69\
+\xa1\x96\xff\0\0\0\0\x57\x01\0\0\x1f\xff\0\0\x55\x01\x07\x01\0\0\0\0\x71\xa1\
+\xce\xff\0\0\0\0\x67\x01\0\0\x02\0\0\0\x57\x01\0\0\x3c\0\0\0\x0f\x18\0\0\0\0\0\
+\0\xb7\x07\0\0\0\0\0\0\x6b\x7a\x98\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\
+\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x83\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\
+\x69\xa1\x98\xff\0\0\0\0\x6b\x1a\xda\xff\0\0\0\0\x05\0\xf9\0\0\0\0\0\x7b\x3a\
+\x80\xff\0\0\0\0\xb7\x01\0\0\xc0\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\
+\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x08\0\0\0\x85\0\0\
+\0\x71\0\0\0\xb7\x01\0\0\xb4\0\0\0\x0f\x16\0\0\0\0\0\0\x79\xa7\x98\xff\0\0\0\0\
+\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\xbf\x63\
+\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa6\x98\xff\0\0\0\0\xb7\x08\0\0\0\0\0\0\
+\x6b\x8a\xf4\xff\0\0\0\0\x63\x8a\xf0\xff\0\0\0\0\x7b\x8a\xe8\xff\0\0\0\0\x7b\
+\x8a\xe0\xff\0\0\0\0\x7b\x8a\xd8\xff\0\0\0\0\x7b\x8a\xd0\xff\0\0\0\0\x79\xa1\
+\x88\xff\0\0\0\0\x57\x01\0\0\x01\0\0\0\xb7\x02\0\0\x01\0\0\0\x73\x2a\x96\xff\0\
+\0\0\0\x63\x1a\xc8\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xc8\xff\xff\xff\
+\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\xbf\x09\0\0\0\0\0\0\
+\x0f\x67\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xcf\xff\xff\xff\xb7\x02\0\
+\0\x01\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x71\xa1\xcf\xff\0\0\0\0\
+\x57\x01\0\0\xf0\0\0\0\x55\x01\xf7\0\x60\0\0\0\x7b\x9a\x70\xff\0\0\0\0\x79\xa1\
+\x80\xff\0\0\0\0\xb7\x01\0\0\x06\0\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\
+\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xce\xff\xff\xff\xb7\x02\0\0\x01\0\0\0\x85\0\0\
+\0\x71\0\0\0\xb7\x01\0\0\x28\0\0\0\x7b\x1a\x78\xff\0\0\0\0\x71\xa6\xce\xff\0\0\
+\0\0\x25\x06\x16\0\x3c\0\0\0\xb7\x01\0\0\x01\0\0\0\x6f\x61\0\0\0\0\0\0\x18\x02\
+\0\0\x01\0\0\0\0\0\0\0\0\x18\x08\x10\x5f\x21\0\0\0\0\0\0\x55\x01\x01\0\0\0\0\0\
+\x05\0\x0f\0\0\0\0\0\xbf\x73\0\0\0\0\0\0\x07\x03\0\0\x28\0\0\0\xbf\xa1\0\0\0\0\
+\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x09\0\0\x02\0\0\0\xb7\x02\0\0\x02\0\0\0\
+\x85\0\0\0\x04\0\0\0\x55\0\x07\0\0\0\0\0\x15\x06\xe1\0\x33\0\0\0\xb7\x01\0\0\
+\x28\0\0\0\x55\x06\xde\0\x2c\0\0\0\xb7\x08\0\0\x01\0\0\0\x71\xa6\x98\xff\0\0\0\
+\0\x07\x01\0\0\x08\0\0\0\x7b\x1a\x78\xff\0\0\0\0\x73\x6a\xf4\xff\0\0\0\0\xb7\
+\x01\0\0\x08\0\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\
+\x07\x01\0\0\xd0\xff\xff\xff\xb7\x02\0\0\x10\0\0\0\x85\0\0\0\x04\0\0\0\xb7\x01\
+\0\0\x18\0\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\
+\x01\0\0\xe0\xff\xff\xff\xb7\x02\0\0\x10\0\0\0\x85\0\0\0\x04\0\0\0\xb7\x01\0\0\
+\x04\0\0\0\xbf\x73\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\
+\0\0\xcc\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\x71\xa1\xf4\xff\
+\0\0\0\0\xb7\x02\0\0\x01\0\0\0\x55\x01\x01\0\x11\0\0\0\xb7\x02\0\0\0\0\0\0\xb7\
+\x03\0\0\x01\0\0\0\x55\x01\x01\0\x06\0\0\0\xb7\x03\0\0\0\0\0\0\x55\x08\x1f\0\0\
+\0\0\0\x5f\x23\0\0\0\0\0\0\x57\x03\0\0\x01\0\0\0\x55\x03\x1c\0\0\0\0\0\x79\xa1\
+\x78\xff\0\0\0\0\x0f\x17\0\0\0\0\0\0\xb7\x06\0\0\0\0\0\0\x63\x6a\x98\xff\0\0\0\
+\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x04\0\0\0\xbf\
+\x73\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x69\xa1\x98\xff\0\0\0\0\x6b\x1a\xf0\xff\0\
+\0\0\0\x69\xa1\x9a\xff\0\0\0\0\x6b\x1a\xf2\xff\0\0\0\0\x71\xa1\xf4\xff\0\0\0\0\
+\x73\x6a\xfc\xff\0\0\0\0\xb7\x06\0\0\x08\0\0\0\x79\xa9\x80\xff\0\0\0\0\x15\x01\
+\x1d\0\x11\0\0\0\x07\x07\0\0\x0c\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\xfc\xff\
+\xff\xff\xb7\x02\0\0\x01\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x71\xa6\
+\xfc\xff\0\0\0\0\x77\x06\0\0\x02\0\0\0\x57\x06\0\0\x3c\0\0\0\x05\0\x13\0\0\0\0\
+\0\xb7\x06\0\0\0\0\0\0\xb7\x02\0\0\x01\0\0\0\x79\xa9\x80\xff\0\0\0\0\x55\x01\
+\x01\0\x3a\0\0\0\xb7\x02\0\0\0\0\0\0\x4f\x28\0\0\0\0\0\0\x57\x08\0\0\x01\0\0\0\
+\x55\x08\x0b\0\0\0\0\0\x79\xa1\x78\xff\0\0\0\0\x0f\x17\0\0\0\0\0\0\xb7\x06\0\0\
+\0\0\0\0\x6b\x6a\x98\xff\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\
+\xff\xb7\x02\0\0\x02\0\0\0\xbf\x73\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x69\xa1\x98\
+\xff\0\0\0\0\x6b\x1a\xf2\xff\0\0\0\0\x69\xa1\xcc\xff\0\0\0\0\xdc\x01\0\0\x10\0\
+\0\0\x18\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x61\x23\0\0\0\0\0\0\x55\x03\x04\0\x01\
+\0\0\0\x07\x01\0\0\x36\0\0\0\xbf\x17\0\0\0\0\0\0\x79\xa3\x70\xff\0\0\0\0\x05\0\
+\x0b\0\0\0\0\0\x07\x01\0\0\x28\0\0\0\x61\x22\0\0\0\0\0\0\xbf\x17\0\0\0\0\0\0\
+\x79\xa3\x70\xff\0\0\0\0\x55\x02\x06\0\x02\0\0\0\x79\xa2\x78\xff\0\0\0\0\x0f\
+\x26\0\0\0\0\0\0\xb7\x07\0\0\0\0\0\0\x2d\x16\x02\0\0\0\0\0\x1f\x61\0\0\0\0\0\0\
+\xbf\x17\0\0\0\0\0\0\xbf\x36\0\0\0\0\0\0\x15\x06\x6d\0\0\0\0\0\x67\x07\0\0\x20\
+\0\0\0\x77\x07\0\0\x20\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\
+\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x7b\x7a\x98\xff\0\0\0\0\x55\0\x0d\0\0\
+\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\xa3\0\0\0\0\0\0\x07\
+\x03\0\0\x98\xff\xff\xff\xbf\x61\0\0\0\0\0\0\xb7\x04\0\0\x01\0\0\0\x85\0\0\0\
+\x02\0\0\0\x15\0\x5d\0\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\xff\xff\
+\xbf\x61\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\x0b\x01\0\0\0\0\xdb\x70\0\0\0\0\
+\0\0\x05\0\x56\0\0\0\0\0\xb7\x01\0\0\xc0\0\0\0\x0f\x16\0\0\0\0\0\0\xbf\xa1\0\0\
+\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xbf\x78\0\0\0\0\0\0\xb7\x07\0\0\x08\0\0\0\
+\xb7\x02\0\0\x08\0\0\0\xbf\x63\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x79\xa6\x98\xff\
+\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\
+\xbf\x83\0\0\0\0\0\0\x85\0\0\0\x71\0\0\0\x69\xa1\x98\xff\0\0\0\0\x0f\x16\0\0\0\
+\0\0\0\xb7\x01\0\0\0\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\
+\0\0\0\0\x07\x01\0\0\xd8\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\
+\xb7\x01\0\0\x02\0\0\0\xbf\x63\0\0\0\0\0\0\x0f\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\
+\0\0\x07\x01\0\0\xda\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x71\0\0\0\x71\
+\xa1\xdc\xff\0\0\0\0\xb7\x02\0\0\0\0\0\0\x73\x2a\x98\xff\0\0\0\0\x15\x01\x09\0\
+\x11\0\0\0\x07\x06\0\0\x0c\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\
+\xff\xb7\x02\0\0\x01\0\0\0\xbf\x63\0\0\0\0\0\0\x85\0\0\0\x04\0\0\0\x71\xa7\x98\
+\xff\0\0\0\0\x77\x07\0\0\x02\0\0\0\x57\x07\0\0\x3c\0\0\0\x69\xa1\xcc\xff\0\0\0\
+\0\xdc\x01\0\0\x10\0\0\0\x71\xa2\xce\xff\0\0\0\0\x18\x03\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\x61\x34\0\0\0\0\0\0\x55\x04\x02\0\x01\0\0\0\x07\x01\0\0\x0e\0\0\0\x05\0\
+\x09\0\0\0\0\0\x61\x33\0\0\0\0\0\0\xbf\x18\0\0\0\0\0\0\x55\x03\x07\0\x02\0\0\0\
+\x67\x02\0\0\x02\0\0\0\x57\x02\0\0\x3c\0\0\0\x0f\x27\0\0\0\0\0\0\xb7\x08\0\0\0\
+\0\0\0\x2d\x17\x02\0\0\0\0\0\x1f\x71\0\0\0\0\0\0\xbf\x18\0\0\0\0\0\0\x15\x09\
+\x16\0\0\0\0\0\x67\x08\0\0\x20\0\0\0\x77\x08\0\0\x20\0\0\0\xbf\xa2\0\0\0\0\0\0\
+\x07\x02\0\0\xd0\xff\xff\xff\xbf\x91\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x7b\x8a\
+\x98\xff\0\0\0\0\x55\0\x0d\0\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\xd0\xff\
+\xff\xff\xbf\xa3\0\0\0\0\0\0\x07\x03\0\0\x98\xff\xff\xff\xbf\x91\0\0\0\0\0\0\
+\xb7\x04\0\0\x01\0\0\0\x85\0\0\0\x02\0\0\0\x15\0\x06\0\0\0\0\0\xbf\xa2\0\0\0\0\
+\0\0\x07\x02\0\0\xd0\xff\xff\xff\xbf\x91\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\
+\xf1\0\0\0\0\0\xdb\x80\0\0\0\0\0\0\x79\xa3\x80\xff\0\0\0\0\x15\x03\x02\0\0\0\0\
+\0\xb7\x01\0\0\xff\xff\xff\xff\xdb\x13\0\0\0\0\0\0\xb7\0\0\0\0\0\0\0\x95\0\0\0\
+\0\0\0\0\xb7\x09\0\0\x03\0\0\0\x71\xa1\x99\xff\0\0\0\0\x6f\x91\0\0\0\0\0\0\xbf\
+\x19\0\0\0\0\0\0\x07\x01\0\0\x30\0\0\0\x7b\x1a\x78\xff\0\0\0\0\x71\xa6\x98\xff\
+\0\0\0\0\x25\x06\x1e\xff\x3c\0\0\0\xb7\x01\0\0\x01\0\0\0\x6f\x61\0\0\0\0\0\0\
+\x18\x02\0\0\x01\0\0\0\0\0\0\0\0\x18\x08\x10\x5f\x21\0\0\0\0\0\0\x55\x01\x01\0\
+\0\0\0\0\x05\0\x17\xff\0\0\0\0\xbf\x73\0\0\0\0\0\0\x79\xa1\x78\xff\0\0\0\0\x0f\
+\x13\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\
+\x02\0\0\0\x85\0\0\0\x04\0\0\0\x55\0\x0f\xff\0\0\0\0\x79\xa1\x78\xff\0\0\0\0\
+\x15\x06\x09\xff\x2c\0\0\0\xb7\x02\0\0\x02\0\0\0\x15\x06\x01\0\x33\0\0\0\xb7\
+\x02\0\0\x03\0\0\0\x71\xa1\x99\xff\0\0\0\0\x6f\x21\0\0\0\0\0\0\xbf\x92\0\0\0\0\
+\0\0\x0f\x12\0\0\0\0\0\0\x07\x02\0\0\x38\0\0\0\x71\xa6\x98\xff\0\0\0\0\x7b\x2a\
+\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x25\x06\x01\xff\x3c\0\0\0\xb7\x01\0\0\
+\x01\0\0\0\x6f\x61\0\0\0\0\0\0\x18\x02\0\0\x01\0\0\0\0\0\0\0\0\x18\x08\x10\x5f\
+\x21\0\0\0\0\0\0\x79\xa2\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x55\x01\x01\0\
+\0\0\0\0\x05\0\xf8\xfe\0\0\0\0\xbf\x73\0\0\0\0\0\0\x79\xa9\x68\xff\0\0\0\0\x0f\
+\x93\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\
+\x02\0\0\0\x85\0\0\0\x04\0\0\0\x7b\x9a\x78\xff\0\0\0\0\x55\0\xef\xfe\0\0\0\0\
+\x79\xa1\x68\xff\0\0\0\0\x15\x06\xe9\xfe\x2c\0\0\0\xb7\x02\0\0\x02\0\0\0\x15\
+\x06\x01\0\x33\0\0\0\xb7\x02\0\0\x03\0\0\0\x71\xa1\x99\xff\0\0\0\0\x6f\x21\0\0\
+\0\0\0\0\x79\xa2\x68\xff\0\0\0\0\x0f\x12\0\0\0\0\0\0\x07\x02\0\0\x08\0\0\0\x71\
+\xa6\x98\xff\0\0\0\0\x7b\x2a\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x25\x06\
+\xe1\xfe\x3c\0\0\0\xb7\x01\0\0\x01\0\0\0\x6f\x61\0\0\0\0\0\0\x18\x02\0\0\x01\0\
+\0\0\0\0\0\0\0\x18\x08\x10\x5f\x21\0\0\0\0\0\0\x79\xa2\x68\xff\0\0\0\0\x7b\x2a\
+\x78\xff\0\0\0\0\x55\x01\x01\0\0\0\0\0\x05\0\xd8\xfe\0\0\0\0\xbf\x73\0\0\0\0\0\
+\0\x79\xa9\x68\xff\0\0\0\0\x0f\x93\0\0\0\0\0\0\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\
+\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x04\0\0\0\x7b\x9a\x78\xff\0\0\
+\0\0\x55\0\xcf\xfe\0\0\0\0\x79\xa1\x68\xff\0\0\0\0\x15\x06\xc9\xfe\x2c\0\0\0\
+\xb7\x02\0\0\x02\0\0\0\x15\x06\x01\0\x33\0\0\0\xb7\x02\0\0\x03\0\0\0\x71\xa1\
+\x99\xff\0\0\0\0\x6f\x21\0\0\0\0\0\0\x79\xa2\x68\xff\0\0\0\0\x0f\x12\0\0\0\0\0\
+\0\x07\x02\0\0\x08\0\0\0\x71\xa6\x98\xff\0\0\0\0\x7b\x2a\x68\xff\0\0\0\0\x7b\
+\x2a\x78\xff\0\0\0\0\x25\x06\xc1\xfe\x3c\0\0\0\xb7\x01\0\0\x01\0\0\0\x6f\x61\0\
+\0\0\0\0\0\x18\x02\0\0\x01\0\0\0\0\0\0\0\0\x18\x08\x10\x5f\x21\0\0\0\0\0\0\x79\
+\xa2\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x55\x01\x01\0\0\0\0\0\x05\0\xb8\
+\xfe\0\0\0\0\xbf\x73\0\0\0\0\0\0\x79\xa9\x68\xff\0\0\0\0\x0f\x93\0\0\0\0\0\0\
+\xbf\xa1\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x7b\x2a\
+\x60\xff\0\0\0\0\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x04\0\0\0\x7b\x9a\x78\xff\0\0\
+\0\0\x55\0\xad\xfe\0\0\0\0\x79\xa1\x68\xff\0\0\0\0\x15\x06\xa7\xfe\x2c\0\0\0\
+\x7b\x7a\x58\xff\0\0\0\0\x15\x06\x02\0\x33\0\0\0\xb7\x01\0\0\x03\0\0\0\x7b\x1a\
+\x60\xff\0\0\0\0\x71\xa1\x99\xff\0\0\0\0\x79\xa2\x60\xff\0\0\0\0\x6f\x21\0\0\0\
+\0\0\0\x79\xa2\x68\xff\0\0\0\0\x0f\x12\0\0\0\0\0\0\x07\x02\0\0\x08\0\0\0\x71\
+\xa6\x98\xff\0\0\0\0\x7b\x2a\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x79\xa7\
+\x58\xff\0\0\0\0\x25\x06\x9c\xfe\x3c\0\0\0\xb7\x01\0\0\x01\0\0\0\x6f\x61\0\0\0\
+\0\0\0\x18\x02\0\0\x01\0\0\0\0\0\0\0\0\x18\x08\x10\x5f\x21\0\0\0\0\0\0\x79\xa2\
+\x68\xff\0\0\0\0\x7b\x2a\x78\xff\0\0\0\0\x55\x01\x01\0\0\0\0\0\x05\0\x93\xfe\0\
+\0\0\0\xbf\x73\0\0\0\0\0\0\x79\xa9\x68\xff\0\0\0\0\x0f\x93\0\0\0\0\0\0\xbf\xa1\
+\0\0\0\0\0\0\x07\x01\0\0\x98\xff\xff\xff\xb7\x02\0\0\x02\0\0\0\x7b\x2a\x60\xff\
+\0\0\0\0\xb7\x02\0\0\x02\0\0\0\x85\0\0\0\x04\0\0\0\x7b\x9a\x78\xff\0\0\0\0\x55\
+\0\x88\xfe\0\0\0\0\x79\xa1\x68\xff\0\0\0\0\x15\x06\x82\xfe\x2c\0\0\0\x15\x06\
+\x02\0\x33\0\0\0\xb7\x01\0\0\x03\0\0\0\x7b\x1a\x60\xff\0\0\0\0\x71\xa1\x99\xff\
+\0\0\0\0\x79\xa2\x60\xff\0\0\0\0\x6f\x21\0\0\0\0\0\0\x79\xa2\x68\xff\0\0\0\0\
+\x0f\x12\0\0\0\0\0\0\x71\xa6\x98\xff\0\0\0\0\x07\x02\0\0\x08\0\0\0\x7b\x2a\x78\
+\xff\0\0\0\0\x79\xa7\x58\xff\0\0\0\0\x05\0\x79\xfe\0\0\0\0\xb7\x01\0\0\0\0\0\0\
+\x6b\x1a\xbe\xff\0\0\0\0\x79\xa1\xd0\xff\0\0\0\0\x7b\x1a\x98\xff\0\0\0\0\x79\
+\xa1\xd8\xff\0\0\0\0\x7b\x1a\xa0\xff\0\0\0\0\x79\xa1\xe0\xff\0\0\0\0\x7b\x1a\
+\xa8\xff\0\0\0\0\x79\xa1\xe8\xff\0\0\0\0\x7b\x1a\xb0\xff\0\0\0\0\x61\xa1\xf0\
+\xff\0\0\0\0\x63\x1a\xb8\xff\0\0\0\0\x69\xa1\xf4\xff\0\0\0\0\x6b\x1a\xbc\xff\0\
+\0\0\0\x79\xa8\x88\xff\0\0\0\0\x63\x8a\xc0\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\
+\x02\0\0\x98\xff\xff\xff\xbf\xa3\0\0\0\0\0\0\x07\x03\0\0\x96\xff\xff\xff\x18\
+\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xb7\x04\0\0\x01\0\0\0\x85\0\0\0\x02\0\0\0\xbf\
+\x09\0\0\0\0\0\0\x67\x08\0\0\x01\0\0\0\x57\x08\0\0\x02\0\0\0\x47\x08\0\0\x01\0\
+\0\0\x27\x08\0\0\x03\0\0\0\xbf\x86\0\0\0\0\0\0\x63\x8a\xfc\xff\0\0\0\0\xbf\xa2\
+\0\0\0\0\0\0\x07\x02\0\0\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x85\0\0\0\x01\0\0\0\x15\0\x01\0\0\0\0\0\xdb\x70\0\0\0\0\0\0\xbf\x61\0\0\0\0\0\
+\0\x07\x01\0\0\x01\0\0\0\x63\x1a\xfc\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\
+\0\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\
+\0\x02\0\0\0\0\0\xb7\x01\0\0\x01\0\0\0\xdb\x10\0\0\0\0\0\0\x55\x09\x1b\xff\0\0\
+\0\0\x07\x06\0\0\x02\0\0\0\x63\x6a\xfc\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\
+\0\0\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\
+\x15\0\x13\xff\0\0\0\0\xb7\x01\0\0\x01\0\0\0\xdb\x10\0\0\0\0\0\0\x05\0\x10\xff\
+\0\0\0\0\x79\xa1\xd8\xff\0\0\0\0\x7b\x1a\xa0\xff\0\0\0\0\x79\xa1\xd0\xff\0\0\0\
+\0\x7b\x1a\x98\xff\0\0\0\0\x79\xa7\x88\xff\0\0\0\0\x63\x7a\xa8\xff\0\0\0\0\xbf\
+\xa2\0\0\0\0\0\0\x07\x02\0\0\x98\xff\xff\xff\xbf\xa3\0\0\0\0\0\0\x07\x03\0\0\
+\xcf\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xb7\x04\0\0\x01\0\0\0\x85\
+\0\0\0\x02\0\0\0\xbf\x09\0\0\0\0\0\0\x67\x07\0\0\x01\0\0\0\x57\x07\0\0\x02\0\0\
+\0\x27\x07\0\0\x03\0\0\0\xbf\x76\0\0\0\0\0\0\x63\x7a\xfc\xff\0\0\0\0\xbf\xa2\0\
+\0\0\0\0\0\x07\x02\0\0\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\
+\0\0\0\x01\0\0\0\x15\0\x01\0\0\0\0\0\xdb\x80\0\0\0\0\0\0\xbf\x61\0\0\0\0\0\0\
+\x47\x01\0\0\x01\0\0\0\x63\x1a\xfc\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\0\
+\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\0\
+\x02\0\0\0\0\0\xb7\x01\0\0\x01\0\0\0\xdb\x10\0\0\0\0\0\0\x55\x09\xe9\xfe\0\0\0\
+\0\x07\x06\0\0\x02\0\0\0\x63\x6a\xfc\xff\0\0\0\0\xbf\xa2\0\0\0\0\0\0\x07\x02\0\
+\0\xfc\xff\xff\xff\x18\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x85\0\0\0\x01\0\0\0\x15\
+\0\xe1\xfe\0\0\0\0\x05\0\xcd\xff\0\0\0\0\x01\0\0\0\0\0\0\0\x01\0\0\0\0\0\0\0\
+\x47\x50\x4c\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
 \0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
 \0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x9f\xeb\x01\0\x18\0\0\
-\0\0\0\0\0\xf8\x0f\0\0\xf8\x0f\0\0\x9c\x10\0\0\0\0\0\0\0\0\0\x02\x03\0\0\0\x01\
-\0\0\0\0\0\0\x01\x04\0\0\0\x20\0\0\x01\0\0\0\0\0\0\0\x03\0\0\0\0\x02\0\0\0\x04\
-\0\0\0\x01\0\0\0\x05\0\0\0\0\0\0\x01\x04\0\0\0\x20\0\0\0\0\0\0\0\0\0\0\x02\x06\
-\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x02\0\0\0\x04\0\0\0\0\x28\0\0\0\0\0\0\0\0\0\
-\x02\x08\0\0\0\x19\0\0\0\x05\0\0\x04\x10\0\0\0\x20\0\0\0\x09\0\0\0\0\0\0\0\x27\
-\0\0\0\x09\0\0\0\x20\0\0\0\x2e\0\0\0\x0c\0\0\0\x40\0\0\0\x37\0\0\0\x0c\0\0\0\
-\x50\0\0\0\x40\0\0\0\x0f\0\0\0\x60\0\0\0\x49\0\0\0\0\0\0\x08\x0a\0\0\0\x4d\0\0\
-\0\0\0\0\x08\x0b\0\0\0\x53\0\0\0\0\0\0\x01\x04\0\0\0\x20\0\0\0\x60\0\0\0\0\0\0\
-\x08\x0d\0\0\0\x64\0\0\0\0\0\0\x08\x0e\0\0\0\x6a\0\0\0\0\0\0\x01\x02\0\0\0\x10\
-\0\0\0\x79\0\0\0\0\0\0\x08\x10\0\0\0\x7c\0\0\0\0\0\0\x08\x11\0\0\0\x81\0\0\0\0\
-\0\0\x01\x01\0\0\0\x08\0\0\0\0\0\0\0\0\0\0\x02\x13\0\0\0\x8f\0\0\0\0\0\0\x08\
-\x14\0\0\0\x93\0\0\0\0\0\0\x08\x15\0\0\0\x99\0\0\0\0\0\0\x01\x08\0\0\0\x40\0\0\
-\0\xb0\0\0\0\x04\0\0\x04\x20\0\0\0\xbe\0\0\0\x01\0\0\0\0\0\0\0\xc3\0\0\0\x05\0\
-\0\0\x40\0\0\0\xcf\0\0\0\x07\0\0\0\x80\0\0\0\xd3\0\0\0\x12\0\0\0\xc0\0\0\0\xd9\
-\0\0\0\0\0\0\x0e\x16\0\0\0\x01\0\0\0\xe5\0\0\0\0\0\0\x0e\x16\0\0\0\x01\0\0\0\0\
-\0\0\0\0\0\0\x02\x1a\0\0\0\xf2\0\0\0\x05\0\0\x04\x26\0\0\0\x20\0\0\0\x1b\0\0\0\
-\0\0\0\0\x27\0\0\0\x1b\0\0\0\x80\0\0\0\x2e\0\0\0\x0c\0\0\0\0\x01\0\0\x37\0\0\0\
-\x0c\0\0\0\x10\x01\0\0\x40\0\0\0\x0f\0\0\0\x20\x01\0\0\0\0\0\0\0\0\0\x03\0\0\0\
-\0\x0f\0\0\0\x04\0\0\0\x10\0\0\0\xfa\0\0\0\x04\0\0\x04\x20\0\0\0\xbe\0\0\0\x01\
-\0\0\0\0\0\0\0\xc3\0\0\0\x05\0\0\0\x40\0\0\0\xcf\0\0\0\x19\0\0\0\x80\0\0\0\xd3\
-\0\0\0\x12\0\0\0\xc0\0\0\0\x09\x01\0\0\0\0\0\x0e\x1c\0\0\0\x01\0\0\0\x16\x01\0\
-\0\0\0\0\x0e\x1c\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\x02\x20\0\0\0\x24\x01\0\0\x05\0\
-\0\x04\x18\0\0\0\x45\x01\0\0\x21\0\0\0\0\0\0\0\x49\x01\0\0\x22\0\0\0\x40\0\0\0\
-\x51\x01\0\0\x0b\0\0\0\x80\0\0\0\x55\x01\0\0\x09\0\0\0\xa0\0\0\0\x65\x01\0\0\
-\x24\0\0\0\xc0\0\0\0\x6c\x01\0\0\x04\0\0\x04\x08\0\0\0\xbe\0\0\0\x0e\0\0\0\0\0\
-\0\0\x78\x01\0\0\x11\0\0\0\x10\0\0\0\x7e\x01\0\0\x11\0\0\0\x18\0\0\0\x8c\x01\0\
-\0\x02\0\0\0\x20\0\0\0\0\0\0\0\0\0\0\x02\0\0\0\0\x90\x01\0\0\0\0\0\x01\x01\0\0\
-\0\x08\0\0\x01\0\0\0\0\0\0\0\x03\0\0\0\0\x23\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\
-\x01\0\0\x0d\x02\0\0\0\x95\x01\0\0\x1f\0\0\0\x99\x01\0\0\x01\0\0\x0c\x25\0\0\0\
-\xcd\x02\0\0\x4d\0\0\x84\xe0\0\0\0\0\0\0\0\x28\0\0\0\0\0\0\0\0\0\0\0\x32\0\0\0\
-\xc0\0\0\0\0\0\0\0\x34\0\0\0\0\x01\0\0\xd5\x02\0\0\x39\0\0\0\x40\x01\0\0\0\0\0\
-\0\x3a\0\0\0\xc0\x02\0\0\xd8\x02\0\0\x2d\0\0\0\x40\x03\0\0\x51\x01\0\0\x0b\0\0\
-\0\x80\x03\0\0\xde\x02\0\0\x0b\0\0\0\xa0\x03\0\0\xe7\x02\0\0\x0d\0\0\0\xc0\x03\
-\0\0\xef\x02\0\0\x0d\0\0\0\xd0\x03\0\0\xf7\x02\0\0\x0d\0\0\0\xe0\x03\0\0\x05\
-\x03\0\0\x3e\0\0\0\xf0\x03\0\0\x15\x03\0\0\x10\0\0\0\xf0\x03\0\x01\x1c\x03\0\0\
-\x10\0\0\0\xf1\x03\0\x01\x22\x03\0\0\x10\0\0\0\xf2\x03\0\x02\x29\x03\0\0\x10\0\
-\0\0\xf4\x03\0\x01\x30\x03\0\0\x10\0\0\0\xf5\x03\0\x01\x3a\x03\0\0\x10\0\0\0\
-\xf6\x03\0\x01\x45\x03\0\0\x10\0\0\0\xf8\x03\0\0\x57\x03\0\0\x3f\0\0\0\0\x04\0\
-\0\x65\x03\0\0\x3e\0\0\0\0\x04\0\0\x77\x03\0\0\x10\0\0\0\0\x04\0\x03\x80\x03\0\
-\0\x10\0\0\0\x03\x04\0\x01\x8a\x03\0\0\x10\0\0\0\x04\x04\0\x01\x93\x03\0\0\x10\
-\0\0\0\x05\x04\0\x02\x9d\x03\0\0\x10\0\0\0\x07\x04\0\x01\xa6\x03\0\0\x10\0\0\0\
-\x08\x04\0\x01\xae\x03\0\0\x10\0\0\0\x09\x04\0\x01\xb6\x03\0\0\x10\0\0\0\x0a\
-\x04\0\x01\xc7\x03\0\0\x10\0\0\0\x0b\x04\0\x01\xd2\x03\0\0\x10\0\0\0\x0c\x04\0\
-\x01\xd9\x03\0\0\x10\0\0\0\x0d\x04\0\x01\xe7\x03\0\0\x10\0\0\0\x0e\x04\0\x01\
-\xf6\x03\0\0\x10\0\0\0\x0f\x04\0\x01\x01\x04\0\0\x3e\0\0\0\x10\x04\0\0\x1b\x04\
-\0\0\x10\0\0\0\x10\x04\0\x01\x28\x04\0\0\x10\0\0\0\x11\x04\0\x01\x39\x04\0\0\
-\x10\0\0\0\x12\x04\0\x02\x44\x04\0\0\x10\0\0\0\x14\x04\0\x01\x52\x04\0\0\x10\0\
-\0\0\x15\x04\0\x01\x66\x04\0\0\x10\0\0\0\x16\x04\0\x02\x75\x04\0\0\x10\0\0\0\
-\x18\x04\0\x01\x83\x04\0\0\x10\0\0\0\x19\x04\0\x01\x97\x04\0\0\x10\0\0\0\x1a\
-\x04\0\x01\xa7\x04\0\0\x10\0\0\0\x1b\x04\0\x01\xb8\x04\0\0\x10\0\0\0\x1c\x04\0\
-\x01\xcc\x04\0\0\x10\0\0\0\x1d\x04\0\x01\xdd\x04\0\0\x10\0\0\0\x1e\x04\0\x01\
-\xeb\x04\0\0\x10\0\0\0\x1f\x04\0\x01\xf6\x04\0\0\x10\0\0\0\x20\x04\0\x01\x03\
-\x05\0\0\x10\0\0\0\x21\x04\0\x01\x0d\x05\0\0\x0d\0\0\0\x30\x04\0\0\0\0\0\0\x40\
-\0\0\0\x40\x04\0\0\x16\x05\0\0\x0a\0\0\0\x60\x04\0\0\x1f\x05\0\0\x02\0\0\0\x80\
-\x04\0\0\x27\x05\0\0\x0a\0\0\0\xa0\x04\0\0\x2c\x05\0\0\x43\0\0\0\xc0\x04\0\0\
-\x37\x05\0\0\x0d\0\0\0\xd0\x04\0\0\0\0\0\0\x44\0\0\0\xe0\x04\0\0\x40\x05\0\0\
-\x0a\0\0\0\0\x05\0\0\0\0\0\0\x45\0\0\0\x20\x05\0\0\0\0\0\0\x46\0\0\0\x40\x05\0\
-\0\x48\x05\0\0\x0d\0\0\0\x50\x05\0\0\x5f\x05\0\0\x0d\0\0\0\x60\x05\0\0\x74\x05\
-\0\0\x0d\0\0\0\x70\x05\0\0\x40\0\0\0\x43\0\0\0\x80\x05\0\0\x85\x05\0\0\x0d\0\0\
-\0\x90\x05\0\0\x96\x05\0\0\x0d\0\0\0\xa0\x05\0\0\xa5\x05\0\0\x0d\0\0\0\xb0\x05\
-\0\0\xb0\x05\0\0\x3f\0\0\0\xc0\x05\0\0\xbc\x05\0\0\x47\0\0\0\xc0\x05\0\0\xc1\
-\x05\0\0\x47\0\0\0\xe0\x05\0\0\xc5\x05\0\0\x48\0\0\0\0\x06\0\0\xca\x05\0\0\x48\
-\0\0\0\x40\x06\0\0\xcf\x05\0\0\x0b\0\0\0\x80\x06\0\0\xd8\x05\0\0\x49\0\0\0\xa0\
-\x06\0\0\xde\x05\0\0\x4d\0\0\0\xc0\x06\0\0\0\0\0\0\x03\0\0\x05\x18\0\0\0\0\0\0\
-\0\x29\0\0\0\0\0\0\0\xe9\x05\0\0\x2e\0\0\0\0\0\0\0\xf0\x05\0\0\x30\0\0\0\0\0\0\
-\0\0\0\0\0\x03\0\0\x04\x18\0\0\0\xf5\x05\0\0\x2a\0\0\0\0\0\0\0\xfa\x05\0\0\x2a\
-\0\0\0\x40\0\0\0\0\0\0\0\x2b\0\0\0\x80\0\0\0\0\0\0\0\0\0\0\x02\x27\0\0\0\0\0\0\
-\0\x02\0\0\x05\x08\0\0\0\xff\x05\0\0\x2c\0\0\0\0\0\0\0\x03\x06\0\0\x2d\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\x02\x6f\0\0\0\x0f\x06\0\0\0\0\0\x01\x08\0\0\0\x40\0\0\0\
-\x21\x06\0\0\x03\0\0\x04\x18\0\0\0\x29\x06\0\0\x2d\0\0\0\0\0\0\0\x3b\x06\0\0\
-\x2f\0\0\0\x40\0\0\0\x44\x06\0\0\x2f\0\0\0\x80\0\0\0\0\0\0\0\0\0\0\x02\x2e\0\0\
-\0\x4c\x06\0\0\x02\0\0\x04\x10\0\0\0\xf5\x05\0\0\x31\0\0\0\0\0\0\0\xfa\x05\0\0\
-\x31\0\0\0\x40\0\0\0\0\0\0\0\0\0\0\x02\x30\0\0\0\0\0\0\0\x02\0\0\x05\x08\0\0\0\
-\x56\x06\0\0\x33\0\0\0\0\0\0\0\x59\x06\0\0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x02\
-\x71\0\0\0\0\0\0\0\x02\0\0\x05\x08\0\0\0\x6a\x06\0\0\x35\0\0\0\0\0\0\0\x71\x06\
-\0\0\x13\0\0\0\0\0\0\0\x7f\x06\0\0\0\0\0\x08\x36\0\0\0\x87\x06\0\0\0\0\0\x08\
-\x37\0\0\0\x8b\x06\0\0\0\0\0\x08\x38\0\0\0\x91\x06\0\0\0\0\0\x01\x08\0\0\0\x40\
-\0\0\x01\0\0\0\0\0\0\0\x03\0\0\0\0\x23\0\0\0\x04\0\0\0\x30\0\0\0\0\0\0\0\x02\0\
-\0\x05\x10\0\0\0\0\0\0\0\x3b\0\0\0\0\0\0\0\x9f\x06\0\0\x30\0\0\0\0\0\0\0\0\0\0\
-\0\x02\0\0\x04\x10\0\0\0\xb2\x06\0\0\x2d\0\0\0\0\0\0\0\xbe\x06\0\0\x3c\0\0\0\
-\x40\0\0\0\0\0\0\0\0\0\0\x02\x3d\0\0\0\0\0\0\0\x01\0\0\x0d\0\0\0\0\0\0\0\0\x2a\
-\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x10\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x03\
-\0\0\0\0\x0a\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\x02\0\0\x05\x04\0\0\0\xc9\x06\0\0\
-\x41\0\0\0\0\0\0\0\0\0\0\0\x42\0\0\0\0\0\0\0\xce\x06\0\0\0\0\0\x08\x0a\0\0\0\0\
-\0\0\0\x02\0\0\x04\x04\0\0\0\xd5\x06\0\0\x0d\0\0\0\0\0\0\0\xe0\x06\0\0\x0d\0\0\
-\0\x10\0\0\0\xec\x06\0\0\0\0\0\x08\x0d\0\0\0\0\0\0\0\x02\0\0\x05\x04\0\0\0\xf3\
-\x06\0\0\x0b\0\0\0\0\0\0\0\xfb\x06\0\0\x0b\0\0\0\0\0\0\0\0\0\0\0\x02\0\0\x05\
-\x04\0\0\0\x06\x07\0\0\x0a\0\0\0\0\0\0\0\x0b\x07\0\0\x0a\0\0\0\0\0\0\0\0\0\0\0\
-\x02\0\0\x05\x02\0\0\0\x1d\x07\0\0\x43\0\0\0\0\0\0\0\x2c\x07\0\0\x10\0\0\0\0\0\
-\0\0\x3a\x07\0\0\0\0\0\x08\x0b\0\0\0\0\0\0\0\0\0\0\x02\x11\0\0\0\x49\x07\0\0\0\
-\0\0\x08\x4a\0\0\0\x54\x07\0\0\x01\0\0\x04\x04\0\0\0\x64\x07\0\0\x4b\0\0\0\0\0\
-\0\0\x69\x07\0\0\0\0\0\x08\x4c\0\0\0\0\0\0\0\x01\0\0\x04\x04\0\0\0\x72\x07\0\0\
-\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x02\x70\0\0\0\xf7\x07\0\0\x03\0\0\x04\x0e\0\0\
-\0\xfe\x07\0\0\x4f\0\0\0\0\0\0\0\x05\x08\0\0\x4f\0\0\0\x30\0\0\0\x0e\x08\0\0\
-\x43\0\0\0\x60\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x11\0\0\0\x04\0\0\0\x06\0\0\0\
-\xc3\x09\0\0\x0b\0\0\x84\x14\0\0\0\xc9\x09\0\0\x10\0\0\0\0\0\0\x04\xcd\x09\0\0\
-\x10\0\0\0\x04\0\0\x04\xd5\x09\0\0\x10\0\0\0\x08\0\0\0\xd9\x09\0\0\x43\0\0\0\
-\x10\0\0\0\xe1\x09\0\0\x43\0\0\0\x20\0\0\0\xe4\x09\0\0\x43\0\0\0\x30\0\0\0\xed\
-\x09\0\0\x10\0\0\0\x40\0\0\0\x40\0\0\0\x10\0\0\0\x48\0\0\0\xf1\x09\0\0\x51\0\0\
-\0\x50\0\0\0\xf7\x09\0\0\x52\0\0\0\x60\0\0\0\xfd\x09\0\0\x52\0\0\0\x80\0\0\0\
-\x03\x0a\0\0\0\0\0\x08\x0d\0\0\0\x0b\x0a\0\0\0\0\0\x08\x0a\0\0\0\x96\x0b\0\0\
-\x11\0\0\x84\x14\0\0\0\x9d\x0b\0\0\x43\0\0\0\0\0\0\0\xa4\x0b\0\0\x43\0\0\0\x10\
-\0\0\0\xa9\x0b\0\0\x52\0\0\0\x20\0\0\0\xad\x0b\0\0\x52\0\0\0\x40\0\0\0\xb5\x0b\
-\0\0\x0d\0\0\0\x60\0\0\x04\xba\x0b\0\0\x0d\0\0\0\x64\0\0\x04\xbf\x0b\0\0\x0d\0\
-\0\0\x68\0\0\x01\xc3\x0b\0\0\x0d\0\0\0\x69\0\0\x01\xc7\x0b\0\0\x0d\0\0\0\x6a\0\
-\0\x01\xcb\x0b\0\0\x0d\0\0\0\x6b\0\0\x01\xcf\x0b\0\0\x0d\0\0\0\x6c\0\0\x01\xd3\
-\x0b\0\0\x0d\0\0\0\x6d\0\0\x01\xd7\x0b\0\0\x0d\0\0\0\x6e\0\0\x01\xdb\x0b\0\0\
-\x0d\0\0\0\x6f\0\0\x01\xdf\x0b\0\0\x43\0\0\0\x70\0\0\0\xf1\x09\0\0\x51\0\0\0\
-\x80\0\0\0\xe6\x0b\0\0\x43\0\0\0\x90\0\0\0\x6c\x0d\0\0\x08\0\0\x84\x28\0\0\0\
-\x16\x05\0\0\x10\0\0\0\0\0\0\x04\xcd\x09\0\0\x10\0\0\0\x04\0\0\x04\x74\x0d\0\0\
-\x55\0\0\0\x08\0\0\0\x7d\x0d\0\0\x43\0\0\0\x20\0\0\0\x89\x0d\0\0\x10\0\0\0\x30\
-\0\0\0\x91\x0d\0\0\x10\0\0\0\x38\0\0\0\xf7\x09\0\0\x56\0\0\0\x40\0\0\0\xfd\x09\
-\0\0\x56\0\0\0\xc0\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x10\0\0\0\x04\0\0\0\x03\0\0\
-\0\x9b\x0d\0\0\x01\0\0\x04\x10\0\0\0\xa4\x0d\0\0\x57\0\0\0\0\0\0\0\0\0\0\0\x03\
-\0\0\x05\x10\0\0\0\xaa\x0d\0\0\x58\0\0\0\0\0\0\0\xb3\x0d\0\0\x59\0\0\0\0\0\0\0\
-\xbd\x0d\0\0\x5a\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x10\0\0\0\x04\0\0\0\
-\x10\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x43\0\0\0\x04\0\0\0\x08\0\0\0\0\0\0\0\0\0\
-\0\x03\0\0\0\0\x52\0\0\0\x04\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\x02\x5c\0\0\0\x11\
-\x0f\0\0\x13\0\0\x04\x40\0\0\0\x45\x01\0\0\x21\0\0\0\0\0\0\0\x55\x01\0\0\x09\0\
-\0\0\x40\0\0\0\xf7\x02\0\0\x0c\0\0\0\x60\0\0\0\x49\x01\0\0\x5d\0\0\0\x80\0\0\0\
-\x34\x0f\0\0\x5f\0\0\0\xc0\0\0\0\x2c\x05\0\0\x0c\0\0\0\xd0\0\0\0\x37\x05\0\0\
-\x0c\0\0\0\xe0\0\0\0\x40\0\0\0\x0c\0\0\0\xf0\0\0\0\x93\x03\0\0\x0f\0\0\0\0\x01\
-\0\0\x51\x01\0\0\x0b\0\0\0\x20\x01\0\0\xde\x02\0\0\x0b\0\0\0\x40\x01\0\0\x40\
-\x0f\0\0\x02\0\0\0\x60\x01\0\0\x4f\x0f\0\0\x5f\0\0\0\x80\x01\0\0\x66\x0f\0\0\
-\x02\0\0\0\xa0\x01\0\0\x77\x0f\0\0\x0f\0\0\0\xc0\x01\0\0\x80\x0f\0\0\x0c\0\0\0\
-\xd0\x01\0\0\x89\x0f\0\0\x0c\0\0\0\xe0\x01\0\0\x92\x0f\0\0\x0c\0\0\0\xf0\x01\0\
-\0\x65\x01\0\0\x24\0\0\0\0\x02\0\0\0\0\0\0\0\0\0\x02\x5e\0\0\0\0\0\0\0\0\0\0\
-\x0a\0\0\0\0\x9b\x0f\0\0\0\0\0\x08\x60\0\0\0\xa0\x0f\0\0\0\0\0\x01\x01\0\0\0\
-\x08\0\0\x04\0\0\0\0\x01\0\0\x0d\x02\0\0\0\x95\x01\0\0\x5b\0\0\0\xa6\x0f\0\0\
-\x01\0\0\x0c\x61\0\0\0\0\0\0\0\0\0\0\x0a\x64\0\0\0\0\0\0\0\0\0\0\x09\x23\0\0\0\
-\0\0\0\0\0\0\0\x03\0\0\0\0\x63\0\0\0\x04\0\0\0\x10\0\0\0\x4e\x10\0\0\0\0\0\x0e\
-\x65\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\x09\x02\0\0\0\x59\x10\0\0\0\0\0\x0e\x67\0\0\
-\0\x01\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x23\0\0\0\x04\0\0\0\x04\0\0\0\x61\x10\0\
-\0\0\0\0\x0e\x69\0\0\0\x01\0\0\0\x69\x10\0\0\x01\0\0\x0f\0\0\0\0\x68\0\0\0\0\0\
-\0\0\x04\0\0\0\x6e\x10\0\0\x04\0\0\x0f\0\0\0\0\x17\0\0\0\0\0\0\0\x20\0\0\0\x18\
-\0\0\0\0\0\0\0\x20\0\0\0\x1d\0\0\0\0\0\0\0\x20\0\0\0\x1e\0\0\0\0\0\0\0\x20\0\0\
-\0\x74\x10\0\0\x01\0\0\x0f\0\0\0\0\x66\0\0\0\0\0\0\0\x10\0\0\0\x7c\x10\0\0\x01\
-\0\0\x0f\0\0\0\0\x6a\0\0\0\0\0\0\0\x04\0\0\0\x84\x10\0\0\0\0\0\x07\0\0\0\0\x8f\
-\x10\0\0\0\0\0\x07\0\0\0\0\x97\x10\0\0\0\0\0\x07\0\0\0\0\0\x69\x6e\x74\0\x5f\
-\x5f\x41\x52\x52\x41\x59\x5f\x53\x49\x5a\x45\x5f\x54\x59\x50\x45\x5f\x5f\0\x63\
-\x6f\x6e\x6e\x5f\x73\0\x73\x72\x63\x5f\x69\x70\0\x64\x73\x74\x5f\x69\x70\0\x73\
-\x72\x63\x5f\x70\x6f\x72\x74\0\x64\x73\x74\x5f\x70\x6f\x72\x74\0\x70\x72\x6f\
-\x74\x6f\x63\x6f\x6c\0\x75\x33\x32\0\x5f\x5f\x75\x33\x32\0\x75\x6e\x73\x69\x67\
-\x6e\x65\x64\x20\x69\x6e\x74\0\x75\x31\x36\0\x5f\x5f\x75\x31\x36\0\x75\x6e\x73\
-\x69\x67\x6e\x65\x64\x20\x73\x68\x6f\x72\x74\0\x75\x38\0\x5f\x5f\x75\x38\0\x75\
-\x6e\x73\x69\x67\x6e\x65\x64\x20\x63\x68\x61\x72\0\x75\x36\x34\0\x5f\x5f\x75\
-\x36\x34\0\x6c\x6f\x6e\x67\x20\x6c\x6f\x6e\x67\x20\x75\x6e\x73\x69\x67\x6e\x65\
-\x64\x20\x69\x6e\x74\0\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x73\x5f\x73\0\
-\x74\x79\x70\x65\0\x6d\x61\x78\x5f\x65\x6e\x74\x72\x69\x65\x73\0\x6b\x65\x79\0\
-\x76\x61\x6c\x75\x65\0\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x73\0\x62\x63\
-\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x73\0\x63\x6f\x6e\x6e\x36\x5f\x73\0\x63\
-\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x73\x36\x5f\x73\0\x63\x6f\x6e\x6e\x65\x63\
-\x74\x69\x6f\x6e\x73\x36\0\x62\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x73\x36\
-\0\x74\x72\x61\x63\x65\x5f\x65\x76\x65\x6e\x74\x5f\x72\x61\x77\x5f\x6e\x65\x74\
-\x5f\x64\x65\x76\x5f\x74\x65\x6d\x70\x6c\x61\x74\x65\0\x65\x6e\x74\0\x73\x6b\
-\x62\x61\x64\x64\x72\0\x6c\x65\x6e\0\x5f\x5f\x64\x61\x74\x61\x5f\x6c\x6f\x63\
-\x5f\x6e\x61\x6d\x65\0\x5f\x5f\x64\x61\x74\x61\0\x74\x72\x61\x63\x65\x5f\x65\
-\x6e\x74\x72\x79\0\x66\x6c\x61\x67\x73\0\x70\x72\x65\x65\x6d\x70\x74\x5f\x63\
-\x6f\x75\x6e\x74\0\x70\x69\x64\0\x63\x68\x61\x72\0\x63\x74\x78\0\x74\x72\x61\
-\x63\x65\x70\x6f\x69\x6e\x74\x5f\x5f\x6e\x65\x74\x5f\x6e\x65\x74\x69\x66\x5f\
-\x72\x65\x63\x65\x69\x76\x65\x5f\x73\x6b\x62\0\x74\x72\x61\x63\x65\x70\x6f\x69\
-\x6e\x74\x2f\x6e\x65\x74\x2f\x6e\x65\x74\x69\x66\x5f\x72\x65\x63\x65\x69\x76\
-\x65\x5f\x73\x6b\x62\0\x2f\x68\x6f\x6d\x65\x2f\x63\x68\x72\x69\x2f\x66\x6c\x6f\
-\x77\x73\x6e\x6f\x6f\x70\x2f\x65\x62\x70\x66\x32\x2f\x63\x2f\x66\x6c\x6f\x77\
-\x73\x6e\x6f\x6f\x70\x32\x2e\x63\0\x69\x6e\x74\x20\x74\x72\x61\x63\x65\x70\x6f\
-\x69\x6e\x74\x5f\x5f\x6e\x65\x74\x5f\x6e\x65\x74\x69\x66\x5f\x72\x65\x63\x65\
-\x69\x76\x65\x5f\x73\x6b\x62\x28\0\x20\x20\x63\x68\x61\x72\x20\x64\x65\x76\x5b\
-\x31\x36\x5d\x20\x3d\x20\x7b\0\x30\x3a\x31\0\x20\x20\x73\x74\x72\x75\x63\x74\
-\x20\x73\x6b\x5f\x62\x75\x66\x66\x20\x2a\x73\x6b\x62\x20\x3d\x20\x28\x73\x74\
-\x72\x75\x63\x74\x20\x73\x6b\x5f\x62\x75\x66\x66\x20\x2a\x29\x63\x74\x78\x2d\
-\x3e\x73\x6b\x62\x61\x64\x64\x72\x3b\0\x30\x3a\x33\0\x20\x20\x54\x50\x5f\x44\
-\x41\x54\x41\x5f\x4c\x4f\x43\x5f\x52\x45\x41\x44\x5f\x43\x4f\x4e\x53\x54\x28\
-\x64\x65\x76\x2c\x20\x6e\x61\x6d\x65\x2c\x20\x31\x36\x29\x3b\0\x30\x3a\x32\0\
-\x20\x20\x64\x6f\x5f\x63\x6f\x75\x6e\x74\x28\x73\x6b\x62\x2c\x20\x63\x74\x78\
-\x2d\x3e\x6c\x65\x6e\x2c\x20\x64\x65\x76\x29\x3b\0\x73\x6b\x5f\x62\x75\x66\x66\
-\0\x63\x62\0\x5f\x6e\x66\x63\x74\0\x64\x61\x74\x61\x5f\x6c\x65\x6e\0\x6d\x61\
-\x63\x5f\x6c\x65\x6e\0\x68\x64\x72\x5f\x6c\x65\x6e\0\x71\x75\x65\x75\x65\x5f\
-\x6d\x61\x70\x70\x69\x6e\x67\0\x5f\x5f\x63\x6c\x6f\x6e\x65\x64\x5f\x6f\x66\x66\
-\x73\x65\x74\0\x63\x6c\x6f\x6e\x65\x64\0\x6e\x6f\x68\x64\x72\0\x66\x63\x6c\x6f\
-\x6e\x65\0\x70\x65\x65\x6b\x65\x64\0\x68\x65\x61\x64\x5f\x66\x72\x61\x67\0\x70\
-\x66\x6d\x65\x6d\x61\x6c\x6c\x6f\x63\0\x61\x63\x74\x69\x76\x65\x5f\x65\x78\x74\
-\x65\x6e\x73\x69\x6f\x6e\x73\0\x68\x65\x61\x64\x65\x72\x73\x5f\x73\x74\x61\x72\
-\x74\0\x5f\x5f\x70\x6b\x74\x5f\x74\x79\x70\x65\x5f\x6f\x66\x66\x73\x65\x74\0\
-\x70\x6b\x74\x5f\x74\x79\x70\x65\0\x69\x67\x6e\x6f\x72\x65\x5f\x64\x66\0\x6e\
-\x66\x5f\x74\x72\x61\x63\x65\0\x69\x70\x5f\x73\x75\x6d\x6d\x65\x64\0\x6f\x6f\
-\x6f\x5f\x6f\x6b\x61\x79\0\x6c\x34\x5f\x68\x61\x73\x68\0\x73\x77\x5f\x68\x61\
-\x73\x68\0\x77\x69\x66\x69\x5f\x61\x63\x6b\x65\x64\x5f\x76\x61\x6c\x69\x64\0\
-\x77\x69\x66\x69\x5f\x61\x63\x6b\x65\x64\0\x6e\x6f\x5f\x66\x63\x73\0\x65\x6e\
-\x63\x61\x70\x73\x75\x6c\x61\x74\x69\x6f\x6e\0\x65\x6e\x63\x61\x70\x5f\x68\x64\
-\x72\x5f\x63\x73\x75\x6d\0\x63\x73\x75\x6d\x5f\x76\x61\x6c\x69\x64\0\x5f\x5f\
-\x70\x6b\x74\x5f\x76\x6c\x61\x6e\x5f\x70\x72\x65\x73\x65\x6e\x74\x5f\x6f\x66\
-\x66\x73\x65\x74\0\x76\x6c\x61\x6e\x5f\x70\x72\x65\x73\x65\x6e\x74\0\x63\x73\
-\x75\x6d\x5f\x63\x6f\x6d\x70\x6c\x65\x74\x65\x5f\x73\x77\0\x63\x73\x75\x6d\x5f\
-\x6c\x65\x76\x65\x6c\0\x63\x73\x75\x6d\x5f\x6e\x6f\x74\x5f\x69\x6e\x65\x74\0\
-\x64\x73\x74\x5f\x70\x65\x6e\x64\x69\x6e\x67\x5f\x63\x6f\x6e\x66\x69\x72\x6d\0\
-\x6e\x64\x69\x73\x63\x5f\x6e\x6f\x64\x65\x74\x79\x70\x65\0\x69\x70\x76\x73\x5f\
-\x70\x72\x6f\x70\x65\x72\x74\x79\0\x69\x6e\x6e\x65\x72\x5f\x70\x72\x6f\x74\x6f\
-\x63\x6f\x6c\x5f\x74\x79\x70\x65\0\x72\x65\x6d\x63\x73\x75\x6d\x5f\x6f\x66\x66\
-\x6c\x6f\x61\x64\0\x6f\x66\x66\x6c\x6f\x61\x64\x5f\x66\x77\x64\x5f\x6d\x61\x72\
-\x6b\0\x6f\x66\x66\x6c\x6f\x61\x64\x5f\x6c\x33\x5f\x66\x77\x64\x5f\x6d\x61\x72\
-\x6b\0\x74\x63\x5f\x73\x6b\x69\x70\x5f\x63\x6c\x61\x73\x73\x69\x66\x79\0\x74\
-\x63\x5f\x61\x74\x5f\x69\x6e\x67\x72\x65\x73\x73\0\x72\x65\x64\x69\x72\x65\x63\
-\x74\x65\x64\0\x66\x72\x6f\x6d\x5f\x69\x6e\x67\x72\x65\x73\x73\0\x64\x65\x63\
-\x72\x79\x70\x74\x65\x64\0\x74\x63\x5f\x69\x6e\x64\x65\x78\0\x70\x72\x69\x6f\
-\x72\x69\x74\x79\0\x73\x6b\x62\x5f\x69\x69\x66\0\x68\x61\x73\x68\0\x76\x6c\x61\
-\x6e\x5f\x70\x72\x6f\x74\x6f\0\x76\x6c\x61\x6e\x5f\x74\x63\x69\0\x73\x65\x63\
-\x6d\x61\x72\x6b\0\x69\x6e\x6e\x65\x72\x5f\x74\x72\x61\x6e\x73\x70\x6f\x72\x74\
-\x5f\x68\x65\x61\x64\x65\x72\0\x69\x6e\x6e\x65\x72\x5f\x6e\x65\x74\x77\x6f\x72\
-\x6b\x5f\x68\x65\x61\x64\x65\x72\0\x69\x6e\x6e\x65\x72\x5f\x6d\x61\x63\x5f\x68\
-\x65\x61\x64\x65\x72\0\x74\x72\x61\x6e\x73\x70\x6f\x72\x74\x5f\x68\x65\x61\x64\
-\x65\x72\0\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x68\x65\x61\x64\x65\x72\0\x6d\x61\
-\x63\x5f\x68\x65\x61\x64\x65\x72\0\x68\x65\x61\x64\x65\x72\x73\x5f\x65\x6e\x64\
-\0\x74\x61\x69\x6c\0\x65\x6e\x64\0\x68\x65\x61\x64\0\x64\x61\x74\x61\0\x74\x72\
-\x75\x65\x73\x69\x7a\x65\0\x75\x73\x65\x72\x73\0\x65\x78\x74\x65\x6e\x73\x69\
-\x6f\x6e\x73\0\x72\x62\x6e\x6f\x64\x65\0\x6c\x69\x73\x74\0\x6e\x65\x78\x74\0\
-\x70\x72\x65\x76\0\x64\x65\x76\0\x64\x65\x76\x5f\x73\x63\x72\x61\x74\x63\x68\0\
-\x6c\x6f\x6e\x67\x20\x75\x6e\x73\x69\x67\x6e\x65\x64\x20\x69\x6e\x74\0\x72\x62\
-\x5f\x6e\x6f\x64\x65\0\x5f\x5f\x72\x62\x5f\x70\x61\x72\x65\x6e\x74\x5f\x63\x6f\
-\x6c\x6f\x72\0\x72\x62\x5f\x72\x69\x67\x68\x74\0\x72\x62\x5f\x6c\x65\x66\x74\0\
-\x6c\x69\x73\x74\x5f\x68\x65\x61\x64\0\x73\x6b\0\x69\x70\x5f\x64\x65\x66\x72\
-\x61\x67\x5f\x6f\x66\x66\x73\x65\x74\0\x74\x73\x74\x61\x6d\x70\0\x73\x6b\x62\
-\x5f\x6d\x73\x74\x61\x6d\x70\x5f\x6e\x73\0\x6b\x74\x69\x6d\x65\x5f\x74\0\x73\
-\x36\x34\0\x5f\x5f\x73\x36\x34\0\x6c\x6f\x6e\x67\x20\x6c\x6f\x6e\x67\x20\x69\
-\x6e\x74\0\x74\x63\x70\x5f\x74\x73\x6f\x72\x74\x65\x64\x5f\x61\x6e\x63\x68\x6f\
-\x72\0\x5f\x73\x6b\x62\x5f\x72\x65\x66\x64\x73\x74\0\x64\x65\x73\x74\x72\x75\
-\x63\x74\x6f\x72\0\x63\x73\x75\x6d\0\x5f\x5f\x77\x73\x75\x6d\0\x63\x73\x75\x6d\
-\x5f\x73\x74\x61\x72\x74\0\x63\x73\x75\x6d\x5f\x6f\x66\x66\x73\x65\x74\0\x5f\
-\x5f\x62\x65\x31\x36\0\x6e\x61\x70\x69\x5f\x69\x64\0\x73\x65\x6e\x64\x65\x72\
-\x5f\x63\x70\x75\0\x6d\x61\x72\x6b\0\x72\x65\x73\x65\x72\x76\x65\x64\x5f\x74\
-\x61\x69\x6c\x72\x6f\x6f\x6d\0\x69\x6e\x6e\x65\x72\x5f\x70\x72\x6f\x74\x6f\x63\
-\x6f\x6c\0\x69\x6e\x6e\x65\x72\x5f\x69\x70\x70\x72\x6f\x74\x6f\0\x73\x6b\x5f\
-\x62\x75\x66\x66\x5f\x64\x61\x74\x61\x5f\x74\0\x72\x65\x66\x63\x6f\x75\x6e\x74\
-\x5f\x74\0\x72\x65\x66\x63\x6f\x75\x6e\x74\x5f\x73\x74\x72\x75\x63\x74\0\x72\
-\x65\x66\x73\0\x61\x74\x6f\x6d\x69\x63\x5f\x74\0\x63\x6f\x75\x6e\x74\x65\x72\0\
-\x30\x3a\x37\x32\0\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x28\x73\x74\x72\x75\x63\
-\x74\x20\x65\x74\x68\x68\x64\x72\x20\x2a\x29\x28\x42\x50\x46\x5f\x43\x4f\x52\
-\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x68\x65\x61\x64\x29\x20\x2b\0\
-\x30\x3a\x36\x38\0\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\
-\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x42\x50\x46\x5f\x43\x4f\
-\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x6d\x61\x63\x5f\x68\x65\
-\x61\x64\x65\x72\x29\x29\x3b\0\x65\x74\x68\x68\x64\x72\0\x68\x5f\x64\x65\x73\
-\x74\0\x68\x5f\x73\x6f\x75\x72\x63\x65\0\x68\x5f\x70\x72\x6f\x74\x6f\0\x20\x20\
-\x75\x31\x36\x20\x70\x72\x6f\x74\x20\x3d\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\
-\x5f\x52\x45\x41\x44\x28\x68\x64\x72\x2c\x20\x68\x5f\x70\x72\x6f\x74\x6f\x29\
-\x3b\0\x20\x20\x69\x66\x20\x28\x77\x61\x6e\x74\x5b\x30\x5d\x20\x3d\x3d\x20\x27\
-\x5c\x30\x27\x29\0\x20\x20\x20\x20\x69\x66\x20\x28\x67\x6f\x74\x5b\x69\x5d\x20\
-\x21\x3d\x20\x77\x61\x6e\x74\x5b\x69\x5d\x29\0\x20\x20\x20\x20\x69\x66\x20\x28\
-\x67\x6f\x74\x5b\x69\x5d\x20\x3d\x3d\x20\x27\x5c\x30\x27\x29\0\x30\x3a\x36\x37\
-\0\x20\x20\x69\x66\x20\x28\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\
-\x28\x73\x6b\x62\x2c\x20\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x68\x65\x61\x64\x65\
-\x72\x29\x20\x3d\x3d\x20\x30\x29\0\x20\x20\x69\x66\x20\x28\x70\x72\x6f\x74\x20\
-\x3d\x3d\x20\x62\x70\x66\x5f\x68\x74\x6f\x6e\x73\x28\x45\x54\x48\x5f\x50\x5f\
-\x49\x50\x29\x29\0\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x28\x73\x74\x72\x75\x63\
-\x74\x20\x69\x70\x68\x64\x72\x20\x2a\x29\x28\x42\x50\x46\x5f\x43\x4f\x52\x45\
-\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x68\x65\x61\x64\x29\x20\x2b\0\x20\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x9f\xeb\x01\
+\0\x18\0\0\0\0\0\0\0\x14\x30\0\0\x14\x30\0\0\x75\x2e\0\0\0\0\0\0\0\0\0\x02\x03\
+\0\0\0\x01\0\0\0\0\0\0\x01\x04\0\0\0\x20\0\0\x01\0\0\0\0\0\0\0\x03\0\0\0\0\x02\
+\0\0\0\x04\0\0\0\x0c\0\0\0\x05\0\0\0\0\0\0\x01\x04\0\0\0\x20\0\0\0\0\0\0\0\0\0\
+\0\x02\x06\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x02\0\0\0\x04\0\0\0\x02\0\0\0\0\0\0\
+\0\0\0\0\x02\x08\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x02\0\0\0\x04\0\0\0\x04\0\0\0\
+\0\0\0\0\x04\0\0\x04\x20\0\0\0\x19\0\0\0\x01\0\0\0\0\0\0\0\x1e\0\0\0\x05\0\0\0\
+\x40\0\0\0\x2a\0\0\0\x07\0\0\0\x80\0\0\0\x33\0\0\0\x07\0\0\0\xc0\0\0\0\x3e\0\0\
+\0\0\0\0\x0e\x09\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\x02\x0c\0\0\0\0\0\0\0\0\0\0\x03\
+\0\0\0\0\x02\0\0\0\x04\0\0\0\x06\0\0\0\0\0\0\0\0\0\0\x02\x0e\0\0\0\x4a\0\0\0\0\
+\0\0\x08\x0f\0\0\0\x4e\0\0\0\0\0\0\x08\x10\0\0\0\x54\0\0\0\0\0\0\x01\x04\0\0\0\
+\x20\0\0\0\0\0\0\0\0\0\0\x02\x12\0\0\0\x61\0\0\0\0\0\0\x08\x13\0\0\0\x65\0\0\0\
+\0\0\0\x08\x14\0\0\0\x6b\0\0\0\0\0\0\x01\x08\0\0\0\x40\0\0\0\0\0\0\0\x04\0\0\
+\x04\x20\0\0\0\x19\0\0\0\x0b\0\0\0\0\0\0\0\x1e\0\0\0\x05\0\0\0\x40\0\0\0\x7e\0\
+\0\0\x0d\0\0\0\x80\0\0\0\x82\0\0\0\x11\0\0\0\xc0\0\0\0\x88\0\0\0\0\0\0\x0e\x15\
+\0\0\0\x01\0\0\0\0\0\0\0\x04\0\0\x04\x20\0\0\0\x19\0\0\0\x01\0\0\0\0\0\0\0\x1e\
+\0\0\0\x05\0\0\0\x40\0\0\0\x2a\0\0\0\x07\0\0\0\x80\0\0\0\x33\0\0\0\x07\0\0\0\
+\xc0\0\0\0\x8f\0\0\0\0\0\0\x0e\x17\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\x02\x1a\0\0\0\
+\0\0\0\0\0\0\0\x03\0\0\0\0\x02\0\0\0\x04\0\0\0\x09\0\0\0\0\0\0\0\0\0\0\x02\x1c\
+\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x02\0\0\0\x04\0\0\0\0\x04\0\0\0\0\0\0\0\0\0\
+\x02\x1e\0\0\0\x9c\0\0\0\x02\0\0\x04\x14\0\0\0\xa4\0\0\0\x1f\0\0\0\0\0\0\0\xa9\
+\0\0\0\x0e\0\0\0\x80\0\0\0\xad\0\0\0\x05\0\0\x04\x10\0\0\0\xb4\0\0\0\x0e\0\0\0\
+\0\0\0\0\xbb\0\0\0\x0e\0\0\0\x20\0\0\0\xc2\0\0\0\x20\0\0\0\x40\0\0\0\xcb\0\0\0\
+\x20\0\0\0\x50\0\0\0\xd4\0\0\0\x23\0\0\0\x60\0\0\0\xdd\0\0\0\0\0\0\x08\x21\0\0\
+\0\xe1\0\0\0\0\0\0\x08\x22\0\0\0\xe7\0\0\0\0\0\0\x01\x02\0\0\0\x10\0\0\0\xf6\0\
+\0\0\0\0\0\x08\x24\0\0\0\xf9\0\0\0\0\0\0\x08\x25\0\0\0\xfe\0\0\0\0\0\0\x01\x01\
+\0\0\0\x08\0\0\0\0\0\0\0\0\0\0\x02\x23\0\0\0\0\0\0\0\x04\0\0\x04\x20\0\0\0\x19\
+\0\0\0\x19\0\0\0\0\0\0\0\x1e\0\0\0\x1b\0\0\0\x40\0\0\0\x7e\0\0\0\x1d\0\0\0\x80\
+\0\0\0\x82\0\0\0\x26\0\0\0\xc0\0\0\0\x0c\x01\0\0\0\0\0\x0e\x27\0\0\0\x01\0\0\0\
+\0\0\0\0\0\0\0\x02\x2a\0\0\0\x12\x01\0\0\x02\0\0\x04\x2c\0\0\0\xa4\0\0\0\x2b\0\
+\0\0\0\0\0\0\xa9\0\0\0\x0e\0\0\0\x40\x01\0\0\x1a\x01\0\0\x05\0\0\x04\x26\0\0\0\
+\xb4\0\0\0\x2c\0\0\0\0\0\0\0\xbb\0\0\0\x2c\0\0\0\x80\0\0\0\xc2\0\0\0\x20\0\0\0\
+\0\x01\0\0\xcb\0\0\0\x20\0\0\0\x10\x01\0\0\xd4\0\0\0\x23\0\0\0\x20\x01\0\0\0\0\
+\0\0\0\0\0\x03\0\0\0\0\x23\0\0\0\x04\0\0\0\x10\0\0\0\0\0\0\0\x04\0\0\x04\x20\0\
+\0\0\x19\0\0\0\x19\0\0\0\0\0\0\0\x1e\0\0\0\x1b\0\0\0\x40\0\0\0\x7e\0\0\0\x29\0\
+\0\0\x80\0\0\0\x82\0\0\0\x26\0\0\0\xc0\0\0\0\x22\x01\0\0\0\0\0\x0e\x2d\0\0\0\
+\x01\0\0\0\0\0\0\0\x04\0\0\x04\x20\0\0\0\x19\0\0\0\x0b\0\0\0\0\0\0\0\x1e\0\0\0\
+\x01\0\0\0\x40\0\0\0\x7e\0\0\0\x0d\0\0\0\x80\0\0\0\x82\0\0\0\x11\0\0\0\xc0\0\0\
+\0\x28\x01\0\0\0\0\0\x0e\x2f\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\x02\x32\0\0\0\0\0\0\
+\0\0\0\0\x03\0\0\0\0\x02\0\0\0\x04\0\0\0\x01\0\0\0\0\0\0\0\x04\0\0\x04\x20\0\0\
+\0\x19\0\0\0\x0b\0\0\0\0\0\0\0\x1e\0\0\0\x31\0\0\0\x40\0\0\0\x7e\0\0\0\x0d\0\0\
+\0\x80\0\0\0\x82\0\0\0\x11\0\0\0\xc0\0\0\0\x31\x01\0\0\0\0\0\x0e\x33\0\0\0\x01\
+\0\0\0\0\0\0\0\x04\0\0\x04\x20\0\0\0\x19\0\0\0\x31\0\0\0\0\0\0\0\x1e\0\0\0\x1b\
+\0\0\0\x40\0\0\0\x7e\0\0\0\x0d\0\0\0\x80\0\0\0\x82\0\0\0\x26\0\0\0\xc0\0\0\0\
+\x38\x01\0\0\0\0\0\x0e\x35\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\x02\x38\0\0\0\x40\x01\
+\0\0\x05\0\0\x04\x18\0\0\0\x61\x01\0\0\x39\0\0\0\0\0\0\0\x65\x01\0\0\x3a\0\0\0\
+\x40\0\0\0\x6d\x01\0\0\x10\0\0\0\x80\0\0\0\x71\x01\0\0\x0e\0\0\0\xa0\0\0\0\x81\
+\x01\0\0\x3c\0\0\0\xc0\0\0\0\x88\x01\0\0\x04\0\0\x04\x08\0\0\0\x19\0\0\0\x22\0\
+\0\0\0\0\0\0\x94\x01\0\0\x25\0\0\0\x10\0\0\0\x9a\x01\0\0\x25\0\0\0\x18\0\0\0\
+\xa8\x01\0\0\x02\0\0\0\x20\0\0\0\0\0\0\0\0\0\0\x02\0\0\0\0\xac\x01\0\0\0\0\0\
+\x01\x01\0\0\0\x08\0\0\x01\0\0\0\0\0\0\0\x03\0\0\0\0\x3b\0\0\0\x04\0\0\0\0\0\0\
+\0\0\0\0\0\x01\0\0\x0d\x02\0\0\0\xb1\x01\0\0\x37\0\0\0\xb5\x01\0\0\x01\0\0\x0c\
+\x3d\0\0\0\x49\x02\0\0\x4d\0\0\x84\xe0\0\0\0\0\0\0\0\x40\0\0\0\0\0\0\0\0\0\0\0\
+\x4a\0\0\0\xc0\0\0\0\0\0\0\0\x4c\0\0\0\0\x01\0\0\x51\x02\0\0\x51\0\0\0\x40\x01\
+\0\0\0\0\0\0\x52\0\0\0\xc0\x02\0\0\x54\x02\0\0\x45\0\0\0\x40\x03\0\0\x6d\x01\0\
+\0\x10\0\0\0\x80\x03\0\0\x5a\x02\0\0\x10\0\0\0\xa0\x03\0\0\x63\x02\0\0\x21\0\0\
+\0\xc0\x03\0\0\x6b\x02\0\0\x21\0\0\0\xd0\x03\0\0\x73\x02\0\0\x21\0\0\0\xe0\x03\
+\0\0\x81\x02\0\0\x56\0\0\0\xf0\x03\0\0\x91\x02\0\0\x24\0\0\0\xf0\x03\0\x01\x98\
+\x02\0\0\x24\0\0\0\xf1\x03\0\x01\x9e\x02\0\0\x24\0\0\0\xf2\x03\0\x02\xa5\x02\0\
+\0\x24\0\0\0\xf4\x03\0\x01\xac\x02\0\0\x24\0\0\0\xf5\x03\0\x01\xb6\x02\0\0\x24\
+\0\0\0\xf6\x03\0\x01\xc1\x02\0\0\x24\0\0\0\xf8\x03\0\0\xd3\x02\0\0\x57\0\0\0\0\
+\x04\0\0\xe1\x02\0\0\x56\0\0\0\0\x04\0\0\xf3\x02\0\0\x24\0\0\0\0\x04\0\x03\xfc\
+\x02\0\0\x24\0\0\0\x03\x04\0\x01\x06\x03\0\0\x24\0\0\0\x04\x04\0\x01\x0f\x03\0\
+\0\x24\0\0\0\x05\x04\0\x02\x19\x03\0\0\x24\0\0\0\x07\x04\0\x01\x22\x03\0\0\x24\
+\0\0\0\x08\x04\0\x01\x2a\x03\0\0\x24\0\0\0\x09\x04\0\x01\x32\x03\0\0\x24\0\0\0\
+\x0a\x04\0\x01\x43\x03\0\0\x24\0\0\0\x0b\x04\0\x01\x4e\x03\0\0\x24\0\0\0\x0c\
+\x04\0\x01\x55\x03\0\0\x24\0\0\0\x0d\x04\0\x01\x63\x03\0\0\x24\0\0\0\x0e\x04\0\
+\x01\x72\x03\0\0\x24\0\0\0\x0f\x04\0\x01\x7d\x03\0\0\x56\0\0\0\x10\x04\0\0\x97\
+\x03\0\0\x24\0\0\0\x10\x04\0\x01\xa4\x03\0\0\x24\0\0\0\x11\x04\0\x01\xb5\x03\0\
+\0\x24\0\0\0\x12\x04\0\x02\xc0\x03\0\0\x24\0\0\0\x14\x04\0\x01\xce\x03\0\0\x24\
+\0\0\0\x15\x04\0\x01\xe2\x03\0\0\x24\0\0\0\x16\x04\0\x02\xf1\x03\0\0\x24\0\0\0\
+\x18\x04\0\x01\xff\x03\0\0\x24\0\0\0\x19\x04\0\x01\x13\x04\0\0\x24\0\0\0\x1a\
+\x04\0\x01\x23\x04\0\0\x24\0\0\0\x1b\x04\0\x01\x34\x04\0\0\x24\0\0\0\x1c\x04\0\
+\x01\x48\x04\0\0\x24\0\0\0\x1d\x04\0\x01\x59\x04\0\0\x24\0\0\0\x1e\x04\0\x01\
+\x67\x04\0\0\x24\0\0\0\x1f\x04\0\x01\x72\x04\0\0\x24\0\0\0\x20\x04\0\x01\x7f\
+\x04\0\0\x24\0\0\0\x21\x04\0\x01\x89\x04\0\0\x21\0\0\0\x30\x04\0\0\0\0\0\0\x58\
+\0\0\0\x40\x04\0\0\x92\x04\0\0\x0f\0\0\0\x60\x04\0\0\x9b\x04\0\0\x02\0\0\0\x80\
+\x04\0\0\xa3\x04\0\0\x0f\0\0\0\xa0\x04\0\0\xa8\x04\0\0\x5b\0\0\0\xc0\x04\0\0\
+\xb3\x04\0\0\x21\0\0\0\xd0\x04\0\0\0\0\0\0\x5c\0\0\0\xe0\x04\0\0\xbc\x04\0\0\
+\x0f\0\0\0\0\x05\0\0\0\0\0\0\x5d\0\0\0\x20\x05\0\0\0\0\0\0\x5e\0\0\0\x40\x05\0\
+\0\xc4\x04\0\0\x21\0\0\0\x50\x05\0\0\xdb\x04\0\0\x21\0\0\0\x60\x05\0\0\xf0\x04\
+\0\0\x21\0\0\0\x70\x05\0\0\xd4\0\0\0\x5b\0\0\0\x80\x05\0\0\x01\x05\0\0\x21\0\0\
+\0\x90\x05\0\0\x12\x05\0\0\x21\0\0\0\xa0\x05\0\0\x21\x05\0\0\x21\0\0\0\xb0\x05\
+\0\0\x2c\x05\0\0\x57\0\0\0\xc0\x05\0\0\x38\x05\0\0\x5f\0\0\0\xc0\x05\0\0\x3d\
+\x05\0\0\x5f\0\0\0\xe0\x05\0\0\x41\x05\0\0\x60\0\0\0\0\x06\0\0\x46\x05\0\0\x60\
+\0\0\0\x40\x06\0\0\x4b\x05\0\0\x10\0\0\0\x80\x06\0\0\x54\x05\0\0\x61\0\0\0\xa0\
+\x06\0\0\x5a\x05\0\0\x65\0\0\0\xc0\x06\0\0\0\0\0\0\x03\0\0\x05\x18\0\0\0\0\0\0\
+\0\x41\0\0\0\0\0\0\0\x65\x05\0\0\x46\0\0\0\0\0\0\0\x6c\x05\0\0\x48\0\0\0\0\0\0\
+\0\0\0\0\0\x03\0\0\x04\x18\0\0\0\x71\x05\0\0\x42\0\0\0\0\0\0\0\x76\x05\0\0\x42\
+\0\0\0\x40\0\0\0\0\0\0\0\x43\0\0\0\x80\0\0\0\0\0\0\0\0\0\0\x02\x3f\0\0\0\0\0\0\
+\0\x02\0\0\x05\x08\0\0\0\x7b\x05\0\0\x44\0\0\0\0\0\0\0\x7f\x05\0\0\x45\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\x02\x68\0\0\0\x8b\x05\0\0\0\0\0\x01\x08\0\0\0\x40\0\0\0\
+\x99\x05\0\0\x03\0\0\x04\x18\0\0\0\xa1\x05\0\0\x45\0\0\0\0\0\0\0\xb3\x05\0\0\
+\x47\0\0\0\x40\0\0\0\xbc\x05\0\0\x47\0\0\0\x80\0\0\0\0\0\0\0\0\0\0\x02\x46\0\0\
+\0\xc4\x05\0\0\x02\0\0\x04\x10\0\0\0\x71\x05\0\0\x49\0\0\0\0\0\0\0\x76\x05\0\0\
+\x49\0\0\0\x40\0\0\0\0\0\0\0\0\0\0\x02\x48\0\0\0\0\0\0\0\x02\0\0\x05\x08\0\0\0\
+\xce\x05\0\0\x4b\0\0\0\0\0\0\0\xd1\x05\0\0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x02\
+\x7a\x01\0\0\0\0\0\0\x02\0\0\x05\x08\0\0\0\xe2\x05\0\0\x4d\0\0\0\0\0\0\0\xe9\
+\x05\0\0\x12\0\0\0\0\0\0\0\xf7\x05\0\0\0\0\0\x08\x4e\0\0\0\xff\x05\0\0\0\0\0\
+\x08\x4f\0\0\0\x03\x06\0\0\0\0\0\x08\x50\0\0\0\x09\x06\0\0\0\0\0\x01\x08\0\0\0\
+\x40\0\0\x01\0\0\0\0\0\0\0\x03\0\0\0\0\x3b\0\0\0\x04\0\0\0\x30\0\0\0\0\0\0\0\
+\x02\0\0\x05\x10\0\0\0\0\0\0\0\x53\0\0\0\0\0\0\0\x13\x06\0\0\x48\0\0\0\0\0\0\0\
+\0\0\0\0\x02\0\0\x04\x10\0\0\0\x26\x06\0\0\x45\0\0\0\0\0\0\0\x32\x06\0\0\x54\0\
+\0\0\x40\0\0\0\0\0\0\0\0\0\0\x02\x55\0\0\0\0\0\0\0\x01\0\0\x0d\0\0\0\0\0\0\0\0\
+\x42\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x24\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x03\0\0\0\0\x0f\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\x02\0\0\x05\x04\0\0\0\x3d\x06\
+\0\0\x59\0\0\0\0\0\0\0\0\0\0\0\x5a\0\0\0\0\0\0\0\x42\x06\0\0\0\0\0\x08\x0f\0\0\
+\0\0\0\0\0\x02\0\0\x04\x04\0\0\0\x49\x06\0\0\x21\0\0\0\0\0\0\0\x54\x06\0\0\x21\
+\0\0\0\x10\0\0\0\x60\x06\0\0\0\0\0\x08\x21\0\0\0\0\0\0\0\x02\0\0\x05\x04\0\0\0\
+\x67\x06\0\0\x10\0\0\0\0\0\0\0\x6f\x06\0\0\x10\0\0\0\0\0\0\0\0\0\0\0\x02\0\0\
+\x05\x04\0\0\0\x7a\x06\0\0\x0f\0\0\0\0\0\0\0\x7f\x06\0\0\x0f\0\0\0\0\0\0\0\0\0\
+\0\0\x02\0\0\x05\x02\0\0\0\x91\x06\0\0\x5b\0\0\0\0\0\0\0\xa0\x06\0\0\x24\0\0\0\
+\0\0\0\0\xae\x06\0\0\0\0\0\x08\x10\0\0\0\0\0\0\0\0\0\0\x02\x25\0\0\0\xbd\x06\0\
+\0\0\0\0\x08\x62\0\0\0\xc8\x06\0\0\x01\0\0\x04\x04\0\0\0\xd8\x06\0\0\x63\0\0\0\
+\0\0\0\0\xdd\x06\0\0\0\0\0\x08\x64\0\0\0\0\0\0\0\x01\0\0\x04\x04\0\0\0\xe6\x06\
+\0\0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x02\x79\x01\0\0\x6b\x07\0\0\x03\0\0\x04\
+\x0e\0\0\0\x72\x07\0\0\x67\0\0\0\0\0\0\0\x79\x07\0\0\x67\0\0\0\x30\0\0\0\x82\
+\x07\0\0\x5b\0\0\0\x60\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x25\0\0\0\x04\0\0\0\x06\
+\0\0\0\x0b\x08\0\0\x93\0\0\x84\x40\x09\0\0\x16\x08\0\0\x69\0\0\0\0\0\0\0\x1b\
+\x08\0\0\x6a\0\0\0\x80\0\0\0\x25\x08\0\0\x6b\0\0\0\xc0\0\0\0\x2d\x08\0\0\x45\0\
+\0\0\0\x01\0\0\x35\x08\0\0\x45\0\0\0\x40\x01\0\0\x3f\x08\0\0\x45\0\0\0\x80\x01\
+\0\0\x49\x08\0\0\x02\0\0\0\xc0\x01\0\0\x4d\x08\0\0\x45\0\0\0\0\x02\0\0\x53\x08\
+\0\0\x48\0\0\0\x40\x02\0\0\x5c\x08\0\0\x48\0\0\0\xc0\x02\0\0\x66\x08\0\0\x48\0\
+\0\0\x40\x03\0\0\x71\x08\0\0\x48\0\0\0\xc0\x03\0\0\x7c\x08\0\0\x48\0\0\0\x40\
+\x04\0\0\x86\x08\0\0\x48\0\0\0\xc0\x04\0\0\x95\x08\0\0\x6c\0\0\0\x40\x05\0\0\
+\x9e\x08\0\0\x6d\0\0\0\x40\x06\0\0\xa7\x08\0\0\x6d\0\0\0\x80\x06\0\0\xb3\x08\0\
+\0\x6d\0\0\0\xc0\x06\0\0\xc3\x08\0\0\x6d\0\0\0\0\x07\0\0\xd1\x08\0\0\x6d\0\0\0\
+\x40\x07\0\0\xe1\x08\0\0\x6d\0\0\0\x80\x07\0\0\xef\x08\0\0\x6d\0\0\0\xc0\x07\0\
+\0\x04\x09\0\0\x02\0\0\0\0\x08\0\0\x0c\x09\0\0\x02\0\0\0\x20\x08\0\0\x12\x09\0\
+\0\x6e\0\0\0\x40\x08\0\0\x18\x09\0\0\x6f\0\0\0\0\x0e\0\0\x23\x09\0\0\x6f\0\0\0\
+\x40\x0e\0\0\x2e\x09\0\0\x6f\0\0\0\x80\x0e\0\0\x3b\x09\0\0\x63\0\0\0\xc0\x0e\0\
+\0\x4c\x09\0\0\x63\0\0\0\xe0\x0e\0\0\x5f\x09\0\0\x72\0\0\0\0\x0f\0\0\x71\x09\0\
+\0\x74\0\0\0\x40\x0f\0\0\x7f\x09\0\0\x75\0\0\0\x80\x0f\0\0\x8a\x09\0\0\x77\0\0\
+\0\xc0\x0f\0\0\x96\x09\0\0\x79\0\0\0\0\x10\0\0\xa1\x09\0\0\x7b\0\0\0\x40\x10\0\
+\0\xab\x09\0\0\x7d\0\0\0\x80\x10\0\0\xb7\x09\0\0\x7f\0\0\0\xc0\x10\0\0\xc2\x09\
+\0\0\x81\0\0\0\0\x11\0\0\x94\x01\0\0\x10\0\0\0\x40\x11\0\0\xcd\x09\0\0\x10\0\0\
+\0\x60\x11\0\0\xd8\x09\0\0\x22\0\0\0\x80\x11\0\0\xdf\x09\0\0\x22\0\0\0\x90\x11\
+\0\0\xe6\x09\0\0\x25\0\0\0\xa0\x11\0\0\xf0\x09\0\0\x25\0\0\0\xa8\x11\0\0\xfa\
+\x09\0\0\x25\0\0\0\xb0\x11\0\0\x02\x0a\0\0\x25\0\0\0\xb8\x11\0\0\x06\x0a\0\0\
+\x10\0\0\0\xc0\x11\0\0\x0a\x0a\0\0\x10\0\0\0\xe0\x11\0\0\x12\x0a\0\0\x10\0\0\0\
+\0\x12\0\0\x19\0\0\0\x22\0\0\0\x20\x12\0\0\x1a\x0a\0\0\x22\0\0\0\x30\x12\0\0\
+\x2a\x0a\0\0\x25\0\0\0\x40\x12\0\0\x39\x0a\0\0\x25\0\0\0\x48\x12\0\0\x4a\x0a\0\
+\0\x22\0\0\0\x50\x12\0\0\x5a\x0a\0\0\x22\0\0\0\x60\x12\0\0\x6a\x0a\0\0\x83\0\0\
+\0\x70\x12\0\0\x74\x0a\0\0\x25\0\0\0\x70\x13\0\0\x85\x0a\0\0\x25\0\0\0\x78\x13\
+\0\0\x8e\x0a\0\0\x25\0\0\0\x80\x13\0\0\x9a\x0a\0\0\x25\0\0\0\x88\x13\0\0\xa6\
+\x0a\0\0\x22\0\0\0\x90\x13\0\0\xb5\x0a\0\0\x22\0\0\0\xa0\x13\0\0\xbc\x0a\0\0\
+\x22\0\0\0\xb0\x13\0\0\xc5\x0a\0\0\x84\0\0\0\xc0\x13\0\0\xd4\x0a\0\0\x8d\0\0\0\
+\0\x14\0\0\xd7\x0a\0\0\x8d\0\0\0\xc0\x14\0\0\xda\x0a\0\0\x8d\0\0\0\x80\x15\0\0\
+\xe4\x0a\0\0\x8e\0\0\0\x40\x16\0\0\xf0\x0a\0\0\x10\0\0\0\x80\x16\0\0\xfc\x0a\0\
+\0\x10\0\0\0\xa0\x16\0\0\x05\x0b\0\0\x8f\0\0\0\xc0\x16\0\0\x10\x0b\0\0\x91\0\0\
+\0\0\x17\0\0\x1a\x0b\0\0\x92\0\0\0\x40\x17\0\0\x22\x0b\0\0\x93\0\0\0\x80\x17\0\
+\0\x2b\x0b\0\0\x3a\0\0\0\xc0\x17\0\0\x35\x0b\0\0\x95\0\0\0\0\x18\0\0\x3c\x0b\0\
+\0\x96\0\0\0\x40\x18\0\0\x44\x0b\0\0\x3a\0\0\0\x80\x18\0\0\x4d\x0b\0\0\x97\0\0\
+\0\xc0\x18\0\0\x5b\x0b\0\0\x98\0\0\0\0\x19\0\0\x6a\x0b\0\0\x99\0\0\0\x40\x19\0\
+\0\x73\x0b\0\0\x60\0\0\0\x80\x19\0\0\x7c\x0b\0\0\x9b\0\0\0\xc0\x19\0\0\x80\x0b\
+\0\0\x10\0\0\0\0\x1a\0\0\x8e\x0b\0\0\x10\0\0\0\x20\x1a\0\0\xa1\x0b\0\0\x9c\0\0\
+\0\x40\x1a\0\0\xaa\x0b\0\0\x45\0\0\0\x80\x1a\0\0\xbc\x0b\0\0\x02\0\0\0\xc0\x1a\
+\0\0\xd1\x0b\0\0\x9d\0\0\0\0\x1b\0\0\xdc\x0b\0\0\x3a\0\0\0\x40\x1b\0\0\xec\x0b\
+\0\0\xa3\0\0\0\x80\x1b\0\0\xfa\x0b\0\0\xa4\0\0\0\xc0\x1b\0\0\x08\x0c\0\0\xa5\0\
+\0\0\0\x1c\0\0\x19\x0c\0\0\x83\0\0\0\x40\x1c\0\0\x23\x0c\0\0\xa6\0\0\0\x40\x1d\
+\0\0\x2f\x0c\0\0\xa7\0\0\0\x80\x1d\0\0\x3b\x0c\0\0\xa4\0\0\0\0\x1e\0\0\x3f\x0c\
+\0\0\x10\0\0\0\x40\x1e\0\0\x4d\x0c\0\0\x10\0\0\0\x60\x1e\0\0\x60\x0c\0\0\xaa\0\
+\0\0\x80\x1e\0\0\x66\x0c\0\0\x10\0\0\0\xc0\x1e\0\0\x73\x0c\0\0\x84\0\0\0\xe0\
+\x1e\0\0\x82\x0c\0\0\xab\0\0\0\0\x1f\0\0\x8c\x0c\0\0\xac\0\0\0\x40\x1f\0\0\x99\
+\x0c\0\0\xac\0\0\0\x80\x1f\0\0\xa6\x0c\0\0\xa3\0\0\0\xc0\x1f\0\0\xb3\x0c\0\0\
+\xae\0\0\0\0\x20\0\0\xbe\x0c\0\0\xaf\0\0\0\0\x24\0\0\xcd\x0c\0\0\x02\0\0\0\x40\
+\x25\0\0\xdc\x0c\0\0\x0e\0\0\0\x60\x25\0\0\xee\x0c\0\0\x48\0\0\0\x80\x25\0\0\
+\xf8\x0c\0\0\xb3\0\0\0\0\x26\0\0\x04\x0d\0\0\x48\0\0\0\x40\x26\0\0\x14\x0d\0\0\
+\xb4\0\0\0\xc0\x26\0\x08\x1e\x0d\0\0\x8f\0\0\0\xc8\x26\0\0\x28\x0d\0\0\xb5\0\0\
+\0\xd0\x26\0\x10\x38\x0d\0\0\x8f\0\0\0\xe0\x26\0\0\x4a\x0d\0\0\xb6\0\0\0\0\x27\
+\0\0\x5a\x0d\0\0\xb8\0\0\0\x40\x27\0\0\x61\x0d\0\0\xb9\0\0\0\x80\x27\0\0\0\0\0\
+\0\xbc\0\0\0\xc0\x27\0\0\x68\x0d\0\0\xc1\0\0\0\0\x28\0\0\x72\x0d\0\0\xc3\0\0\0\
+\x40\x28\0\0\x7b\x05\0\0\xc5\0\0\0\x80\x28\0\0\x7b\x0d\0\0\x0c\x01\0\0\0\x40\0\
+\0\x88\x0d\0\0\x06\x01\0\0\0\x41\0\0\x9d\x0d\0\0\x0d\x01\0\0\x40\x41\0\0\xab\
+\x0d\0\0\x10\0\0\0\x80\x41\0\0\xb8\x0d\0\0\x20\0\0\0\xa0\x41\0\0\xc5\x0d\0\0\
+\x0f\x01\0\0\xc0\x41\0\0\xcf\x0d\0\0\x11\x01\0\0\0\x42\0\0\xd6\x0d\0\0\x15\x01\
+\0\0\x10\x42\0\0\xe0\x0d\0\0\x2c\0\0\0\x10\x44\0\0\xec\x0d\0\0\x10\0\0\0\xa0\
+\x44\0\0\xf9\x0d\0\0\x16\x01\0\0\xc0\x44\0\0\x01\x0e\0\0\x17\x01\0\0\0\x45\0\0\
+\x08\x0e\0\0\x18\x01\0\0\x40\x45\0\0\x10\x0e\0\0\x19\x01\0\0\x80\x45\0\0\x22\
+\x0e\0\0\x19\x01\0\0\xc0\x45\0\0\x34\x0e\0\0\x8f\0\0\0\0\x46\0\0\x3f\x0e\0\0\
+\x10\0\0\0\x08\x46\0\x01\x4b\x0e\0\0\x48\0\0\0\x40\x46\0\0\x5d\x0e\0\0\x1a\x01\
+\0\0\xc0\x46\0\0\x68\x0e\0\0\x1d\x01\0\0\0\x47\0\0\x7c\x0e\0\0\x1f\x01\0\0\x40\
+\x47\0\0\x8b\x0e\0\0\x23\x01\0\0\x80\x47\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x3b\0\0\
+\0\x04\0\0\0\x10\0\0\0\0\0\0\0\0\0\0\x02\x6d\x01\0\0\0\0\0\0\0\0\0\x02\x4d\x01\
+\0\0\0\0\0\0\x02\0\0\x04\x20\0\0\0\x95\x0e\0\0\x48\0\0\0\0\0\0\0\x9b\x0e\0\0\
+\x48\0\0\0\x80\0\0\0\xa1\x0e\0\0\0\0\0\x08\x12\0\0\0\xb3\x0e\0\0\x17\0\0\x04\
+\xb8\0\0\0\xc4\x0e\0\0\x45\0\0\0\0\0\0\0\xcf\x0e\0\0\x45\0\0\0\x40\0\0\0\xda\
+\x0e\0\0\x45\0\0\0\x80\0\0\0\xe3\x0e\0\0\x45\0\0\0\xc0\0\0\0\xec\x0e\0\0\x45\0\
+\0\0\0\x01\0\0\xf6\x0e\0\0\x45\0\0\0\x40\x01\0\0\x18\x09\0\0\x45\0\0\0\x80\x01\
+\0\0\x23\x09\0\0\x45\0\0\0\xc0\x01\0\0\0\x0f\0\0\x45\0\0\0\0\x02\0\0\x0a\x0f\0\
+\0\x45\0\0\0\x40\x02\0\0\x15\x0f\0\0\x45\0\0\0\x80\x02\0\0\x26\x0f\0\0\x45\0\0\
+\0\xc0\x02\0\0\x35\x0f\0\0\x45\0\0\0\0\x03\0\0\x43\x0f\0\0\x45\0\0\0\x40\x03\0\
+\0\x53\x0f\0\0\x45\0\0\0\x80\x03\0\0\x62\x0f\0\0\x45\0\0\0\xc0\x03\0\0\x73\x0f\
+\0\0\x45\0\0\0\0\x04\0\0\x85\x0f\0\0\x45\0\0\0\x40\x04\0\0\x97\x0f\0\0\x45\0\0\
+\0\x80\x04\0\0\xa6\x0f\0\0\x45\0\0\0\xc0\x04\0\0\xba\x0f\0\0\x45\0\0\0\0\x05\0\
+\0\xcb\x0f\0\0\x45\0\0\0\x40\x05\0\0\xd9\x0f\0\0\x45\0\0\0\x80\x05\0\0\xe7\x0f\
+\0\0\0\0\0\x08\x70\0\0\0\xf5\x0f\0\0\0\0\0\x08\x71\0\0\0\0\0\0\0\x01\0\0\x04\
+\x08\0\0\0\xe6\x06\0\0\x4e\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x02\x73\0\0\0\0\0\0\0\0\
+\0\0\x0a\x62\x01\0\0\0\0\0\0\0\0\0\x02\x63\x01\0\0\0\0\0\0\0\0\0\x02\x76\0\0\0\
+\0\0\0\0\0\0\0\x0a\x6c\x01\0\0\0\0\0\0\0\0\0\x02\x78\0\0\0\0\0\0\0\0\0\0\x0a\
+\x5a\x01\0\0\0\0\0\0\0\0\0\x02\x7a\0\0\0\0\0\0\0\0\0\0\x0a\x67\x01\0\0\0\0\0\0\
+\0\0\0\x02\x7c\0\0\0\0\0\0\0\0\0\0\x0a\x6a\x01\0\0\0\0\0\0\0\0\0\x02\x7e\0\0\0\
+\0\0\0\0\0\0\0\x0a\x83\x01\0\0\0\0\0\0\0\0\0\x02\x80\0\0\0\0\0\0\0\0\0\0\x0a\
+\x7b\x01\0\0\0\0\0\0\0\0\0\x02\x82\0\0\0\0\0\0\0\0\0\0\x0a\x5c\x01\0\0\0\0\0\0\
+\0\0\0\x03\0\0\0\0\x25\0\0\0\x04\0\0\0\x20\0\0\0\0\x10\0\0\0\0\0\x08\x85\0\0\0\
+\x0b\x10\0\0\x01\0\0\x04\x04\0\0\0\0\0\0\0\x86\0\0\0\0\0\0\0\0\0\0\0\x01\0\0\
+\x05\x04\0\0\0\x14\x10\0\0\x87\0\0\0\0\0\0\0\x1a\x10\0\0\x01\0\0\x04\x04\0\0\0\
+\x27\x10\0\0\x88\0\0\0\0\0\0\0\x30\x10\0\0\0\0\0\x08\x89\0\0\0\x40\x10\0\0\x01\
+\0\0\x04\x04\0\0\0\0\0\0\0\x8a\0\0\0\0\0\0\0\0\0\0\0\x03\0\0\x05\x04\0\0\0\x4a\
+\x10\0\0\x63\0\0\0\0\0\0\0\0\0\0\0\x8b\0\0\0\0\0\0\0\0\0\0\0\x8c\0\0\0\0\0\0\0\
+\0\0\0\0\x02\0\0\x04\x02\0\0\0\x4e\x10\0\0\x23\0\0\0\0\0\0\0\x55\x10\0\0\x23\0\
+\0\0\x08\0\0\0\0\0\0\0\x02\0\0\x04\x04\0\0\0\x5d\x10\0\0\x20\0\0\0\0\0\0\0\x38\
+\x05\0\0\x20\0\0\0\x10\0\0\0\x6c\x10\0\0\x02\0\0\x04\x18\0\0\0\x6c\x05\0\0\x48\
+\0\0\0\0\0\0\0\x80\x10\0\0\x02\0\0\0\x80\0\0\0\0\0\0\0\0\0\0\x02\x66\x01\0\0\
+\x86\x10\0\0\0\0\0\x08\x90\0\0\0\x8b\x10\0\0\0\0\0\x01\x01\0\0\0\x08\0\0\x04\0\
+\0\0\0\0\0\0\x02\x7d\x01\0\0\0\0\0\0\0\0\0\x02\x58\x01\0\0\0\0\0\0\0\0\0\x02\
+\x94\0\0\0\x91\x10\0\0\0\0\0\x07\0\0\0\0\0\0\0\0\0\0\0\x02\x5e\x01\0\0\0\0\0\0\
+\0\0\0\x02\x5f\x01\0\0\0\0\0\0\0\0\0\x02\x80\x01\0\0\0\0\0\0\0\0\0\x02\x81\x01\
+\0\0\0\0\0\0\0\0\0\x02\x9a\0\0\0\x9d\x10\0\0\0\0\0\x07\0\0\0\0\0\0\0\0\0\0\0\
+\x02\x6f\x01\0\0\0\0\0\0\0\0\0\x02\x45\x01\0\0\0\0\0\0\0\0\0\x02\x9e\0\0\0\xa6\
+\x10\0\0\0\0\0\x08\x9f\0\0\0\0\0\0\0\x01\0\0\x0d\xa0\0\0\0\0\0\0\0\xa2\0\0\0\
+\xb8\x10\0\0\0\0\0\x08\xa1\0\0\0\xcc\x10\0\0\x04\0\0\x06\x04\0\0\0\xde\x10\0\0\
+\0\0\0\0\xf2\x10\0\0\x01\0\0\0\x05\x11\0\0\x02\0\0\0\x16\x11\0\0\x03\0\0\0\0\0\
+\0\0\0\0\0\x02\x42\0\0\0\0\0\0\0\0\0\0\x02\x69\x01\0\0\0\0\0\0\0\0\0\x02\x6e\
+\x01\0\0\0\0\0\0\0\0\0\x02\x72\x01\0\0\0\0\0\0\0\0\0\x02\x4b\x01\0\0\x26\x11\0\
+\0\x02\0\0\x04\x10\0\0\0\x71\x05\0\0\xa8\0\0\0\0\0\0\0\x31\x11\0\0\xa9\0\0\0\
+\x40\0\0\0\0\0\0\0\0\0\0\x02\xa7\0\0\0\0\0\0\0\0\0\0\x02\xa8\0\0\0\0\0\0\0\0\0\
+\0\x02\x43\x01\0\0\0\0\0\0\0\0\0\x02\x82\x01\0\0\0\0\0\0\0\0\0\x02\x84\x01\0\0\
+\x37\x11\0\0\x01\0\0\x04\x08\0\0\0\x42\x11\0\0\xa8\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x03\0\0\0\0\xad\0\0\0\x04\0\0\0\x10\0\0\0\x48\x11\0\0\x04\0\0\x04\x28\0\0\0\
+\x53\x11\0\0\xa7\0\0\0\0\0\0\0\x59\x11\0\0\x45\0\0\0\x80\0\0\0\x61\x11\0\0\xb0\
+\0\0\0\xc0\0\0\0\x94\x01\0\0\x0e\0\0\0\0\x01\0\0\0\0\0\0\0\0\0\x02\xb1\0\0\0\0\
+\0\0\0\x01\0\0\x0d\0\0\0\0\0\0\0\0\xb2\0\0\0\0\0\0\0\0\0\0\x02\xaf\0\0\0\0\0\0\
+\0\0\0\0\x02\x02\0\0\0\0\0\0\0\x06\0\0\x06\x04\0\0\0\x6a\x11\0\0\0\0\0\0\x7f\
+\x11\0\0\x01\0\0\0\x91\x11\0\0\x02\0\0\0\xa6\x11\0\0\x03\0\0\0\xba\x11\0\0\x04\
+\0\0\0\xca\x11\0\0\x05\0\0\0\0\0\0\0\x02\0\0\x06\x04\0\0\0\xd7\x11\0\0\0\0\0\0\
+\xed\x11\0\0\x01\0\0\0\0\0\0\0\0\0\0\x02\xb7\0\0\0\0\0\0\0\x01\0\0\x0d\0\0\0\0\
+\0\0\0\0\x44\0\0\0\0\0\0\0\0\0\0\x02\x70\x01\0\0\x04\x12\0\0\0\0\0\x08\xba\0\0\
+\0\0\0\0\0\x01\0\0\x04\x08\0\0\0\x13\x12\0\0\xbb\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x02\x6b\x01\0\0\0\0\0\0\x04\0\0\x05\x08\0\0\0\x17\x12\0\0\x3a\0\0\0\0\0\0\0\
+\x1f\x12\0\0\xbd\0\0\0\0\0\0\0\x26\x12\0\0\xbe\0\0\0\0\0\0\0\x2d\x12\0\0\xbf\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\x02\x73\x01\0\0\0\0\0\0\0\0\0\x02\x74\x01\0\0\0\0\0\
+\0\0\0\0\x02\xc0\0\0\0\x34\x12\0\0\0\0\0\x07\0\0\0\0\0\0\0\0\0\0\0\x02\xc2\0\0\
+\0\x68\x0d\0\0\0\0\0\x07\0\0\0\0\0\0\0\0\0\0\0\x02\xc4\0\0\0\x72\x0d\0\0\0\0\0\
+\x07\0\0\0\0\x40\x12\0\0\x2a\0\0\x84\xf0\x02\0\0\x47\x12\0\0\xc6\0\0\0\0\0\0\0\
+\x4c\x12\0\0\xcd\0\0\0\0\x02\0\0\x53\x12\0\0\xce\0\0\0\x40\x02\0\0\x55\x12\0\0\
+\xc7\0\0\0\x80\x02\0\0\x19\0\0\0\xcf\0\0\0\xc0\x02\0\0\x5f\x12\0\0\xd1\0\0\0\0\
+\x03\0\0\x63\x12\0\0\xd2\0\0\0\x40\x03\0\0\x6a\x12\0\0\x3a\0\0\0\x80\x03\0\0\
+\x78\x12\0\0\x3a\0\0\0\xc0\x03\0\0\x84\x12\0\0\xd3\0\0\0\0\x04\0\0\x8a\x12\0\0\
+\xd5\0\0\0\0\x05\0\0\x90\x12\0\0\xd7\0\0\0\x40\x07\0\0\x96\x12\0\0\xf5\0\0\0\
+\xc0\x10\0\0\xa0\x12\0\0\xf6\0\0\0\0\x11\0\0\xa6\x12\0\0\xf7\0\0\0\x40\x11\0\0\
+\xb1\x12\0\0\xf8\0\0\0\x80\x11\0\0\xb6\x12\0\0\x48\0\0\0\xc0\x11\0\0\xbf\x12\0\
+\0\xf9\0\0\0\x40\x12\0\0\xc7\x12\0\0\x11\0\0\0\x80\x12\0\0\xd0\x12\0\0\x12\0\0\
+\0\xc0\x12\0\0\xe2\x12\0\0\x12\0\0\0\0\x13\0\0\xf0\x12\0\0\xfb\0\0\0\x40\x13\0\
+\0\xfe\x12\0\0\xfd\0\0\0\x80\x13\0\0\x08\x13\0\0\x48\0\0\0\xc0\x13\0\0\x12\x13\
+\0\0\xfe\0\0\0\x40\x14\0\0\x1b\x13\0\0\xff\0\0\0\x80\x14\0\0\x24\x13\0\0\0\x01\
+\0\0\x80\x14\0\0\x2c\x13\0\0\x01\x01\0\0\xc0\x14\0\0\x33\x13\0\0\x02\0\0\0\0\
+\x15\0\0\x3d\x13\0\0\x02\x01\0\0\x20\x15\0\0\x42\x13\0\0\x0e\0\0\0\x40\x15\0\0\
+\x45\x13\0\0\x84\0\0\0\x60\x15\0\0\x51\x13\0\0\x48\0\0\0\x80\x15\0\0\x5d\x13\0\
+\0\x04\x01\0\0\0\x16\0\0\x63\x13\0\0\x05\x01\0\0\x40\x16\0\0\x6a\x13\0\0\x08\
+\x01\0\0\x80\x16\0\0\x72\x13\0\0\x0a\x01\0\0\xc0\x16\0\0\x7e\x13\0\0\x0b\x01\0\
+\0\0\x17\0\0\x84\x13\0\0\x8f\0\0\0\x40\x17\0\x01\x95\x13\0\0\x8f\0\0\0\x41\x17\
+\0\x01\x9d\x13\0\0\x8f\0\0\0\x42\x17\0\x01\xac\x13\0\0\x8f\0\0\0\x43\x17\0\x01\
+\xb9\x13\0\0\x0c\0\0\x84\x40\0\0\0\x16\x08\0\0\xc7\0\0\0\0\0\0\0\x53\x11\0\0\
+\x48\0\0\0\x40\0\0\0\x4c\x12\0\0\xc9\0\0\0\xc0\0\0\0\xc1\x13\0\0\x8e\0\0\0\0\
+\x01\0\0\xc6\x13\0\0\xca\0\0\0\x40\x01\0\0\xcc\x13\0\0\xcb\0\0\0\x80\x01\0\0\
+\xcf\x13\0\0\xcc\0\0\0\xc0\x01\0\0\xd4\x13\0\0\x10\0\0\0\xe0\x01\0\x01\xe6\x13\
+\0\0\x10\0\0\0\xe1\x01\0\x01\xf5\x13\0\0\x10\0\0\0\xe2\x01\0\x01\x0b\x14\0\0\
+\x10\0\0\0\xe3\x01\0\x01\x24\x14\0\0\x10\0\0\0\xe4\x01\0\x01\0\0\0\0\0\0\0\x02\
+\xc8\0\0\0\0\0\0\0\0\0\0\x0a\x3b\0\0\0\0\0\0\0\0\0\0\x02\xc6\0\0\0\0\0\0\0\0\0\
+\0\x02\x65\x01\0\0\0\0\0\0\0\0\0\x02\x64\x01\0\0\xcf\x13\0\0\x01\0\0\x04\x04\0\
+\0\0\x34\x14\0\0\x61\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x02\xc5\0\0\0\0\0\0\0\0\0\0\
+\x02\x55\x01\0\0\0\0\0\0\0\0\0\x02\xd0\0\0\0\0\0\0\0\0\0\0\x0a\x56\x01\0\0\0\0\
+\0\0\0\0\0\x02\x48\x01\0\0\0\0\0\0\0\0\0\x02\x53\x01\0\0\x84\x12\0\0\x04\0\0\
+\x04\x20\0\0\0\x3d\x14\0\0\x6f\0\0\0\0\0\0\0\x43\x14\0\0\x84\0\0\0\x40\0\0\0\
+\x4d\x14\0\0\xd4\0\0\0\x60\0\0\0\x51\x14\0\0\x48\0\0\0\x80\0\0\0\x5b\x14\0\0\
+\x01\0\0\x04\x04\0\0\0\x38\x05\0\0\x63\0\0\0\0\0\0\0\x71\x14\0\0\x06\0\0\x04\
+\x48\0\0\0\x80\x14\0\0\x48\0\0\0\0\0\0\0\x8a\x14\0\0\x48\0\0\0\x80\0\0\0\x94\
+\x14\0\0\x48\0\0\0\0\x01\0\0\xa4\x14\0\0\x48\0\0\0\x80\x01\0\0\xaf\x14\0\0\x8f\
+\0\0\0\0\x02\0\0\xbe\x14\0\0\xd6\0\0\0\x20\x02\0\0\xc5\x14\0\0\x04\0\0\x06\x04\
+\0\0\0\xd2\x14\0\0\0\0\0\0\xe3\x14\0\0\x01\0\0\0\xf2\x14\0\0\x02\0\0\0\x06\x15\
+\0\0\x03\0\0\0\x17\x15\0\0\x33\0\0\x84\x30\x01\0\0\x23\x15\0\0\xd8\0\0\0\0\0\0\
+\0\x2f\x15\0\0\x10\0\0\0\x20\0\0\x01\x3a\x15\0\0\x10\0\0\0\x21\0\0\x01\x48\x15\
+\0\0\x8f\0\0\0\x22\0\0\x01\x54\x15\0\0\x8f\0\0\0\x23\0\0\x01\x60\x15\0\0\x8f\0\
+\0\0\x24\0\0\x01\x6d\x15\0\0\x8f\0\0\0\x25\0\0\x01\x80\x15\0\0\x8f\0\0\0\x26\0\
+\0\x01\x92\x15\0\0\x8f\0\0\0\x27\0\0\x01\x98\x15\0\0\x8f\0\0\0\x28\0\0\x01\xa3\
+\x15\0\0\x8f\0\0\0\x29\0\0\x01\xb3\x15\0\0\x0e\0\0\0\x40\0\0\0\xc0\x15\0\0\x84\
+\0\0\0\x60\0\0\0\x53\x11\0\0\x48\0\0\0\x80\0\0\0\xc5\x15\0\0\xda\0\0\0\0\x01\0\
+\0\xd0\x15\0\0\xdd\0\0\0\0\x02\0\0\xd7\x15\0\0\x8f\0\0\0\x40\x02\0\x01\xe3\x15\
+\0\0\x8f\0\0\0\x41\x02\0\x01\xeb\x15\0\0\x8f\0\0\0\x42\x02\0\x01\xfb\x15\0\0\
+\x10\0\0\0\x43\x02\0\x01\x07\x16\0\0\x10\0\0\0\x44\x02\0\x01\x17\x16\0\0\xde\0\
+\0\0\x80\x02\0\0\x25\x16\0\0\x12\0\0\0\x80\x04\0\0\x33\x16\0\0\xe5\0\0\0\xc0\
+\x04\0\0\x38\x16\0\0\xea\0\0\0\xc0\x05\0\0\x43\x16\0\0\xec\0\0\0\x80\x06\0\0\
+\x4b\x16\0\0\x63\0\0\0\xc0\x06\0\0\x57\x16\0\0\x63\0\0\0\xe0\x06\0\0\x63\x16\0\
+\0\x10\0\0\0\0\x07\0\x03\x71\x16\0\0\x10\0\0\0\x03\x07\0\x01\x83\x16\0\0\x10\0\
+\0\0\x04\x07\0\x01\x93\x16\0\0\x10\0\0\0\x05\x07\0\x01\xa3\x16\0\0\x10\0\0\0\
+\x06\x07\0\x01\xb0\x16\0\0\x8f\0\0\0\x07\x07\0\x01\xc0\x16\0\0\x10\0\0\0\x08\
+\x07\0\x01\xcd\x16\0\0\x10\0\0\0\x09\x07\0\x01\xd6\x16\0\0\x10\0\0\0\x0a\x07\0\
+\x01\xe6\x16\0\0\x10\0\0\0\x0b\x07\0\x01\xf9\x16\0\0\x10\0\0\0\x0c\x07\0\x01\
+\x07\x17\0\0\x10\0\0\0\x20\x07\0\0\x13\x17\0\0\xed\0\0\0\x40\x07\0\0\x1b\x17\0\
+\0\xee\0\0\0\x60\x07\0\0\x2a\x17\0\0\x02\0\0\0\x80\x07\0\0\x38\x17\0\0\x02\0\0\
+\0\xa0\x07\0\0\x4a\x17\0\0\x12\0\0\0\xc0\x07\0\0\x54\x17\0\0\x12\0\0\0\0\x08\0\
+\0\x60\x17\0\0\x12\0\0\0\x40\x08\0\0\x6f\x17\0\0\x12\0\0\0\x80\x08\0\0\x84\x17\
+\0\0\xef\0\0\0\xc0\x08\0\0\x90\x17\0\0\xf0\0\0\0\0\x09\0\0\xa6\x17\0\0\xf4\0\0\
+\0\x40\x09\0\0\xaa\x17\0\0\0\0\0\x08\xd9\0\0\0\xb7\x17\0\0\x01\0\0\x04\x04\0\0\
+\0\xc2\x17\0\0\x02\0\0\0\0\0\0\0\xc5\x15\0\0\x02\0\0\x04\x20\0\0\0\xc8\x17\0\0\
+\x10\0\0\0\0\0\0\0\xcd\x17\0\0\xdb\0\0\0\x40\0\0\0\xd2\x17\0\0\x02\0\0\x04\x18\
+\0\0\0\xc0\x15\0\0\xdc\0\0\0\0\0\0\0\xe3\x17\0\0\x48\0\0\0\x40\0\0\0\xed\x17\0\
+\0\0\0\0\x08\x87\0\0\0\0\0\0\0\0\0\0\x02\x7f\x01\0\0\xfc\x17\0\0\x08\0\0\x04\
+\x40\0\0\0\x04\x18\0\0\xdf\0\0\0\0\0\0\0\x09\x18\0\0\x4d\0\0\0\0\x01\0\0\x61\
+\x11\0\0\xe0\0\0\0\x40\x01\0\0\x16\x18\0\0\xe4\0\0\0\x80\x01\0\0\x4d\x08\0\0\
+\x23\0\0\0\xc0\x01\0\0\x1b\x18\0\0\x23\0\0\0\xc8\x01\0\0\x22\x18\0\0\x23\0\0\0\
+\xd0\x01\0\0\x2a\x18\0\0\x23\0\0\0\xd8\x01\0\0\x32\x18\0\0\x02\0\0\x04\x20\0\0\
+\0\x04\x18\0\0\x46\0\0\0\0\0\0\0\x59\x11\0\0\x4d\0\0\0\xc0\0\0\0\0\0\0\0\0\0\0\
+\x02\xe1\0\0\0\0\0\0\0\x01\0\0\x0d\xe2\0\0\0\0\0\0\0\xe3\0\0\0\x42\x18\0\0\x02\
+\0\0\x06\x04\0\0\0\x52\x18\0\0\0\0\0\0\x64\x18\0\0\x01\0\0\0\0\0\0\0\0\0\0\x02\
+\xde\0\0\0\0\0\0\0\0\0\0\x02\x5d\x01\0\0\x74\x18\0\0\x03\0\0\x04\x20\0\0\0\x46\
+\x05\0\0\x6f\0\0\0\0\0\0\0\x53\x11\0\0\x48\0\0\0\x40\0\0\0\x80\x18\0\0\xe6\0\0\
+\0\xc0\0\0\0\x85\x18\0\0\0\0\0\x08\xe7\0\0\0\0\0\0\0\0\0\0\x02\xe8\0\0\0\0\0\0\
+\0\x01\0\0\x0d\0\0\0\0\0\0\0\0\xe9\0\0\0\0\0\0\0\0\0\0\x02\xe5\0\0\0\x91\x18\0\
+\0\0\0\0\x08\xeb\0\0\0\xa3\x18\0\0\x02\0\0\x04\x18\0\0\0\xc0\x15\0\0\x84\0\0\0\
+\0\0\0\0\x41\x05\0\0\x48\0\0\0\x40\0\0\0\0\0\0\0\0\0\0\x02\x7e\x01\0\0\xb3\x18\
+\0\0\x05\0\0\x06\x04\0\0\0\xbf\x18\0\0\0\0\0\0\xcc\x18\0\0\x01\0\0\0\xd9\x18\0\
+\0\x02\0\0\0\xe9\x18\0\0\x03\0\0\0\xfd\x18\0\0\x04\0\0\0\x0c\x19\0\0\x04\0\0\
+\x06\x04\0\0\0\x17\x19\0\0\0\0\0\0\x22\x19\0\0\x01\0\0\0\x2f\x19\0\0\x02\0\0\0\
+\x3d\x19\0\0\x03\0\0\0\0\0\0\0\0\0\0\x02\x76\x01\0\0\0\0\0\0\0\0\0\x02\xf1\0\0\
+\0\0\0\0\0\x02\0\0\x0d\0\0\0\0\0\0\0\0\xcd\0\0\0\0\0\0\0\xf2\0\0\0\x4c\x19\0\0\
+\0\0\0\x08\xf3\0\0\0\x50\x19\0\0\0\0\0\x08\x02\0\0\0\0\0\0\0\0\0\0\x02\x51\x01\
+\0\0\0\0\0\0\0\0\0\x02\x50\x01\0\0\0\0\0\0\0\0\0\x02\x59\x01\0\0\0\0\0\0\0\0\0\
+\x02\x61\x01\0\0\0\0\0\0\0\0\0\x02\x4f\x01\0\0\0\0\0\0\0\0\0\x02\xfa\0\0\0\0\0\
+\0\0\0\0\0\x0a\x57\x01\0\0\0\0\0\0\0\0\0\x02\xfc\0\0\0\0\0\0\0\0\0\0\x0a\x47\
+\x01\0\0\0\0\0\0\0\0\0\x02\x52\x01\0\0\0\0\0\0\0\0\0\x02\x4a\x01\0\0\x56\x19\0\
+\0\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\x02\x54\x01\0\0\0\0\0\0\0\0\0\x02\x5b\x01\0\
+\0\x63\x19\0\0\0\0\0\x08\x03\x01\0\0\x69\x19\0\0\0\0\0\x08\x0e\0\0\0\0\0\0\0\0\
+\0\0\x02\x49\x01\0\0\0\0\0\0\0\0\0\x02\x06\x01\0\0\0\0\0\0\0\0\0\x02\x07\x01\0\
+\0\0\0\0\0\0\0\0\x0a\x44\x01\0\0\0\0\0\0\0\0\0\x02\x09\x01\0\0\0\0\0\0\x01\0\0\
+\x0d\0\0\0\0\0\0\0\0\xcd\0\0\0\0\0\0\0\0\0\0\x02\x60\x01\0\0\0\0\0\0\0\0\0\x02\
+\x4e\x01\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x06\x01\0\0\x04\0\0\0\x04\0\0\0\0\0\0\0\
+\0\0\0\x02\x0e\x01\0\0\0\0\0\0\0\0\0\x0a\x77\x01\0\0\0\0\0\0\0\0\0\x02\x10\x01\
+\0\0\0\0\0\0\0\0\0\x0a\x4c\x01\0\0\x78\x19\0\0\0\0\0\x08\x12\x01\0\0\x7c\x19\0\
+\0\0\0\0\x08\x13\x01\0\0\x82\x19\0\0\0\0\0\x01\x02\0\0\0\x10\0\0\x01\x88\x19\0\
+\0\x02\0\0\x04\x04\0\0\0\x80\x10\0\0\x20\0\0\0\0\0\0\0\x96\x19\0\0\x20\0\0\0\
+\x10\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x14\x01\0\0\x04\0\0\0\x10\0\0\0\0\0\0\0\0\
+\0\0\x02\x71\x01\0\0\0\0\0\0\0\0\0\x02\x75\x01\0\0\0\0\0\0\0\0\0\x02\x78\x01\0\
+\0\0\0\0\0\0\0\0\x02\x68\x01\0\0\0\0\0\0\0\0\0\x02\x1b\x01\0\0\0\0\0\0\0\0\0\
+\x0a\x1c\x01\0\0\x5d\x0e\0\0\0\0\0\x07\0\0\0\0\0\0\0\0\0\0\0\x02\x1e\x01\0\0\0\
+\0\0\0\0\0\0\x0a\x7c\x01\0\0\0\0\0\0\0\0\0\x02\x20\x01\0\0\x7c\x0e\0\0\0\0\0\
+\x07\0\0\0\0\x9d\x19\0\0\x02\0\0\x04\x10\0\0\0\xac\x19\0\0\x9c\0\0\0\0\0\0\0\
+\xb1\x19\0\0\x22\x01\0\0\x40\0\0\0\0\0\0\0\0\0\0\x02\x46\x01\0\0\0\0\0\0\0\0\0\
+\x03\0\0\0\0\x21\x01\0\0\x04\0\0\0\x03\0\0\0\xa5\x1d\0\0\x0b\0\0\x84\x14\0\0\0\
+\xab\x1d\0\0\x24\0\0\0\0\0\0\x04\xaf\x1d\0\0\x24\0\0\0\x04\0\0\x04\xb7\x1d\0\0\
+\x24\0\0\0\x08\0\0\0\xbb\x1d\0\0\x5b\0\0\0\x10\0\0\0\x42\x13\0\0\x5b\0\0\0\x20\
+\0\0\0\xc3\x1d\0\0\x5b\0\0\0\x30\0\0\0\xcc\x1d\0\0\x24\0\0\0\x40\0\0\0\xd4\0\0\
+\0\x24\0\0\0\x48\0\0\0\xd0\x1d\0\0\x25\x01\0\0\x50\0\0\0\xd6\x1d\0\0\x26\x01\0\
+\0\x60\0\0\0\xdc\x1d\0\0\x26\x01\0\0\x80\0\0\0\xe2\x1d\0\0\0\0\0\x08\x21\0\0\0\
+\xea\x1d\0\0\0\0\0\x08\x0f\0\0\0\x65\x21\0\0\x08\0\0\x84\x28\0\0\0\x92\x04\0\0\
+\x24\0\0\0\0\0\0\x04\xaf\x1d\0\0\x24\0\0\0\x04\0\0\x04\x6d\x21\0\0\x28\x01\0\0\
+\x08\0\0\0\x76\x21\0\0\x5b\0\0\0\x20\0\0\0\x82\x21\0\0\x24\0\0\0\x30\0\0\0\x8a\
+\x21\0\0\x24\0\0\0\x38\0\0\0\xd6\x1d\0\0\x29\x01\0\0\x40\0\0\0\xdc\x1d\0\0\x29\
+\x01\0\0\xc0\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x24\0\0\0\x04\0\0\0\x03\0\0\0\x94\
+\x21\0\0\x01\0\0\x04\x10\0\0\0\x9d\x21\0\0\x2a\x01\0\0\0\0\0\0\0\0\0\0\x03\0\0\
+\x05\x10\0\0\0\xa3\x21\0\0\x2b\x01\0\0\0\0\0\0\xac\x21\0\0\x2c\x01\0\0\0\0\0\0\
+\xb6\x21\0\0\x2d\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x24\0\0\0\x04\0\0\0\
+\x10\0\0\0\0\0\0\0\0\0\0\x03\0\0\0\0\x5b\0\0\0\x04\0\0\0\x08\0\0\0\0\0\0\0\0\0\
+\0\x03\0\0\0\0\x26\x01\0\0\x04\0\0\0\x04\0\0\0\xa0\x27\0\0\x11\0\0\x84\x14\0\0\
+\0\xa7\x27\0\0\x5b\0\0\0\0\0\0\0\xae\x27\0\0\x5b\0\0\0\x10\0\0\0\xa9\0\0\0\x26\
+\x01\0\0\x20\0\0\0\xb3\x27\0\0\x26\x01\0\0\x40\0\0\0\xbb\x27\0\0\x21\0\0\0\x60\
+\0\0\x04\xc0\x27\0\0\x21\0\0\0\x64\0\0\x04\xc5\x27\0\0\x21\0\0\0\x68\0\0\x01\
+\xc9\x27\0\0\x21\0\0\0\x69\0\0\x01\xcd\x27\0\0\x21\0\0\0\x6a\0\0\x01\xd1\x27\0\
+\0\x21\0\0\0\x6b\0\0\x01\xd5\x27\0\0\x21\0\0\0\x6c\0\0\x01\xd9\x27\0\0\x21\0\0\
+\0\x6d\0\0\x01\xdd\x27\0\0\x21\0\0\0\x6e\0\0\x01\xe1\x27\0\0\x21\0\0\0\x6f\0\0\
+\x01\xe5\x27\0\0\x5b\0\0\0\x70\0\0\0\xd0\x1d\0\0\x25\x01\0\0\x80\0\0\0\xec\x27\
+\0\0\x5b\0\0\0\x90\0\0\0\0\0\0\0\0\0\0\x02\x30\x01\0\0\xbd\x2a\0\0\x13\0\0\x04\
+\x40\0\0\0\x61\x01\0\0\x39\0\0\0\0\0\0\0\x71\x01\0\0\x0e\0\0\0\x40\0\0\0\x73\
+\x02\0\0\x20\0\0\0\x60\0\0\0\x65\x01\0\0\x31\x01\0\0\x80\0\0\0\xe0\x2a\0\0\x8f\
+\0\0\0\xc0\0\0\0\xa8\x04\0\0\x20\0\0\0\xd0\0\0\0\xb3\x04\0\0\x20\0\0\0\xe0\0\0\
+\0\xd4\0\0\0\x20\0\0\0\xf0\0\0\0\x0f\x03\0\0\x23\0\0\0\0\x01\0\0\x6d\x01\0\0\
+\x10\0\0\0\x20\x01\0\0\x5a\x02\0\0\x10\0\0\0\x40\x01\0\0\xec\x2a\0\0\x02\0\0\0\
+\x60\x01\0\0\xfb\x2a\0\0\x8f\0\0\0\x80\x01\0\0\x12\x2b\0\0\x02\0\0\0\xa0\x01\0\
+\0\x23\x2b\0\0\x23\0\0\0\xc0\x01\0\0\x2c\x2b\0\0\x20\0\0\0\xd0\x01\0\0\x35\x2b\
+\0\0\x20\0\0\0\xe0\x01\0\0\x3e\x2b\0\0\x20\0\0\0\xf0\x01\0\0\x81\x01\0\0\x3c\0\
+\0\0\0\x02\0\0\0\0\0\0\0\0\0\x02\x32\x01\0\0\0\0\0\0\0\0\0\x0a\0\0\0\0\0\0\0\0\
+\x01\0\0\x0d\x02\0\0\0\xb1\x01\0\0\x2f\x01\0\0\x47\x2b\0\0\x01\0\0\x0c\x33\x01\
+\0\0\0\0\0\0\0\0\0\x0a\x36\x01\0\0\0\0\0\0\0\0\0\x09\x0f\0\0\0\x8c\x2b\0\0\0\0\
+\0\x0e\x35\x01\0\0\x01\0\0\0\x97\x2b\0\0\0\0\0\x0e\x35\x01\0\0\x01\0\0\0\xa2\
+\x2b\0\0\0\0\0\x0e\x35\x01\0\0\x01\0\0\0\xae\x2b\0\0\0\0\0\x0e\x35\x01\0\0\x01\
+\0\0\0\0\0\0\0\0\0\0\x09\x02\0\0\0\xba\x2b\0\0\0\0\0\x0e\x3b\x01\0\0\x01\0\0\0\
+\0\0\0\0\0\0\0\x03\0\0\0\0\x3b\0\0\0\x04\0\0\0\x04\0\0\0\xc2\x2b\0\0\0\0\0\x0e\
+\x3d\x01\0\0\x01\0\0\0\xca\x2b\0\0\x01\0\0\x0f\0\0\0\0\x3c\x01\0\0\0\0\0\0\x04\
+\0\0\0\xcf\x2b\0\0\x08\0\0\x0f\0\0\0\0\x0a\0\0\0\0\0\0\0\x20\0\0\0\x16\0\0\0\0\
+\0\0\0\x20\0\0\0\x18\0\0\0\0\0\0\0\x20\0\0\0\x28\0\0\0\0\0\0\0\x20\0\0\0\x2e\0\
+\0\0\0\0\0\0\x20\0\0\0\x30\0\0\0\0\0\0\0\x20\0\0\0\x34\0\0\0\0\0\0\0\x20\0\0\0\
+\x36\0\0\0\0\0\0\0\x20\0\0\0\xd5\x2b\0\0\x04\0\0\x0f\0\0\0\0\x37\x01\0\0\0\0\0\
+\0\x04\0\0\0\x38\x01\0\0\0\0\0\0\x04\0\0\0\x39\x01\0\0\0\0\0\0\x04\0\0\0\x3a\
+\x01\0\0\0\0\0\0\x04\0\0\0\xdd\x2b\0\0\x01\0\0\x0f\0\0\0\0\x3e\x01\0\0\0\0\0\0\
+\x04\0\0\0\xe5\x2b\0\0\0\0\0\x07\0\0\0\0\xeb\x2b\0\0\0\0\0\x07\0\0\0\0\xfb\x2b\
+\0\0\0\0\0\x07\0\0\0\0\x04\x2c\0\0\0\0\0\x07\0\0\0\0\x11\x2c\0\0\0\0\0\x07\0\0\
+\0\0\x20\x2c\0\0\0\0\0\x07\0\0\0\0\x5d\x13\0\0\0\0\0\x07\0\0\0\0\x29\x2c\0\0\0\
+\0\0\x07\0\0\0\0\x2d\x2c\0\0\0\0\0\x07\0\0\0\0\x36\x2c\0\0\0\0\0\x07\0\0\0\0\
+\x45\x2c\0\0\0\0\0\x07\0\0\0\0\x51\x2c\0\0\0\0\0\x07\0\0\0\0\x5b\x2c\0\0\0\0\0\
+\x07\0\0\0\0\x68\x2c\0\0\0\0\0\x07\0\0\0\0\x76\x2c\0\0\0\0\0\x07\0\0\0\0\x81\
+\x2c\0\0\0\0\0\x07\0\0\0\0\x97\x2c\0\0\0\0\0\x07\0\0\0\0\xa5\x2c\0\0\0\0\0\x07\
+\0\0\0\0\xb1\x2c\0\0\0\0\0\x07\0\0\0\0\xc0\x2c\0\0\0\0\0\x07\0\0\0\0\xcc\x2c\0\
+\0\0\0\0\x07\0\0\0\0\xd8\x2c\0\0\0\0\0\x07\0\0\0\0\xe1\x2c\0\0\0\0\0\x07\0\0\0\
+\0\x8a\x09\0\0\0\0\0\x07\0\0\0\0\xf0\x2c\0\0\0\0\0\x07\0\0\0\0\xc2\x09\0\0\0\0\
+\0\x07\0\0\0\0\xfe\x2c\0\0\0\0\0\x07\0\0\0\0\x11\x2d\0\0\0\0\0\x07\0\0\0\0\x1b\
+\x2d\0\0\0\0\0\x07\0\0\0\0\x72\x13\0\0\0\0\0\x07\0\0\0\0\x25\x2d\0\0\0\0\0\x07\
+\0\0\0\0\x30\x2d\0\0\0\0\0\x07\0\0\0\0\x3f\x2d\0\0\0\0\0\x07\0\0\0\0\x4e\x2d\0\
+\0\0\0\0\x07\0\0\0\0\x5a\x2d\0\0\0\0\0\x07\0\0\0\0\xc1\x13\0\0\0\0\0\x07\0\0\0\
+\0\x96\x09\0\0\0\0\0\x07\0\0\0\0\x64\x2d\0\0\0\0\0\x07\0\0\0\0\x73\x2d\0\0\0\0\
+\0\x07\0\0\0\0\xa1\x09\0\0\0\0\0\x07\0\0\0\0\x13\x12\0\0\0\0\0\x07\0\0\0\0\x7e\
+\x2d\0\0\0\0\0\x07\0\0\0\0\x8d\x2d\0\0\0\0\0\x07\0\0\0\0\x9e\x2d\0\0\0\0\0\x07\
+\0\0\0\0\xab\x2d\0\0\0\0\0\x07\0\0\0\0\xbb\x2d\0\0\0\0\0\x07\0\0\0\0\xc8\x2d\0\
+\0\0\0\0\x07\0\0\0\0\xd4\x2d\0\0\0\0\0\x07\0\0\0\0\xe4\x2d\0\0\0\0\0\x07\0\0\0\
+\0\xf0\x2d\0\0\0\0\0\x07\0\0\0\0\x01\x2e\0\0\0\0\0\x07\0\0\0\0\x0c\x2e\0\0\0\0\
+\0\x07\0\0\0\0\x9d\x0d\0\0\0\0\0\x07\0\0\0\0\x08\x0e\0\0\0\0\0\x07\0\0\0\0\x1b\
+\x2e\0\0\0\0\0\x07\0\0\0\0\x23\x2e\0\0\0\0\0\x07\0\0\0\0\xb7\x09\0\0\0\0\0\x07\
+\0\0\0\0\x68\x0e\0\0\0\0\0\x07\0\0\0\0\x10\x0b\0\0\0\0\0\x07\0\0\0\0\x28\x2e\0\
+\0\0\0\0\x07\0\0\0\0\x31\x2e\0\0\0\0\0\x07\0\0\0\0\x3f\x2e\0\0\0\0\0\x07\0\0\0\
+\0\x4c\x2e\0\0\0\0\0\x07\0\0\0\0\x55\x2e\0\0\0\0\0\x07\0\0\0\0\xab\x09\0\0\0\0\
+\0\x07\0\0\0\0\x68\x2e\0\0\0\0\0\x07\0\0\0\0\0\x69\x6e\x74\0\x5f\x5f\x41\x52\
+\x52\x41\x59\x5f\x53\x49\x5a\x45\x5f\x54\x59\x50\x45\x5f\x5f\0\x74\x79\x70\x65\
+\0\x6d\x61\x78\x5f\x65\x6e\x74\x72\x69\x65\x73\0\x6b\x65\x79\x5f\x73\x69\x7a\
+\x65\0\x76\x61\x6c\x75\x65\x5f\x73\x69\x7a\x65\0\x63\x6f\x6e\x6e\x65\x63\x74\
+\x69\x6f\x6e\x73\0\x75\x33\x32\0\x5f\x5f\x75\x33\x32\0\x75\x6e\x73\x69\x67\x6e\
+\x65\x64\x20\x69\x6e\x74\0\x75\x36\x34\0\x5f\x5f\x75\x36\x34\0\x75\x6e\x73\x69\
+\x67\x6e\x65\x64\x20\x6c\x6f\x6e\x67\x20\x6c\x6f\x6e\x67\0\x6b\x65\x79\0\x76\
+\x61\x6c\x75\x65\0\x61\x63\x74\x69\x76\x65\0\x63\x6f\x6e\x6e\x65\x63\x74\x69\
+\x6f\x6e\x73\x36\0\x6c\x6f\x73\x74\x34\x5f\x73\0\x63\x6f\x6e\x6e\0\x73\x65\x71\
+\0\x63\x6f\x6e\x6e\x5f\x73\0\x73\x72\x63\x5f\x69\x70\0\x64\x73\x74\x5f\x69\x70\
+\0\x73\x72\x63\x5f\x70\x6f\x72\x74\0\x64\x73\x74\x5f\x70\x6f\x72\x74\0\x70\x72\
+\x6f\x74\x6f\x63\x6f\x6c\0\x75\x31\x36\0\x5f\x5f\x75\x31\x36\0\x75\x6e\x73\x69\
+\x67\x6e\x65\x64\x20\x73\x68\x6f\x72\x74\0\x75\x38\0\x5f\x5f\x75\x38\0\x75\x6e\
+\x73\x69\x67\x6e\x65\x64\x20\x63\x68\x61\x72\0\x6c\x6f\x73\x74\x34\0\x6c\x6f\
+\x73\x74\x36\x5f\x73\0\x63\x6f\x6e\x6e\x36\x5f\x73\0\x6c\x6f\x73\x74\x36\0\x6f\
+\x76\x65\x72\x66\x6c\x6f\x77\0\x73\x61\x6d\x70\x6c\x65\0\x61\x6c\x6c\x6f\x77\
+\x65\x64\0\x74\x72\x61\x63\x65\x5f\x65\x76\x65\x6e\x74\x5f\x72\x61\x77\x5f\x6e\
+\x65\x74\x5f\x64\x65\x76\x5f\x74\x65\x6d\x70\x6c\x61\x74\x65\0\x65\x6e\x74\0\
+\x73\x6b\x62\x61\x64\x64\x72\0\x6c\x65\x6e\0\x5f\x5f\x64\x61\x74\x61\x5f\x6c\
+\x6f\x63\x5f\x6e\x61\x6d\x65\0\x5f\x5f\x64\x61\x74\x61\0\x74\x72\x61\x63\x65\
+\x5f\x65\x6e\x74\x72\x79\0\x66\x6c\x61\x67\x73\0\x70\x72\x65\x65\x6d\x70\x74\
+\x5f\x63\x6f\x75\x6e\x74\0\x70\x69\x64\0\x63\x68\x61\x72\0\x63\x74\x78\0\x74\
+\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x5f\x5f\x6e\x65\x74\x5f\x6e\x65\x74\x69\
+\x66\x5f\x72\x65\x63\x65\x69\x76\x65\x5f\x73\x6b\x62\0\x74\x72\x61\x63\x65\x70\
+\x6f\x69\x6e\x74\x2f\x6e\x65\x74\x2f\x6e\x65\x74\x69\x66\x5f\x72\x65\x63\x65\
+\x69\x76\x65\x5f\x73\x6b\x62\0\x30\x3a\x31\0\x2f\x74\x6d\x70\x2f\x72\x32\x2f\
+\x66\x6c\x6f\x77\x73\x6e\x6f\x6f\x70\x32\x2e\x63\0\x20\x20\x73\x74\x72\x75\x63\
+\x74\x20\x73\x6b\x5f\x62\x75\x66\x66\x20\x2a\x73\x6b\x62\x20\x3d\x20\x28\x73\
+\x74\x72\x75\x63\x74\x20\x73\x6b\x5f\x62\x75\x66\x66\x20\x2a\x29\x63\x74\x78\
+\x2d\x3e\x73\x6b\x62\x61\x64\x64\x72\x3b\0\x73\x6b\x5f\x62\x75\x66\x66\0\x63\
+\x62\0\x5f\x6e\x66\x63\x74\0\x64\x61\x74\x61\x5f\x6c\x65\x6e\0\x6d\x61\x63\x5f\
+\x6c\x65\x6e\0\x68\x64\x72\x5f\x6c\x65\x6e\0\x71\x75\x65\x75\x65\x5f\x6d\x61\
+\x70\x70\x69\x6e\x67\0\x5f\x5f\x63\x6c\x6f\x6e\x65\x64\x5f\x6f\x66\x66\x73\x65\
+\x74\0\x63\x6c\x6f\x6e\x65\x64\0\x6e\x6f\x68\x64\x72\0\x66\x63\x6c\x6f\x6e\x65\
+\0\x70\x65\x65\x6b\x65\x64\0\x68\x65\x61\x64\x5f\x66\x72\x61\x67\0\x70\x66\x6d\
+\x65\x6d\x61\x6c\x6c\x6f\x63\0\x61\x63\x74\x69\x76\x65\x5f\x65\x78\x74\x65\x6e\
+\x73\x69\x6f\x6e\x73\0\x68\x65\x61\x64\x65\x72\x73\x5f\x73\x74\x61\x72\x74\0\
+\x5f\x5f\x70\x6b\x74\x5f\x74\x79\x70\x65\x5f\x6f\x66\x66\x73\x65\x74\0\x70\x6b\
+\x74\x5f\x74\x79\x70\x65\0\x69\x67\x6e\x6f\x72\x65\x5f\x64\x66\0\x6e\x66\x5f\
+\x74\x72\x61\x63\x65\0\x69\x70\x5f\x73\x75\x6d\x6d\x65\x64\0\x6f\x6f\x6f\x5f\
+\x6f\x6b\x61\x79\0\x6c\x34\x5f\x68\x61\x73\x68\0\x73\x77\x5f\x68\x61\x73\x68\0\
+\x77\x69\x66\x69\x5f\x61\x63\x6b\x65\x64\x5f\x76\x61\x6c\x69\x64\0\x77\x69\x66\
+\x69\x5f\x61\x63\x6b\x65\x64\0\x6e\x6f\x5f\x66\x63\x73\0\x65\x6e\x63\x61\x70\
+\x73\x75\x6c\x61\x74\x69\x6f\x6e\0\x65\x6e\x63\x61\x70\x5f\x68\x64\x72\x5f\x63\
+\x73\x75\x6d\0\x63\x73\x75\x6d\x5f\x76\x61\x6c\x69\x64\0\x5f\x5f\x70\x6b\x74\
+\x5f\x76\x6c\x61\x6e\x5f\x70\x72\x65\x73\x65\x6e\x74\x5f\x6f\x66\x66\x73\x65\
+\x74\0\x76\x6c\x61\x6e\x5f\x70\x72\x65\x73\x65\x6e\x74\0\x63\x73\x75\x6d\x5f\
+\x63\x6f\x6d\x70\x6c\x65\x74\x65\x5f\x73\x77\0\x63\x73\x75\x6d\x5f\x6c\x65\x76\
+\x65\x6c\0\x63\x73\x75\x6d\x5f\x6e\x6f\x74\x5f\x69\x6e\x65\x74\0\x64\x73\x74\
+\x5f\x70\x65\x6e\x64\x69\x6e\x67\x5f\x63\x6f\x6e\x66\x69\x72\x6d\0\x6e\x64\x69\
+\x73\x63\x5f\x6e\x6f\x64\x65\x74\x79\x70\x65\0\x69\x70\x76\x73\x5f\x70\x72\x6f\
+\x70\x65\x72\x74\x79\0\x69\x6e\x6e\x65\x72\x5f\x70\x72\x6f\x74\x6f\x63\x6f\x6c\
+\x5f\x74\x79\x70\x65\0\x72\x65\x6d\x63\x73\x75\x6d\x5f\x6f\x66\x66\x6c\x6f\x61\
+\x64\0\x6f\x66\x66\x6c\x6f\x61\x64\x5f\x66\x77\x64\x5f\x6d\x61\x72\x6b\0\x6f\
+\x66\x66\x6c\x6f\x61\x64\x5f\x6c\x33\x5f\x66\x77\x64\x5f\x6d\x61\x72\x6b\0\x74\
+\x63\x5f\x73\x6b\x69\x70\x5f\x63\x6c\x61\x73\x73\x69\x66\x79\0\x74\x63\x5f\x61\
+\x74\x5f\x69\x6e\x67\x72\x65\x73\x73\0\x72\x65\x64\x69\x72\x65\x63\x74\x65\x64\
+\0\x66\x72\x6f\x6d\x5f\x69\x6e\x67\x72\x65\x73\x73\0\x64\x65\x63\x72\x79\x70\
+\x74\x65\x64\0\x74\x63\x5f\x69\x6e\x64\x65\x78\0\x70\x72\x69\x6f\x72\x69\x74\
+\x79\0\x73\x6b\x62\x5f\x69\x69\x66\0\x68\x61\x73\x68\0\x76\x6c\x61\x6e\x5f\x70\
+\x72\x6f\x74\x6f\0\x76\x6c\x61\x6e\x5f\x74\x63\x69\0\x73\x65\x63\x6d\x61\x72\
+\x6b\0\x69\x6e\x6e\x65\x72\x5f\x74\x72\x61\x6e\x73\x70\x6f\x72\x74\x5f\x68\x65\
+\x61\x64\x65\x72\0\x69\x6e\x6e\x65\x72\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x68\
+\x65\x61\x64\x65\x72\0\x69\x6e\x6e\x65\x72\x5f\x6d\x61\x63\x5f\x68\x65\x61\x64\
+\x65\x72\0\x74\x72\x61\x6e\x73\x70\x6f\x72\x74\x5f\x68\x65\x61\x64\x65\x72\0\
+\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x68\x65\x61\x64\x65\x72\0\x6d\x61\x63\x5f\x68\
+\x65\x61\x64\x65\x72\0\x68\x65\x61\x64\x65\x72\x73\x5f\x65\x6e\x64\0\x74\x61\
+\x69\x6c\0\x65\x6e\x64\0\x68\x65\x61\x64\0\x64\x61\x74\x61\0\x74\x72\x75\x65\
+\x73\x69\x7a\x65\0\x75\x73\x65\x72\x73\0\x65\x78\x74\x65\x6e\x73\x69\x6f\x6e\
+\x73\0\x72\x62\x6e\x6f\x64\x65\0\x6c\x69\x73\x74\0\x6e\x65\x78\x74\0\x70\x72\
+\x65\x76\0\x64\x65\x76\0\x64\x65\x76\x5f\x73\x63\x72\x61\x74\x63\x68\0\x75\x6e\
+\x73\x69\x67\x6e\x65\x64\x20\x6c\x6f\x6e\x67\0\x72\x62\x5f\x6e\x6f\x64\x65\0\
+\x5f\x5f\x72\x62\x5f\x70\x61\x72\x65\x6e\x74\x5f\x63\x6f\x6c\x6f\x72\0\x72\x62\
+\x5f\x72\x69\x67\x68\x74\0\x72\x62\x5f\x6c\x65\x66\x74\0\x6c\x69\x73\x74\x5f\
+\x68\x65\x61\x64\0\x73\x6b\0\x69\x70\x5f\x64\x65\x66\x72\x61\x67\x5f\x6f\x66\
+\x66\x73\x65\x74\0\x74\x73\x74\x61\x6d\x70\0\x73\x6b\x62\x5f\x6d\x73\x74\x61\
+\x6d\x70\x5f\x6e\x73\0\x6b\x74\x69\x6d\x65\x5f\x74\0\x73\x36\x34\0\x5f\x5f\x73\
+\x36\x34\0\x6c\x6f\x6e\x67\x20\x6c\x6f\x6e\x67\0\x74\x63\x70\x5f\x74\x73\x6f\
+\x72\x74\x65\x64\x5f\x61\x6e\x63\x68\x6f\x72\0\x5f\x73\x6b\x62\x5f\x72\x65\x66\
+\x64\x73\x74\0\x64\x65\x73\x74\x72\x75\x63\x74\x6f\x72\0\x63\x73\x75\x6d\0\x5f\
+\x5f\x77\x73\x75\x6d\0\x63\x73\x75\x6d\x5f\x73\x74\x61\x72\x74\0\x63\x73\x75\
+\x6d\x5f\x6f\x66\x66\x73\x65\x74\0\x5f\x5f\x62\x65\x31\x36\0\x6e\x61\x70\x69\
+\x5f\x69\x64\0\x73\x65\x6e\x64\x65\x72\x5f\x63\x70\x75\0\x6d\x61\x72\x6b\0\x72\
+\x65\x73\x65\x72\x76\x65\x64\x5f\x74\x61\x69\x6c\x72\x6f\x6f\x6d\0\x69\x6e\x6e\
+\x65\x72\x5f\x70\x72\x6f\x74\x6f\x63\x6f\x6c\0\x69\x6e\x6e\x65\x72\x5f\x69\x70\
+\x70\x72\x6f\x74\x6f\0\x73\x6b\x5f\x62\x75\x66\x66\x5f\x64\x61\x74\x61\x5f\x74\
+\0\x72\x65\x66\x63\x6f\x75\x6e\x74\x5f\x74\0\x72\x65\x66\x63\x6f\x75\x6e\x74\
+\x5f\x73\x74\x72\x75\x63\x74\0\x72\x65\x66\x73\0\x61\x74\x6f\x6d\x69\x63\x5f\
+\x74\0\x63\x6f\x75\x6e\x74\x65\x72\0\x30\x3a\x37\x32\0\x20\x20\x72\x65\x74\x75\
+\x72\x6e\x20\x28\x73\x74\x72\x75\x63\x74\x20\x65\x74\x68\x68\x64\x72\x20\x2a\
+\x29\x28\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\
+\x2c\x20\x68\x65\x61\x64\x29\x20\x2b\0\x30\x3a\x36\x38\0\x20\x20\x20\x20\x20\
 \x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\
-\x20\x20\x20\x20\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\
-\x28\x73\x6b\x62\x2c\x20\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x68\x65\x61\x64\x65\
-\x72\x29\x29\x3b\0\x20\x20\x73\x74\x72\x75\x63\x74\x20\x63\x6f\x6e\x6e\x5f\x73\
-\x20\x63\x6f\x6e\x6e\x20\x3d\x20\x7b\x7d\x3b\0\x20\x20\x62\x70\x66\x5f\x70\x72\
-\x6f\x62\x65\x5f\x72\x65\x61\x64\x28\x26\x76\x65\x72\x73\x69\x6f\x6e\x2c\x20\
-\x31\x2c\x20\x69\x70\x29\x3b\0\x20\x20\x69\x66\x20\x28\x28\x76\x65\x72\x73\x69\
-\x6f\x6e\x20\x26\x20\x30\x78\x66\x30\x29\x20\x21\x3d\x20\x30\x78\x34\x30\x29\
-\x20\x2f\x2a\x20\x49\x50\x76\x34\x20\x6f\x6e\x6c\x79\x20\x2a\x2f\0\x69\x70\x68\
-\x64\x72\0\x69\x68\x6c\0\x76\x65\x72\x73\x69\x6f\x6e\0\x74\x6f\x73\0\x74\x6f\
-\x74\x5f\x6c\x65\x6e\0\x69\x64\0\x66\x72\x61\x67\x5f\x6f\x66\x66\0\x74\x74\x6c\
-\0\x63\x68\x65\x63\x6b\0\x73\x61\x64\x64\x72\0\x64\x61\x64\x64\x72\0\x5f\x5f\
-\x73\x75\x6d\x31\x36\0\x5f\x5f\x62\x65\x33\x32\0\x30\x3a\x37\0\x20\x20\x42\x50\
-\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\x54\x4f\x28\x26\x63\
-\x6f\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x2c\x20\x69\x70\x2c\x20\x70\
-\x72\x6f\x74\x6f\x63\x6f\x6c\x29\x3b\0\x30\x3a\x39\0\x20\x20\x42\x50\x46\x5f\
+\x20\x20\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\
+\x6b\x62\x2c\x20\x6d\x61\x63\x5f\x68\x65\x61\x64\x65\x72\x29\x29\x3b\0\x65\x74\
+\x68\x68\x64\x72\0\x68\x5f\x64\x65\x73\x74\0\x68\x5f\x73\x6f\x75\x72\x63\x65\0\
+\x68\x5f\x70\x72\x6f\x74\x6f\0\x30\x3a\x32\0\x20\x20\x75\x31\x36\x20\x70\x72\
+\x6f\x74\x20\x3d\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\
+\x68\x64\x72\x2c\x20\x68\x5f\x70\x72\x6f\x74\x6f\x29\x3b\0\x20\x20\x69\x66\x20\
+\x28\x21\x61\x6c\x6c\x5f\x69\x66\x61\x63\x65\x73\x29\x20\x7b\0\x30\x3a\x30\x3a\
+\x30\x3a\x32\x3a\x30\0\x20\x20\x20\x20\x75\x33\x32\x20\x69\x66\x69\x6e\x64\x65\
+\x78\x20\x3d\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\
+\x6b\x62\x2c\x20\x64\x65\x76\x2c\x20\x69\x66\x69\x6e\x64\x65\x78\x29\x3b\0\x6e\
+\x65\x74\x5f\x64\x65\x76\x69\x63\x65\0\x6e\x61\x6d\x65\0\x6e\x61\x6d\x65\x5f\
+\x6e\x6f\x64\x65\0\x69\x66\x61\x6c\x69\x61\x73\0\x6d\x65\x6d\x5f\x65\x6e\x64\0\
+\x6d\x65\x6d\x5f\x73\x74\x61\x72\x74\0\x62\x61\x73\x65\x5f\x61\x64\x64\x72\0\
+\x69\x72\x71\0\x73\x74\x61\x74\x65\0\x64\x65\x76\x5f\x6c\x69\x73\x74\0\x6e\x61\
+\x70\x69\x5f\x6c\x69\x73\x74\0\x75\x6e\x72\x65\x67\x5f\x6c\x69\x73\x74\0\x63\
+\x6c\x6f\x73\x65\x5f\x6c\x69\x73\x74\0\x70\x74\x79\x70\x65\x5f\x61\x6c\x6c\0\
+\x70\x74\x79\x70\x65\x5f\x73\x70\x65\x63\x69\x66\x69\x63\0\x61\x64\x6a\x5f\x6c\
+\x69\x73\x74\0\x66\x65\x61\x74\x75\x72\x65\x73\0\x68\x77\x5f\x66\x65\x61\x74\
+\x75\x72\x65\x73\0\x77\x61\x6e\x74\x65\x64\x5f\x66\x65\x61\x74\x75\x72\x65\x73\
+\0\x76\x6c\x61\x6e\x5f\x66\x65\x61\x74\x75\x72\x65\x73\0\x68\x77\x5f\x65\x6e\
+\x63\x5f\x66\x65\x61\x74\x75\x72\x65\x73\0\x6d\x70\x6c\x73\x5f\x66\x65\x61\x74\
+\x75\x72\x65\x73\0\x67\x73\x6f\x5f\x70\x61\x72\x74\x69\x61\x6c\x5f\x66\x65\x61\
+\x74\x75\x72\x65\x73\0\x69\x66\x69\x6e\x64\x65\x78\0\x67\x72\x6f\x75\x70\0\x73\
+\x74\x61\x74\x73\0\x72\x78\x5f\x64\x72\x6f\x70\x70\x65\x64\0\x74\x78\x5f\x64\
+\x72\x6f\x70\x70\x65\x64\0\x72\x78\x5f\x6e\x6f\x68\x61\x6e\x64\x6c\x65\x72\0\
+\x63\x61\x72\x72\x69\x65\x72\x5f\x75\x70\x5f\x63\x6f\x75\x6e\x74\0\x63\x61\x72\
+\x72\x69\x65\x72\x5f\x64\x6f\x77\x6e\x5f\x63\x6f\x75\x6e\x74\0\x77\x69\x72\x65\
+\x6c\x65\x73\x73\x5f\x68\x61\x6e\x64\x6c\x65\x72\x73\0\x77\x69\x72\x65\x6c\x65\
+\x73\x73\x5f\x64\x61\x74\x61\0\x6e\x65\x74\x64\x65\x76\x5f\x6f\x70\x73\0\x65\
+\x74\x68\x74\x6f\x6f\x6c\x5f\x6f\x70\x73\0\x6c\x33\x6d\x64\x65\x76\x5f\x6f\x70\
+\x73\0\x6e\x64\x69\x73\x63\x5f\x6f\x70\x73\0\x78\x66\x72\x6d\x64\x65\x76\x5f\
+\x6f\x70\x73\0\x74\x6c\x73\x64\x65\x76\x5f\x6f\x70\x73\0\x68\x65\x61\x64\x65\
+\x72\x5f\x6f\x70\x73\0\x70\x72\x69\x76\x5f\x66\x6c\x61\x67\x73\0\x67\x66\x6c\
+\x61\x67\x73\0\x70\x61\x64\x64\x65\x64\0\x6f\x70\x65\x72\x73\x74\x61\x74\x65\0\
+\x6c\x69\x6e\x6b\x5f\x6d\x6f\x64\x65\0\x69\x66\x5f\x70\x6f\x72\x74\0\x64\x6d\
+\x61\0\x6d\x74\x75\0\x6d\x69\x6e\x5f\x6d\x74\x75\0\x6d\x61\x78\x5f\x6d\x74\x75\
+\0\x68\x61\x72\x64\x5f\x68\x65\x61\x64\x65\x72\x5f\x6c\x65\x6e\0\x6d\x69\x6e\
+\x5f\x68\x65\x61\x64\x65\x72\x5f\x6c\x65\x6e\0\x6e\x61\x6d\x65\x5f\x61\x73\x73\
+\x69\x67\x6e\x5f\x74\x79\x70\x65\0\x6e\x65\x65\x64\x65\x64\x5f\x68\x65\x61\x64\
+\x72\x6f\x6f\x6d\0\x6e\x65\x65\x64\x65\x64\x5f\x74\x61\x69\x6c\x72\x6f\x6f\x6d\
+\0\x70\x65\x72\x6d\x5f\x61\x64\x64\x72\0\x61\x64\x64\x72\x5f\x61\x73\x73\x69\
+\x67\x6e\x5f\x74\x79\x70\x65\0\x61\x64\x64\x72\x5f\x6c\x65\x6e\0\x75\x70\x70\
+\x65\x72\x5f\x6c\x65\x76\x65\x6c\0\x6c\x6f\x77\x65\x72\x5f\x6c\x65\x76\x65\x6c\
+\0\x6e\x65\x69\x67\x68\x5f\x70\x72\x69\x76\x5f\x6c\x65\x6e\0\x64\x65\x76\x5f\
+\x69\x64\0\x64\x65\x76\x5f\x70\x6f\x72\x74\0\x61\x64\x64\x72\x5f\x6c\x69\x73\
+\x74\x5f\x6c\x6f\x63\x6b\0\x75\x63\0\x6d\x63\0\x64\x65\x76\x5f\x61\x64\x64\x72\
+\x73\0\x71\x75\x65\x75\x65\x73\x5f\x6b\x73\x65\x74\0\x70\x72\x6f\x6d\x69\x73\
+\x63\x75\x69\x74\x79\0\x61\x6c\x6c\x6d\x75\x6c\x74\x69\0\x75\x63\x5f\x70\x72\
+\x6f\x6d\x69\x73\x63\0\x76\x6c\x61\x6e\x5f\x69\x6e\x66\x6f\0\x64\x73\x61\x5f\
+\x70\x74\x72\0\x74\x69\x70\x63\x5f\x70\x74\x72\0\x61\x74\x61\x6c\x6b\x5f\x70\
+\x74\x72\0\x69\x70\x5f\x70\x74\x72\0\x69\x70\x36\x5f\x70\x74\x72\0\x61\x78\x32\
+\x35\x5f\x70\x74\x72\0\x69\x65\x65\x65\x38\x30\x32\x31\x31\x5f\x70\x74\x72\0\
+\x69\x65\x65\x65\x38\x30\x32\x31\x35\x34\x5f\x70\x74\x72\0\x6d\x70\x6c\x73\x5f\
+\x70\x74\x72\0\x64\x65\x76\x5f\x61\x64\x64\x72\0\x5f\x72\x78\0\x6e\x75\x6d\x5f\
+\x72\x78\x5f\x71\x75\x65\x75\x65\x73\0\x72\x65\x61\x6c\x5f\x6e\x75\x6d\x5f\x72\
+\x78\x5f\x71\x75\x65\x75\x65\x73\0\x78\x64\x70\x5f\x70\x72\x6f\x67\0\x67\x72\
+\x6f\x5f\x66\x6c\x75\x73\x68\x5f\x74\x69\x6d\x65\x6f\x75\x74\0\x6e\x61\x70\x69\
+\x5f\x64\x65\x66\x65\x72\x5f\x68\x61\x72\x64\x5f\x69\x72\x71\x73\0\x72\x78\x5f\
+\x68\x61\x6e\x64\x6c\x65\x72\0\x72\x78\x5f\x68\x61\x6e\x64\x6c\x65\x72\x5f\x64\
+\x61\x74\x61\0\x6d\x69\x6e\x69\x71\x5f\x69\x6e\x67\x72\x65\x73\x73\0\x69\x6e\
+\x67\x72\x65\x73\x73\x5f\x71\x75\x65\x75\x65\0\x6e\x66\x5f\x68\x6f\x6f\x6b\x73\
+\x5f\x69\x6e\x67\x72\x65\x73\x73\0\x62\x72\x6f\x61\x64\x63\x61\x73\x74\0\x72\
+\x78\x5f\x63\x70\x75\x5f\x72\x6d\x61\x70\0\x69\x6e\x64\x65\x78\x5f\x68\x6c\x69\
+\x73\x74\0\x5f\x74\x78\0\x6e\x75\x6d\x5f\x74\x78\x5f\x71\x75\x65\x75\x65\x73\0\
+\x72\x65\x61\x6c\x5f\x6e\x75\x6d\x5f\x74\x78\x5f\x71\x75\x65\x75\x65\x73\0\x71\
+\x64\x69\x73\x63\0\x74\x78\x5f\x71\x75\x65\x75\x65\x5f\x6c\x65\x6e\0\x74\x78\
+\x5f\x67\x6c\x6f\x62\x61\x6c\x5f\x6c\x6f\x63\x6b\0\x78\x64\x70\x5f\x62\x75\x6c\
+\x6b\x71\0\x78\x70\x73\x5f\x63\x70\x75\x73\x5f\x6d\x61\x70\0\x78\x70\x73\x5f\
+\x72\x78\x71\x73\x5f\x6d\x61\x70\0\x6d\x69\x6e\x69\x71\x5f\x65\x67\x72\x65\x73\
+\x73\0\x71\x64\x69\x73\x63\x5f\x68\x61\x73\x68\0\x77\x61\x74\x63\x68\x64\x6f\
+\x67\x5f\x74\x69\x6d\x65\x72\0\x77\x61\x74\x63\x68\x64\x6f\x67\x5f\x74\x69\x6d\
+\x65\x6f\0\x70\x72\x6f\x74\x6f\x5f\x64\x6f\x77\x6e\x5f\x72\x65\x61\x73\x6f\x6e\
+\0\x74\x6f\x64\x6f\x5f\x6c\x69\x73\x74\0\x70\x63\x70\x75\x5f\x72\x65\x66\x63\
+\x6e\x74\0\x6c\x69\x6e\x6b\x5f\x77\x61\x74\x63\x68\x5f\x6c\x69\x73\x74\0\x72\
+\x65\x67\x5f\x73\x74\x61\x74\x65\0\x64\x69\x73\x6d\x61\x6e\x74\x6c\x65\0\x72\
+\x74\x6e\x6c\x5f\x6c\x69\x6e\x6b\x5f\x73\x74\x61\x74\x65\0\x6e\x65\x65\x64\x73\
+\x5f\x66\x72\x65\x65\x5f\x6e\x65\x74\x64\x65\x76\0\x70\x72\x69\x76\x5f\x64\x65\
+\x73\x74\x72\x75\x63\x74\x6f\x72\0\x6e\x70\x69\x6e\x66\x6f\0\x6e\x64\x5f\x6e\
+\x65\x74\0\x67\x61\x72\x70\x5f\x70\x6f\x72\x74\0\x6d\x72\x70\x5f\x70\x6f\x72\
+\x74\0\x73\x79\x73\x66\x73\x5f\x67\x72\x6f\x75\x70\x73\0\x73\x79\x73\x66\x73\
+\x5f\x72\x78\x5f\x71\x75\x65\x75\x65\x5f\x67\x72\x6f\x75\x70\0\x72\x74\x6e\x6c\
+\x5f\x6c\x69\x6e\x6b\x5f\x6f\x70\x73\0\x67\x73\x6f\x5f\x6d\x61\x78\x5f\x73\x69\
+\x7a\x65\0\x67\x73\x6f\x5f\x6d\x61\x78\x5f\x73\x65\x67\x73\0\x64\x63\x62\x6e\
+\x6c\x5f\x6f\x70\x73\0\x6e\x75\x6d\x5f\x74\x63\0\x74\x63\x5f\x74\x6f\x5f\x74\
+\x78\x71\0\x70\x72\x69\x6f\x5f\x74\x63\x5f\x6d\x61\x70\0\x66\x63\x6f\x65\x5f\
+\x64\x64\x70\x5f\x78\x69\x64\0\x70\x72\x69\x6f\x6d\x61\x70\0\x70\x68\x79\x64\
+\x65\x76\0\x73\x66\x70\x5f\x62\x75\x73\0\x71\x64\x69\x73\x63\x5f\x74\x78\x5f\
+\x62\x75\x73\x79\x6c\x6f\x63\x6b\0\x71\x64\x69\x73\x63\x5f\x72\x75\x6e\x6e\x69\
+\x6e\x67\x5f\x6b\x65\x79\0\x70\x72\x6f\x74\x6f\x5f\x64\x6f\x77\x6e\0\x77\x6f\
+\x6c\x5f\x65\x6e\x61\x62\x6c\x65\x64\0\x6e\x65\x74\x5f\x6e\x6f\x74\x69\x66\x69\
+\x65\x72\x5f\x6c\x69\x73\x74\0\x6d\x61\x63\x73\x65\x63\x5f\x6f\x70\x73\0\x75\
+\x64\x70\x5f\x74\x75\x6e\x6e\x65\x6c\x5f\x6e\x69\x63\x5f\x69\x6e\x66\x6f\0\x75\
+\x64\x70\x5f\x74\x75\x6e\x6e\x65\x6c\x5f\x6e\x69\x63\0\x78\x64\x70\x5f\x73\x74\
+\x61\x74\x65\0\x75\x70\x70\x65\x72\0\x6c\x6f\x77\x65\x72\0\x6e\x65\x74\x64\x65\
+\x76\x5f\x66\x65\x61\x74\x75\x72\x65\x73\x5f\x74\0\x6e\x65\x74\x5f\x64\x65\x76\
+\x69\x63\x65\x5f\x73\x74\x61\x74\x73\0\x72\x78\x5f\x70\x61\x63\x6b\x65\x74\x73\
+\0\x74\x78\x5f\x70\x61\x63\x6b\x65\x74\x73\0\x72\x78\x5f\x62\x79\x74\x65\x73\0\
+\x74\x78\x5f\x62\x79\x74\x65\x73\0\x72\x78\x5f\x65\x72\x72\x6f\x72\x73\0\x74\
+\x78\x5f\x65\x72\x72\x6f\x72\x73\0\x6d\x75\x6c\x74\x69\x63\x61\x73\x74\0\x63\
+\x6f\x6c\x6c\x69\x73\x69\x6f\x6e\x73\0\x72\x78\x5f\x6c\x65\x6e\x67\x74\x68\x5f\
+\x65\x72\x72\x6f\x72\x73\0\x72\x78\x5f\x6f\x76\x65\x72\x5f\x65\x72\x72\x6f\x72\
+\x73\0\x72\x78\x5f\x63\x72\x63\x5f\x65\x72\x72\x6f\x72\x73\0\x72\x78\x5f\x66\
+\x72\x61\x6d\x65\x5f\x65\x72\x72\x6f\x72\x73\0\x72\x78\x5f\x66\x69\x66\x6f\x5f\
+\x65\x72\x72\x6f\x72\x73\0\x72\x78\x5f\x6d\x69\x73\x73\x65\x64\x5f\x65\x72\x72\
+\x6f\x72\x73\0\x74\x78\x5f\x61\x62\x6f\x72\x74\x65\x64\x5f\x65\x72\x72\x6f\x72\
+\x73\0\x74\x78\x5f\x63\x61\x72\x72\x69\x65\x72\x5f\x65\x72\x72\x6f\x72\x73\0\
+\x74\x78\x5f\x66\x69\x66\x6f\x5f\x65\x72\x72\x6f\x72\x73\0\x74\x78\x5f\x68\x65\
+\x61\x72\x74\x62\x65\x61\x74\x5f\x65\x72\x72\x6f\x72\x73\0\x74\x78\x5f\x77\x69\
+\x6e\x64\x6f\x77\x5f\x65\x72\x72\x6f\x72\x73\0\x72\x78\x5f\x63\x6f\x6d\x70\x72\
+\x65\x73\x73\x65\x64\0\x74\x78\x5f\x63\x6f\x6d\x70\x72\x65\x73\x73\x65\x64\0\
+\x61\x74\x6f\x6d\x69\x63\x5f\x6c\x6f\x6e\x67\x5f\x74\0\x61\x74\x6f\x6d\x69\x63\
+\x36\x34\x5f\x74\0\x73\x70\x69\x6e\x6c\x6f\x63\x6b\x5f\x74\0\x73\x70\x69\x6e\
+\x6c\x6f\x63\x6b\0\x72\x6c\x6f\x63\x6b\0\x72\x61\x77\x5f\x73\x70\x69\x6e\x6c\
+\x6f\x63\x6b\0\x72\x61\x77\x5f\x6c\x6f\x63\x6b\0\x61\x72\x63\x68\x5f\x73\x70\
+\x69\x6e\x6c\x6f\x63\x6b\x5f\x74\0\x71\x73\x70\x69\x6e\x6c\x6f\x63\x6b\0\x76\
+\x61\x6c\0\x6c\x6f\x63\x6b\x65\x64\0\x70\x65\x6e\x64\x69\x6e\x67\0\x6c\x6f\x63\
+\x6b\x65\x64\x5f\x70\x65\x6e\x64\x69\x6e\x67\0\x6e\x65\x74\x64\x65\x76\x5f\x68\
+\x77\x5f\x61\x64\x64\x72\x5f\x6c\x69\x73\x74\0\x63\x6f\x75\x6e\x74\0\x62\x6f\
+\x6f\x6c\0\x5f\x42\x6f\x6f\x6c\0\x74\x69\x70\x63\x5f\x62\x65\x61\x72\x65\x72\0\
+\x6d\x70\x6c\x73\x5f\x64\x65\x76\0\x72\x78\x5f\x68\x61\x6e\x64\x6c\x65\x72\x5f\
+\x66\x75\x6e\x63\x5f\x74\0\x72\x78\x5f\x68\x61\x6e\x64\x6c\x65\x72\x5f\x72\x65\
+\x73\x75\x6c\x74\x5f\x74\0\x72\x78\x5f\x68\x61\x6e\x64\x6c\x65\x72\x5f\x72\x65\
+\x73\x75\x6c\x74\0\x52\x58\x5f\x48\x41\x4e\x44\x4c\x45\x52\x5f\x43\x4f\x4e\x53\
+\x55\x4d\x45\x44\0\x52\x58\x5f\x48\x41\x4e\x44\x4c\x45\x52\x5f\x41\x4e\x4f\x54\
+\x48\x45\x52\0\x52\x58\x5f\x48\x41\x4e\x44\x4c\x45\x52\x5f\x45\x58\x41\x43\x54\
+\0\x52\x58\x5f\x48\x41\x4e\x44\x4c\x45\x52\x5f\x50\x41\x53\x53\0\x68\x6c\x69\
+\x73\x74\x5f\x6e\x6f\x64\x65\0\x70\x70\x72\x65\x76\0\x68\x6c\x69\x73\x74\x5f\
+\x68\x65\x61\x64\0\x66\x69\x72\x73\x74\0\x74\x69\x6d\x65\x72\x5f\x6c\x69\x73\
+\x74\0\x65\x6e\x74\x72\x79\0\x65\x78\x70\x69\x72\x65\x73\0\x66\x75\x6e\x63\x74\
+\x69\x6f\x6e\0\x4e\x45\x54\x52\x45\x47\x5f\x55\x4e\x49\x4e\x49\x54\x49\x41\x4c\
+\x49\x5a\x45\x44\0\x4e\x45\x54\x52\x45\x47\x5f\x52\x45\x47\x49\x53\x54\x45\x52\
+\x45\x44\0\x4e\x45\x54\x52\x45\x47\x5f\x55\x4e\x52\x45\x47\x49\x53\x54\x45\x52\
+\x49\x4e\x47\0\x4e\x45\x54\x52\x45\x47\x5f\x55\x4e\x52\x45\x47\x49\x53\x54\x45\
+\x52\x45\x44\0\x4e\x45\x54\x52\x45\x47\x5f\x52\x45\x4c\x45\x41\x53\x45\x44\0\
+\x4e\x45\x54\x52\x45\x47\x5f\x44\x55\x4d\x4d\x59\0\x52\x54\x4e\x4c\x5f\x4c\x49\
+\x4e\x4b\x5f\x49\x4e\x49\x54\x49\x41\x4c\x49\x5a\x45\x44\0\x52\x54\x4e\x4c\x5f\
+\x4c\x49\x4e\x4b\x5f\x49\x4e\x49\x54\x49\x41\x4c\x49\x5a\x49\x4e\x47\0\x70\x6f\
+\x73\x73\x69\x62\x6c\x65\x5f\x6e\x65\x74\x5f\x74\0\x6e\x65\x74\0\x6d\x6c\x5f\
+\x70\x72\x69\x76\0\x6c\x73\x74\x61\x74\x73\0\x74\x73\x74\x61\x74\x73\0\x64\x73\
+\x74\x61\x74\x73\0\x70\x63\x70\x75\x5f\x64\x73\x74\x61\x74\x73\0\x64\x65\x76\
+\x69\x63\x65\0\x6b\x6f\x62\x6a\0\x70\x61\x72\x65\x6e\x74\0\x70\0\x69\x6e\x69\
+\x74\x5f\x6e\x61\x6d\x65\0\x62\x75\x73\0\x64\x72\x69\x76\x65\x72\0\x70\x6c\x61\
+\x74\x66\x6f\x72\x6d\x5f\x64\x61\x74\x61\0\x64\x72\x69\x76\x65\x72\x5f\x64\x61\
+\x74\x61\0\x6d\x75\x74\x65\x78\0\x6c\x69\x6e\x6b\x73\0\x70\x6f\x77\x65\x72\0\
+\x70\x6d\x5f\x64\x6f\x6d\x61\x69\x6e\0\x65\x6d\x5f\x70\x64\0\x6d\x73\x69\x5f\
+\x64\x6f\x6d\x61\x69\x6e\0\x70\x69\x6e\x73\0\x6d\x73\x69\x5f\x6c\x69\x73\x74\0\
+\x64\x6d\x61\x5f\x6f\x70\x73\0\x64\x6d\x61\x5f\x6d\x61\x73\x6b\0\x63\x6f\x68\
+\x65\x72\x65\x6e\x74\x5f\x64\x6d\x61\x5f\x6d\x61\x73\x6b\0\x62\x75\x73\x5f\x64\
+\x6d\x61\x5f\x6c\x69\x6d\x69\x74\0\x64\x6d\x61\x5f\x72\x61\x6e\x67\x65\x5f\x6d\
+\x61\x70\0\x64\x6d\x61\x5f\x70\x61\x72\x6d\x73\0\x64\x6d\x61\x5f\x70\x6f\x6f\
+\x6c\x73\0\x63\x6d\x61\x5f\x61\x72\x65\x61\0\x61\x72\x63\x68\x64\x61\x74\x61\0\
+\x6f\x66\x5f\x6e\x6f\x64\x65\0\x66\x77\x6e\x6f\x64\x65\0\x6e\x75\x6d\x61\x5f\
+\x6e\x6f\x64\x65\0\x64\x65\x76\x74\0\x69\x64\0\x64\x65\x76\x72\x65\x73\x5f\x6c\
+\x6f\x63\x6b\0\x64\x65\x76\x72\x65\x73\x5f\x68\x65\x61\x64\0\x63\x6c\x61\x73\
+\x73\0\x67\x72\x6f\x75\x70\x73\0\x72\x65\x6c\x65\x61\x73\x65\0\x69\x6f\x6d\x6d\
+\x75\x5f\x67\x72\x6f\x75\x70\0\x69\x6f\x6d\x6d\x75\0\x6f\x66\x66\x6c\x69\x6e\
+\x65\x5f\x64\x69\x73\x61\x62\x6c\x65\x64\0\x6f\x66\x66\x6c\x69\x6e\x65\0\x6f\
+\x66\x5f\x6e\x6f\x64\x65\x5f\x72\x65\x75\x73\x65\x64\0\x73\x74\x61\x74\x65\x5f\
+\x73\x79\x6e\x63\x65\x64\0\x6b\x6f\x62\x6a\x65\x63\x74\0\x6b\x73\x65\x74\0\x6b\
+\x74\x79\x70\x65\0\x73\x64\0\x6b\x72\x65\x66\0\x73\x74\x61\x74\x65\x5f\x69\x6e\
+\x69\x74\x69\x61\x6c\x69\x7a\x65\x64\0\x73\x74\x61\x74\x65\x5f\x69\x6e\x5f\x73\
+\x79\x73\x66\x73\0\x73\x74\x61\x74\x65\x5f\x61\x64\x64\x5f\x75\x65\x76\x65\x6e\
+\x74\x5f\x73\x65\x6e\x74\0\x73\x74\x61\x74\x65\x5f\x72\x65\x6d\x6f\x76\x65\x5f\
+\x75\x65\x76\x65\x6e\x74\x5f\x73\x65\x6e\x74\0\x75\x65\x76\x65\x6e\x74\x5f\x73\
+\x75\x70\x70\x72\x65\x73\x73\0\x72\x65\x66\x63\x6f\x75\x6e\x74\0\x6f\x77\x6e\
+\x65\x72\0\x77\x61\x69\x74\x5f\x6c\x6f\x63\x6b\0\x6f\x73\x71\0\x77\x61\x69\x74\
+\x5f\x6c\x69\x73\x74\0\x6f\x70\x74\x69\x6d\x69\x73\x74\x69\x63\x5f\x73\x70\x69\
+\x6e\x5f\x71\x75\x65\x75\x65\0\x64\x65\x76\x5f\x6c\x69\x6e\x6b\x73\x5f\x69\x6e\
+\x66\x6f\0\x73\x75\x70\x70\x6c\x69\x65\x72\x73\0\x63\x6f\x6e\x73\x75\x6d\x65\
+\x72\x73\0\x6e\x65\x65\x64\x73\x5f\x73\x75\x70\x70\x6c\x69\x65\x72\x73\0\x64\
+\x65\x66\x65\x72\x5f\x68\x6f\x6f\x6b\0\x6e\x65\x65\x64\x5f\x66\x6f\x72\x5f\x70\
+\x72\x6f\x62\x65\0\x73\x74\x61\x74\x75\x73\0\x64\x6c\x5f\x64\x65\x76\x5f\x73\
+\x74\x61\x74\x65\0\x44\x4c\x5f\x44\x45\x56\x5f\x4e\x4f\x5f\x44\x52\x49\x56\x45\
+\x52\0\x44\x4c\x5f\x44\x45\x56\x5f\x50\x52\x4f\x42\x49\x4e\x47\0\x44\x4c\x5f\
+\x44\x45\x56\x5f\x44\x52\x49\x56\x45\x52\x5f\x42\x4f\x55\x4e\x44\0\x44\x4c\x5f\
+\x44\x45\x56\x5f\x55\x4e\x42\x49\x4e\x44\x49\x4e\x47\0\x64\x65\x76\x5f\x70\x6d\
+\x5f\x69\x6e\x66\x6f\0\x70\x6f\x77\x65\x72\x5f\x73\x74\x61\x74\x65\0\x63\x61\
+\x6e\x5f\x77\x61\x6b\x65\x75\x70\0\x61\x73\x79\x6e\x63\x5f\x73\x75\x73\x70\x65\
+\x6e\x64\0\x69\x6e\x5f\x64\x70\x6d\x5f\x6c\x69\x73\x74\0\x69\x73\x5f\x70\x72\
+\x65\x70\x61\x72\x65\x64\0\x69\x73\x5f\x73\x75\x73\x70\x65\x6e\x64\x65\x64\0\
+\x69\x73\x5f\x6e\x6f\x69\x72\x71\x5f\x73\x75\x73\x70\x65\x6e\x64\x65\x64\0\x69\
+\x73\x5f\x6c\x61\x74\x65\x5f\x73\x75\x73\x70\x65\x6e\x64\x65\x64\0\x6e\x6f\x5f\
+\x70\x6d\0\x65\x61\x72\x6c\x79\x5f\x69\x6e\x69\x74\0\x64\x69\x72\x65\x63\x74\
+\x5f\x63\x6f\x6d\x70\x6c\x65\x74\x65\0\x64\x72\x69\x76\x65\x72\x5f\x66\x6c\x61\
+\x67\x73\0\x6c\x6f\x63\x6b\0\x63\x6f\x6d\x70\x6c\x65\x74\x69\x6f\x6e\0\x77\x61\
+\x6b\x65\x75\x70\0\x77\x61\x6b\x65\x75\x70\x5f\x70\x61\x74\x68\0\x73\x79\x73\
+\x63\x6f\x72\x65\0\x6e\x6f\x5f\x70\x6d\x5f\x63\x61\x6c\x6c\x62\x61\x63\x6b\x73\
+\0\x6d\x75\x73\x74\x5f\x72\x65\x73\x75\x6d\x65\0\x6d\x61\x79\x5f\x73\x6b\x69\
+\x70\x5f\x72\x65\x73\x75\x6d\x65\0\x73\x75\x73\x70\x65\x6e\x64\x5f\x74\x69\x6d\
+\x65\x72\0\x74\x69\x6d\x65\x72\x5f\x65\x78\x70\x69\x72\x65\x73\0\x77\x6f\x72\
+\x6b\0\x77\x61\x69\x74\x5f\x71\x75\x65\x75\x65\0\x77\x61\x6b\x65\x69\x72\x71\0\
+\x75\x73\x61\x67\x65\x5f\x63\x6f\x75\x6e\x74\0\x63\x68\x69\x6c\x64\x5f\x63\x6f\
+\x75\x6e\x74\0\x64\x69\x73\x61\x62\x6c\x65\x5f\x64\x65\x70\x74\x68\0\x69\x64\
+\x6c\x65\x5f\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\0\x72\x65\x71\x75\
+\x65\x73\x74\x5f\x70\x65\x6e\x64\x69\x6e\x67\0\x64\x65\x66\x65\x72\x72\x65\x64\
+\x5f\x72\x65\x73\x75\x6d\x65\0\x72\x75\x6e\x74\x69\x6d\x65\x5f\x61\x75\x74\x6f\
+\0\x69\x67\x6e\x6f\x72\x65\x5f\x63\x68\x69\x6c\x64\x72\x65\x6e\0\x6e\x6f\x5f\
+\x63\x61\x6c\x6c\x62\x61\x63\x6b\x73\0\x69\x72\x71\x5f\x73\x61\x66\x65\0\x75\
+\x73\x65\x5f\x61\x75\x74\x6f\x73\x75\x73\x70\x65\x6e\x64\0\x74\x69\x6d\x65\x72\
+\x5f\x61\x75\x74\x6f\x73\x75\x73\x70\x65\x6e\x64\x73\0\x6d\x65\x6d\x61\x6c\x6c\
+\x6f\x63\x5f\x6e\x6f\x69\x6f\0\x6c\x69\x6e\x6b\x73\x5f\x63\x6f\x75\x6e\x74\0\
+\x72\x65\x71\x75\x65\x73\x74\0\x72\x75\x6e\x74\x69\x6d\x65\x5f\x73\x74\x61\x74\
+\x75\x73\0\x72\x75\x6e\x74\x69\x6d\x65\x5f\x65\x72\x72\x6f\x72\0\x61\x75\x74\
+\x6f\x73\x75\x73\x70\x65\x6e\x64\x5f\x64\x65\x6c\x61\x79\0\x6c\x61\x73\x74\x5f\
+\x62\x75\x73\x79\0\x61\x63\x74\x69\x76\x65\x5f\x74\x69\x6d\x65\0\x73\x75\x73\
+\x70\x65\x6e\x64\x65\x64\x5f\x74\x69\x6d\x65\0\x61\x63\x63\x6f\x75\x6e\x74\x69\
+\x6e\x67\x5f\x74\x69\x6d\x65\x73\x74\x61\x6d\x70\0\x73\x75\x62\x73\x79\x73\x5f\
+\x64\x61\x74\x61\0\x73\x65\x74\x5f\x6c\x61\x74\x65\x6e\x63\x79\x5f\x74\x6f\x6c\
+\x65\x72\x61\x6e\x63\x65\0\x71\x6f\x73\0\x70\x6d\x5f\x6d\x65\x73\x73\x61\x67\
+\x65\x5f\x74\0\x70\x6d\x5f\x6d\x65\x73\x73\x61\x67\x65\0\x65\x76\x65\x6e\x74\0\
+\x64\x6f\x6e\x65\0\x77\x61\x69\x74\0\x73\x77\x61\x69\x74\x5f\x71\x75\x65\x75\
+\x65\x5f\x68\x65\x61\x64\0\x74\x61\x73\x6b\x5f\x6c\x69\x73\x74\0\x72\x61\x77\
+\x5f\x73\x70\x69\x6e\x6c\x6f\x63\x6b\x5f\x74\0\x68\x72\x74\x69\x6d\x65\x72\0\
+\x6e\x6f\x64\x65\0\x5f\x73\x6f\x66\x74\x65\x78\x70\x69\x72\x65\x73\0\x62\x61\
+\x73\x65\0\x69\x73\x5f\x72\x65\x6c\0\x69\x73\x5f\x73\x6f\x66\x74\0\x69\x73\x5f\
+\x68\x61\x72\x64\0\x74\x69\x6d\x65\x72\x71\x75\x65\x75\x65\x5f\x6e\x6f\x64\x65\
+\0\x68\x72\x74\x69\x6d\x65\x72\x5f\x72\x65\x73\x74\x61\x72\x74\0\x48\x52\x54\
+\x49\x4d\x45\x52\x5f\x4e\x4f\x52\x45\x53\x54\x41\x52\x54\0\x48\x52\x54\x49\x4d\
+\x45\x52\x5f\x52\x45\x53\x54\x41\x52\x54\0\x77\x6f\x72\x6b\x5f\x73\x74\x72\x75\
+\x63\x74\0\x66\x75\x6e\x63\0\x77\x6f\x72\x6b\x5f\x66\x75\x6e\x63\x5f\x74\0\x77\
+\x61\x69\x74\x5f\x71\x75\x65\x75\x65\x5f\x68\x65\x61\x64\x5f\x74\0\x77\x61\x69\
+\x74\x5f\x71\x75\x65\x75\x65\x5f\x68\x65\x61\x64\0\x72\x70\x6d\x5f\x72\x65\x71\
+\x75\x65\x73\x74\0\x52\x50\x4d\x5f\x52\x45\x51\x5f\x4e\x4f\x4e\x45\0\x52\x50\
+\x4d\x5f\x52\x45\x51\x5f\x49\x44\x4c\x45\0\x52\x50\x4d\x5f\x52\x45\x51\x5f\x53\
+\x55\x53\x50\x45\x4e\x44\0\x52\x50\x4d\x5f\x52\x45\x51\x5f\x41\x55\x54\x4f\x53\
+\x55\x53\x50\x45\x4e\x44\0\x52\x50\x4d\x5f\x52\x45\x51\x5f\x52\x45\x53\x55\x4d\
+\x45\0\x72\x70\x6d\x5f\x73\x74\x61\x74\x75\x73\0\x52\x50\x4d\x5f\x41\x43\x54\
+\x49\x56\x45\0\x52\x50\x4d\x5f\x52\x45\x53\x55\x4d\x49\x4e\x47\0\x52\x50\x4d\
+\x5f\x53\x55\x53\x50\x45\x4e\x44\x45\x44\0\x52\x50\x4d\x5f\x53\x55\x53\x50\x45\
+\x4e\x44\x49\x4e\x47\0\x73\x33\x32\0\x5f\x5f\x73\x33\x32\0\x64\x65\x76\x5f\x61\
+\x72\x63\x68\x64\x61\x74\x61\0\x64\x65\x76\x5f\x74\0\x5f\x5f\x6b\x65\x72\x6e\
+\x65\x6c\x5f\x64\x65\x76\x5f\x74\0\x73\x31\x36\0\x5f\x5f\x73\x31\x36\0\x73\x68\
+\x6f\x72\x74\0\x6e\x65\x74\x64\x65\x76\x5f\x74\x63\x5f\x74\x78\x71\0\x6f\x66\
+\x66\x73\x65\x74\0\x62\x70\x66\x5f\x78\x64\x70\x5f\x65\x6e\x74\x69\x74\x79\0\
+\x70\x72\x6f\x67\0\x6c\x69\x6e\x6b\0\x30\x3a\x32\x32\0\x20\x20\x20\x20\x69\x66\
+\x20\x28\x21\x62\x70\x66\x5f\x6d\x61\x70\x5f\x6c\x6f\x6f\x6b\x75\x70\x5f\x65\
+\x6c\x65\x6d\x28\x26\x61\x6c\x6c\x6f\x77\x65\x64\x2c\x20\x26\x69\x66\x69\x6e\
+\x64\x65\x78\x29\x29\0\x30\x3a\x36\x37\0\x20\x20\x69\x66\x20\x28\x42\x50\x46\
+\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x6e\x65\x74\
+\x77\x6f\x72\x6b\x5f\x68\x65\x61\x64\x65\x72\x29\x20\x3d\x3d\x20\x30\x29\0\x20\
+\x20\x75\x33\x32\x20\x7a\x65\x72\x6f\x20\x3d\x20\x30\x3b\0\x20\x20\x69\x66\x20\
+\x28\x73\x61\x6d\x70\x6c\x65\x5f\x72\x61\x74\x65\x20\x3c\x3d\x20\x31\x29\0\x20\
+\x20\x69\x66\x20\x28\x73\x61\x6d\x70\x6c\x65\x5f\x6d\x6f\x64\x65\x20\x3d\x3d\
+\x20\x53\x41\x4d\x50\x4c\x45\x5f\x52\x41\x4e\x44\x4f\x4d\x29\0\x20\x20\x20\x20\
+\x72\x65\x74\x75\x72\x6e\x20\x62\x70\x66\x5f\x67\x65\x74\x5f\x70\x72\x61\x6e\
+\x64\x6f\x6d\x5f\x75\x33\x32\x28\x29\x20\x25\x20\x73\x61\x6d\x70\x6c\x65\x5f\
+\x72\x61\x74\x65\x20\x3d\x3d\x20\x30\x3b\0\x20\x20\x69\x66\x20\x28\x21\x73\x61\
+\x6d\x70\x6c\x65\x64\x28\x29\x29\0\x20\x20\x73\x65\x65\x6e\x20\x3d\x20\x62\x70\
+\x66\x5f\x6d\x61\x70\x5f\x6c\x6f\x6f\x6b\x75\x70\x5f\x65\x6c\x65\x6d\x28\x26\
+\x73\x61\x6d\x70\x6c\x65\x2c\x20\x26\x7a\x65\x72\x6f\x29\x3b\0\x20\x20\x69\x66\
+\x20\x28\x21\x73\x65\x65\x6e\x29\0\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x28\x2a\
+\x73\x65\x65\x6e\x29\x2b\x2b\x20\x25\x20\x73\x61\x6d\x70\x6c\x65\x5f\x72\x61\
+\x74\x65\x20\x3d\x3d\x20\x30\x3b\0\x20\x20\x75\x33\x32\x20\x73\x65\x71\x20\x3d\
+\x20\x75\x73\x65\x5f\x6d\x61\x70\x3b\0\x20\x20\x75\x33\x32\x20\x67\x65\x6e\x20\
+\x3d\x20\x73\x65\x71\x20\x26\x20\x31\x3b\0\x20\x20\x75\x36\x34\x20\x2a\x63\x20\
+\x3d\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\x6c\x6f\x6f\x6b\x75\x70\x5f\x65\x6c\
+\x65\x6d\x28\x26\x61\x63\x74\x69\x76\x65\x2c\x20\x26\x67\x65\x6e\x29\x3b\0\x20\
+\x20\x69\x66\x20\x28\x21\x63\x29\0\x20\x20\x5f\x5f\x73\x79\x6e\x63\x5f\x66\x65\
+\x74\x63\x68\x5f\x61\x6e\x64\x5f\x61\x64\x64\x28\x63\x2c\x20\x31\x29\x3b\0\x20\
+\x20\x69\x66\x20\x28\x28\x75\x73\x65\x5f\x6d\x61\x70\x20\x26\x20\x31\x29\x20\
+\x21\x3d\x20\x67\x65\x6e\x29\x20\x7b\0\x20\x20\x20\x20\x5f\x5f\x73\x79\x6e\x63\
+\x5f\x66\x65\x74\x63\x68\x5f\x61\x6e\x64\x5f\x61\x64\x64\x28\x63\x2c\x20\x2d\
+\x31\x29\x3b\0\x20\x20\x20\x20\x73\x65\x71\x20\x3d\x20\x75\x73\x65\x5f\x6d\x61\
+\x70\x3b\0\x20\x20\x20\x20\x67\x65\x6e\x20\x3d\x20\x73\x65\x71\x20\x26\x20\x31\
+\x3b\0\x20\x20\x20\x20\x63\x20\x3d\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\x6c\x6f\
+\x6f\x6b\x75\x70\x5f\x65\x6c\x65\x6d\x28\x26\x61\x63\x74\x69\x76\x65\x2c\x20\
+\x26\x67\x65\x6e\x29\x3b\0\x20\x20\x20\x20\x69\x66\x20\x28\x21\x63\x29\0\x20\
+\x20\x20\x20\x5f\x5f\x73\x79\x6e\x63\x5f\x66\x65\x74\x63\x68\x5f\x61\x6e\x64\
+\x5f\x61\x64\x64\x28\x63\x2c\x20\x31\x29\x3b\0\x20\x20\x69\x66\x20\x28\x70\x72\
+\x6f\x74\x20\x3d\x3d\x20\x62\x70\x66\x5f\x68\x74\x6f\x6e\x73\x28\x45\x54\x48\
+\x5f\x50\x5f\x49\x50\x29\x29\0\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x28\x73\x74\
+\x72\x75\x63\x74\x20\x69\x70\x68\x64\x72\x20\x2a\x29\x28\x42\x50\x46\x5f\x43\
+\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x68\x65\x61\x64\x29\
+\x20\x2b\0\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\
+\x20\x20\x20\x20\x20\x20\x20\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\
+\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x68\x65\
+\x61\x64\x65\x72\x29\x29\x3b\0\x20\x20\x73\x74\x72\x75\x63\x74\x20\x63\x6f\x6e\
+\x6e\x5f\x73\x20\x63\x6f\x6e\x6e\x20\x3d\x20\x7b\x7d\x3b\0\x20\x20\x75\x38\x20\
+\x6f\x6e\x65\x20\x3d\x20\x31\x3b\0\x20\x20\x76\x6f\x69\x64\x20\x2a\x63\x6f\x6e\
+\x6e\x5f\x74\x61\x62\x6c\x65\x20\x3d\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\x6c\
+\x6f\x6f\x6b\x75\x70\x5f\x65\x6c\x65\x6d\x28\x26\x63\x6f\x6e\x6e\x65\x63\x74\
+\x69\x6f\x6e\x73\x2c\x20\x26\x67\x65\x6e\x29\x3b\0\x20\x20\x62\x70\x66\x5f\x70\
+\x72\x6f\x62\x65\x5f\x72\x65\x61\x64\x28\x26\x76\x65\x72\x73\x69\x6f\x6e\x2c\
+\x20\x31\x2c\x20\x69\x70\x29\x3b\0\x20\x20\x69\x66\x20\x28\x28\x76\x65\x72\x73\
+\x69\x6f\x6e\x20\x26\x20\x30\x78\x66\x30\x29\x20\x21\x3d\x20\x30\x78\x34\x30\
+\x29\x20\x2f\x2a\x20\x49\x50\x76\x34\x20\x6f\x6e\x6c\x79\x20\x2a\x2f\0\x69\x70\
+\x68\x64\x72\0\x69\x68\x6c\0\x76\x65\x72\x73\x69\x6f\x6e\0\x74\x6f\x73\0\x74\
+\x6f\x74\x5f\x6c\x65\x6e\0\x66\x72\x61\x67\x5f\x6f\x66\x66\0\x74\x74\x6c\0\x63\
+\x68\x65\x63\x6b\0\x73\x61\x64\x64\x72\0\x64\x61\x64\x64\x72\0\x5f\x5f\x73\x75\
+\x6d\x31\x36\0\x5f\x5f\x62\x65\x33\x32\0\x30\x3a\x37\0\x20\x20\x42\x50\x46\x5f\
 \x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\x54\x4f\x28\x26\x63\x6f\x6e\
-\x6e\x2e\x73\x72\x63\x5f\x69\x70\x2c\x20\x69\x70\x2c\x20\x73\x61\x64\x64\x72\
-\x29\x3b\0\x30\x3a\x31\x30\0\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\
-\x45\x41\x44\x5f\x49\x4e\x54\x4f\x28\x26\x63\x6f\x6e\x6e\x2e\x64\x73\x74\x5f\
-\x69\x70\x2c\x20\x69\x70\x2c\x20\x64\x61\x64\x64\x72\x29\x3b\0\x20\x20\x69\x66\
+\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x2c\x20\x69\x70\x2c\x20\x70\x72\x6f\
+\x74\x6f\x63\x6f\x6c\x29\x3b\0\x30\x3a\x39\0\x20\x20\x42\x50\x46\x5f\x43\x4f\
+\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\x54\x4f\x28\x26\x63\x6f\x6e\x6e\x2e\
+\x73\x72\x63\x5f\x69\x70\x2c\x20\x69\x70\x2c\x20\x73\x61\x64\x64\x72\x29\x3b\0\
+\x30\x3a\x31\x30\0\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\
+\x5f\x49\x4e\x54\x4f\x28\x26\x63\x6f\x6e\x6e\x2e\x64\x73\x74\x5f\x69\x70\x2c\
+\x20\x69\x70\x2c\x20\x64\x61\x64\x64\x72\x29\x3b\0\x30\x3a\x33\0\x20\x20\x42\
+\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\x54\x4f\x28\x26\
+\x74\x6f\x74\x5f\x6c\x65\x6e\x2c\x20\x69\x70\x2c\x20\x74\x6f\x74\x5f\x6c\x65\
+\x6e\x29\x3b\0\x30\x3a\x35\0\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\
+\x45\x41\x44\x5f\x49\x4e\x54\x4f\x28\x26\x66\x72\x61\x67\x5f\x6f\x66\x66\x2c\
+\x20\x69\x70\x2c\x20\x66\x72\x61\x67\x5f\x6f\x66\x66\x29\x3b\0\x20\x20\x69\x66\
 \x20\x28\x28\x63\x6f\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x20\x3d\x3d\
 \x20\x36\x20\x7c\x7c\x20\x63\x6f\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\
-\x20\x3d\x3d\x20\x31\x37\x29\x20\x26\x26\0\x30\x3a\x36\x36\0\x20\x20\x20\x20\
-\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\
-\x2c\x20\x74\x72\x61\x6e\x73\x70\x6f\x72\x74\x5f\x68\x65\x61\x64\x65\x72\x29\
-\x20\x21\x3d\x20\x30\x29\x20\x7b\0\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x28\x73\
-\x74\x72\x75\x63\x74\x20\x74\x63\x70\x68\x64\x72\x20\x2a\x29\x28\x42\x50\x46\
-\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x68\x65\x61\
-\x64\x29\x20\x2b\0\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\
-\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\
-\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x74\x72\x61\x6e\x73\x70\x6f\
-\x72\x74\x5f\x68\x65\x61\x64\x65\x72\x29\x29\x3b\0\x74\x63\x70\x68\x64\x72\0\
-\x73\x6f\x75\x72\x63\x65\0\x64\x65\x73\x74\0\x73\x65\x71\0\x61\x63\x6b\x5f\x73\
-\x65\x71\0\x72\x65\x73\x31\0\x64\x6f\x66\x66\0\x66\x69\x6e\0\x73\x79\x6e\0\x72\
-\x73\x74\0\x70\x73\x68\0\x61\x63\x6b\0\x75\x72\x67\0\x65\x63\x65\0\x63\x77\x72\
-\0\x77\x69\x6e\x64\x6f\x77\0\x75\x72\x67\x5f\x70\x74\x72\0\x30\x3a\x30\0\x20\
-\x20\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\
-\x54\x4f\x28\x26\x63\x6f\x6e\x6e\x2e\x73\x72\x63\x5f\x70\x6f\x72\x74\x2c\x20\
-\x74\x63\x70\x2c\x20\x73\x6f\x75\x72\x63\x65\x29\x3b\0\x20\x20\x20\x20\x42\x50\
-\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\x54\x4f\x28\x26\x63\
-\x6f\x6e\x6e\x2e\x64\x73\x74\x5f\x70\x6f\x72\x74\x2c\x20\x74\x63\x70\x2c\x20\
-\x64\x65\x73\x74\x29\x3b\0\x20\x20\x69\x66\x20\x28\x75\x73\x65\x5f\x6d\x61\x70\
-\x29\0\x20\x20\x6f\x76\x61\x6c\x20\x3d\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\x6c\
-\x6f\x6f\x6b\x75\x70\x5f\x65\x6c\x65\x6d\x28\x63\x6f\x6e\x6e\x5f\x74\x61\x62\
-\x6c\x65\x2c\x20\x26\x63\x6f\x6e\x6e\x29\x3b\0\x20\x20\x69\x66\x20\x28\x6f\x76\
-\x61\x6c\x29\x20\x7b\0\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x28\x73\x74\x72\x75\
-\x63\x74\x20\x69\x70\x76\x36\x68\x64\x72\x20\x2a\x29\x28\x42\x50\x46\x5f\x43\
-\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x68\x65\x61\x64\x29\
-\x20\x2b\0\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\
-\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\
-\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x6e\x65\x74\x77\x6f\x72\x6b\x5f\
-\x68\x65\x61\x64\x65\x72\x29\x29\x3b\0\x20\x20\x73\x74\x72\x75\x63\x74\x20\x63\
-\x6f\x6e\x6e\x36\x5f\x73\x20\x63\x6f\x6e\x6e\x20\x3d\x20\x7b\x7d\x3b\0\x20\x20\
-\x69\x66\x20\x28\x28\x76\x65\x72\x73\x69\x6f\x6e\x20\x26\x20\x30\x78\x66\x30\
-\x29\x20\x21\x3d\x20\x30\x78\x36\x30\x29\x20\x2f\x2a\x20\x49\x50\x76\x36\x20\
-\x6f\x6e\x6c\x79\x20\x2a\x2f\0\x69\x70\x76\x36\x68\x64\x72\0\x66\x6c\x6f\x77\
-\x5f\x6c\x62\x6c\0\x70\x61\x79\x6c\x6f\x61\x64\x5f\x6c\x65\x6e\0\x6e\x65\x78\
-\x74\x68\x64\x72\0\x68\x6f\x70\x5f\x6c\x69\x6d\x69\x74\0\x69\x6e\x36\x5f\x61\
-\x64\x64\x72\0\x69\x6e\x36\x5f\x75\0\x75\x36\x5f\x61\x64\x64\x72\x38\0\x75\x36\
-\x5f\x61\x64\x64\x72\x31\x36\0\x75\x36\x5f\x61\x64\x64\x72\x33\x32\0\x30\x3a\
-\x34\0\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\
-\x54\x4f\x28\x26\x63\x6f\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x2c\x20\
-\x69\x70\x2c\x20\x6e\x65\x78\x74\x68\x64\x72\x29\x3b\0\x30\x3a\x36\0\x20\x20\
-\x62\x70\x66\x5f\x70\x72\x6f\x62\x65\x5f\x72\x65\x61\x64\x28\x63\x6f\x6e\x6e\
-\x2e\x73\x72\x63\x5f\x69\x70\x2c\x20\x31\x36\x2c\x20\x26\x69\x70\x2d\x3e\x73\
-\x61\x64\x64\x72\x29\x3b\0\x20\x20\x62\x70\x66\x5f\x70\x72\x6f\x62\x65\x5f\x72\
-\x65\x61\x64\x28\x63\x6f\x6e\x6e\x2e\x64\x73\x74\x5f\x69\x70\x2c\x20\x31\x36\
-\x2c\x20\x26\x69\x70\x2d\x3e\x64\x61\x64\x64\x72\x29\x3b\0\x20\x20\x20\x20\x75\
-\x36\x34\x20\x6e\x76\x61\x6c\x20\x3d\x20\x6c\x65\x6e\x3b\0\x20\x20\x20\x20\x69\
-\x66\x20\x28\x62\x70\x66\x5f\x6d\x61\x70\x5f\x75\x70\x64\x61\x74\x65\x5f\x65\
-\x6c\x65\x6d\x28\x63\x6f\x6e\x6e\x5f\x74\x61\x62\x6c\x65\x2c\x20\x26\x63\x6f\
-\x6e\x6e\x2c\x20\x26\x6e\x76\x61\x6c\x2c\x20\x42\x50\x46\x5f\x4e\x4f\x45\x58\
-\x49\x53\x54\x29\x20\x3d\x3d\x20\x2d\x31\x29\x20\x7b\0\x20\x20\x20\x20\x20\x20\
-\x6f\x76\x61\x6c\x20\x3d\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\x6c\x6f\x6f\x6b\
-\x75\x70\x5f\x65\x6c\x65\x6d\x28\x63\x6f\x6e\x6e\x5f\x74\x61\x62\x6c\x65\x2c\
-\x20\x26\x63\x6f\x6e\x6e\x29\x3b\0\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x6f\
-\x76\x61\x6c\x29\0\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x30\x3b\0\x74\x72\x61\
-\x63\x65\x5f\x65\x76\x65\x6e\x74\x5f\x72\x61\x77\x5f\x6e\x65\x74\x5f\x64\x65\
-\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\x69\x74\0\x76\x6c\x61\x6e\x5f\x74\x61\
-\x67\x67\x65\x64\0\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x6f\x66\x66\x73\x65\x74\0\
-\x74\x72\x61\x6e\x73\x70\x6f\x72\x74\x5f\x6f\x66\x66\x73\x65\x74\x5f\x76\x61\
-\x6c\x69\x64\0\x74\x72\x61\x6e\x73\x70\x6f\x72\x74\x5f\x6f\x66\x66\x73\x65\x74\
-\0\x74\x78\x5f\x66\x6c\x61\x67\x73\0\x67\x73\x6f\x5f\x73\x69\x7a\x65\0\x67\x73\
-\x6f\x5f\x73\x65\x67\x73\0\x67\x73\x6f\x5f\x74\x79\x70\x65\0\x62\x6f\x6f\x6c\0\
-\x5f\x42\x6f\x6f\x6c\0\x74\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x5f\x5f\x6e\x65\
-\x74\x5f\x6e\x65\x74\x5f\x64\x65\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\x69\
-\x74\0\x74\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x2f\x6e\x65\x74\x2f\x6e\x65\x74\
-\x5f\x64\x65\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\x69\x74\0\x69\x6e\x74\x20\
-\x74\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x5f\x5f\x6e\x65\x74\x5f\x6e\x65\x74\
-\x5f\x64\x65\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\x69\x74\x28\0\x20\x20\x64\
-\x6f\x5f\x63\x6f\x75\x6e\x74\x28\x73\x6b\x62\x2c\x20\x63\x74\x78\x2d\x3e\x6c\
-\x65\x6e\x20\x2d\x20\x63\x74\x78\x2d\x3e\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x6f\
-\x66\x66\x73\x65\x74\x2c\x20\x64\x65\x76\x29\x3b\0\x30\x3a\x31\x31\0\x74\x61\
-\x72\x67\x5f\x69\x66\x61\x63\x65\0\x75\x73\x65\x5f\x6d\x61\x70\0\x4c\x49\x43\
-\x45\x4e\x53\x45\0\x2e\x62\x73\x73\0\x2e\x6d\x61\x70\x73\0\x2e\x72\x6f\x64\x61\
-\x74\x61\0\x6c\x69\x63\x65\x6e\x73\x65\0\x6e\x65\x74\x5f\x64\x65\x76\x69\x63\
-\x65\0\x73\x6b\x62\x5f\x65\x78\x74\0\x73\x6f\x63\x6b\0\x9f\xeb\x01\0\x20\0\0\0\
-\0\0\0\0\x24\0\0\0\x24\0\0\0\x44\x16\0\0\x68\x16\0\0\x84\x02\0\0\x08\0\0\0\xbb\
-\x01\0\0\x01\0\0\0\0\0\0\0\x26\0\0\0\xc9\x0f\0\0\x01\0\0\0\0\0\0\0\x62\0\0\0\
-\x10\0\0\0\xbb\x01\0\0\xb1\0\0\0\0\0\0\0\xdc\x01\0\0\x06\x02\0\0\0\xc8\x02\0\
-\x10\0\0\0\xdc\x01\0\0\x2d\x02\0\0\x08\xd0\x02\0\x20\0\0\0\xdc\x01\0\0\x44\x02\
-\0\0\x30\xdc\x02\0\x28\0\0\0\xdc\x01\0\0\x80\x02\0\0\x03\xe0\x02\0\x50\0\0\0\
-\xdc\x01\0\0\0\0\0\0\0\0\0\0\x58\0\0\0\xdc\x01\0\0\x80\x02\0\0\x03\xe0\x02\0\
-\x68\0\0\0\xdc\x01\0\0\xad\x02\0\0\x16\xe4\x02\0\x98\0\0\0\xdc\x01\0\0\0\0\0\0\
-\0\0\0\0\xa0\0\0\0\xdc\x01\0\0\x7f\x07\0\0\x1c\x64\x01\0\xd0\0\0\0\xdc\x01\0\0\
-\x7f\x07\0\0\x1c\x64\x01\0\xe0\0\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xe8\0\0\0\xdc\
-\x01\0\0\xba\x07\0\0\x1d\x68\x01\0\xf8\0\0\0\xdc\x01\0\0\xba\x07\0\0\x1d\x68\
-\x01\0\0\x01\0\0\xdc\x01\0\0\x7f\x07\0\0\x35\x64\x01\0\x20\x01\0\0\xdc\x01\0\0\
-\0\0\0\0\0\0\0\0\x28\x01\0\0\xdc\x01\0\0\x16\x08\0\0\x0e\x94\x02\0\x40\x01\0\0\
-\xdc\x01\0\0\x16\x08\0\0\x0e\x94\x02\0\x48\x01\0\0\xdc\x01\0\0\x40\x08\0\0\x07\
-\xf8\0\0\x60\x01\0\0\xdc\x01\0\0\x40\x08\0\0\x07\xf8\0\0\x68\x01\0\0\xdc\x01\0\
-\0\x57\x08\0\0\x13\x04\x01\0\x70\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\
-\x78\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x88\x01\0\0\xdc\x01\0\0\x72\
-\x08\0\0\x09\x0c\x01\0\x90\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\xa8\
-\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xb0\x01\0\0\xdc\x01\0\0\x57\x08\
-\0\0\x09\x04\x01\0\xc0\x01\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\xc8\x01\0\
-\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\xe0\x01\0\0\xdc\x01\0\0\x57\x08\0\0\
-\x09\x04\x01\0\xe8\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xf0\x01\0\0\
-\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\xf8\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x13\
-\x04\x01\0\x10\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x18\x02\0\0\xdc\
-\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x20\x02\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\
-\x01\0\x28\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\x40\x02\0\0\xdc\x01\0\
-\0\x57\x08\0\0\x09\x04\x01\0\x48\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\
-\x50\x02\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\x58\x02\0\0\xdc\x01\0\0\x57\
-\x08\0\0\x13\x04\x01\0\x70\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x78\
-\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x80\x02\0\0\xdc\x01\0\0\x72\x08\
-\0\0\x09\x0c\x01\0\x88\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\xa0\x02\0\
-\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xa8\x02\0\0\xdc\x01\0\0\x57\x08\0\0\
-\x09\x04\x01\0\xb0\x02\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\xb8\x02\0\0\
-\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\xd0\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\
-\x04\x01\0\xd8\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xe0\x02\0\0\xdc\
-\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\xe8\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\
-\x01\0\0\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x08\x03\0\0\xdc\x01\0\0\
-\x57\x08\0\0\x09\x04\x01\0\x10\x03\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\
-\x18\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\x30\x03\0\0\xdc\x01\0\0\x57\
-\x08\0\0\x09\x04\x01\0\x38\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x40\
-\x03\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\x48\x03\0\0\xdc\x01\0\0\x57\x08\
-\0\0\x13\x04\x01\0\x60\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x68\x03\0\
-\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x70\x03\0\0\xdc\x01\0\0\x72\x08\0\0\
-\x09\x0c\x01\0\x78\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\x90\x03\0\0\
-\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x98\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\
-\x04\x01\0\xa0\x03\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\xa8\x03\0\0\xdc\
-\x01\0\0\x57\x08\0\0\x13\x04\x01\0\xc0\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\
-\x01\0\xc8\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xd0\x03\0\0\xdc\x01\0\
-\0\x72\x08\0\0\x09\x0c\x01\0\xd8\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\
-\xf0\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xf8\x03\0\0\xdc\x01\0\0\x57\
-\x08\0\0\x09\x04\x01\0\0\x04\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\x08\x04\
-\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\x20\x04\0\0\xdc\x01\0\0\x57\x08\0\0\
-\x09\x04\x01\0\x28\x04\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x30\x04\0\0\
-\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\x38\x04\0\0\xdc\x01\0\0\x57\x08\0\0\x13\
-\x04\x01\0\x50\x04\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x58\x04\0\0\xdc\
-\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x90\x04\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x98\
-\x04\0\0\xdc\x01\0\0\x8f\x08\0\0\x07\xa0\x02\0\xb0\x04\0\0\xdc\x01\0\0\x8f\x08\
-\0\0\x07\xa0\x02\0\xb8\x04\0\0\xdc\x01\0\0\x8f\x08\0\0\x07\xa0\x02\0\xc0\x04\0\
-\0\xdc\x01\0\0\xbe\x08\0\0\x07\xa8\x02\0\xd8\x04\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\
-\0\xe0\x04\0\0\xdc\x01\0\0\xe1\x08\0\0\x1b\x3c\x01\0\xf8\x04\0\0\xdc\x01\0\0\
-\xe1\x08\0\0\x1b\x3c\x01\0\x08\x05\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x10\x05\0\0\
-\xdc\x01\0\0\x16\x09\0\0\x1b\x40\x01\0\x28\x05\0\0\xdc\x01\0\0\x16\x09\0\0\x1b\
-\x40\x01\0\x38\x05\0\0\xdc\x01\0\0\x55\x09\0\0\x11\x7c\x01\0\x48\x05\0\0\xdc\
-\x01\0\0\xe1\x08\0\0\x34\x3c\x01\0\x58\x05\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x60\
-\x05\0\0\xdc\x01\0\0\x70\x09\0\0\x03\x8c\x01\0\x78\x05\0\0\xdc\x01\0\0\x93\x09\
-\0\0\x08\x90\x01\0\x80\x05\0\0\xdc\x01\0\0\x93\x09\0\0\x10\x90\x01\0\x88\x05\0\
-\0\xdc\x01\0\0\x93\x09\0\0\x07\x90\x01\0\xa8\x05\0\0\xdc\x01\0\0\x16\x0a\0\0\
-\x03\x98\x01\0\xf0\x05\0\0\xdc\x01\0\0\x4e\x0a\0\0\x03\x9c\x01\0\x10\x06\0\0\
-\xdc\x01\0\0\x82\x0a\0\0\x03\xa0\x01\0\x38\x06\0\0\xdc\x01\0\0\xb1\x0a\0\0\x0d\
-\xa4\x01\0\x40\x06\0\0\xdc\x01\0\0\xb1\x0a\0\0\x1b\xa4\x01\0\x68\x06\0\0\xdc\
-\x01\0\0\0\0\0\0\0\0\0\0\x70\x06\0\0\xdc\x01\0\0\xeb\x0a\0\0\x07\xa8\x01\0\x88\
-\x06\0\0\xdc\x01\0\0\xeb\x0a\0\0\x07\xa8\x01\0\x90\x06\0\0\xdc\x01\0\0\xb1\x0a\
-\0\0\x07\xa4\x01\0\xa0\x06\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xa8\x06\0\0\xdc\x01\
-\0\0\x1e\x0b\0\0\x1c\x28\x01\0\xc0\x06\0\0\xdc\x01\0\0\x1e\x0b\0\0\x1c\x28\x01\
-\0\xd0\x06\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xd8\x06\0\0\xdc\x01\0\0\x54\x0b\0\0\
-\x1c\x2c\x01\0\xf0\x06\0\0\xdc\x01\0\0\x54\x0b\0\0\x1c\x2c\x01\0\xf8\x06\0\0\
-\xdc\x01\0\0\x1e\x0b\0\0\x35\x28\x01\0\x18\x07\0\0\xdc\x01\0\0\xf2\x0b\0\0\x05\
-\xb0\x01\0\x48\x07\0\0\xdc\x01\0\0\x27\x0c\0\0\x05\xb4\x01\0\x70\x07\0\0\xdc\
-\x01\0\0\x5a\x0c\0\0\x07\xbc\x01\0\x88\x07\0\0\xdc\x01\0\0\x5a\x0c\0\0\x07\xbc\
-\x01\0\xb8\x07\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xc0\x07\0\0\xdc\x01\0\0\x69\x0c\
-\0\0\x0a\xc4\x01\0\xd8\x07\0\0\xdc\x01\0\0\x9a\x0c\0\0\x07\xc8\x01\0\xe0\x07\0\
-\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\0\x08\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x08\x08\0\
-\0\xdc\x01\0\0\xa8\x0c\0\0\x1d\x50\x01\0\x20\x08\0\0\xdc\x01\0\0\xa8\x0c\0\0\
-\x1d\x50\x01\0\x30\x08\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x38\x08\0\0\xdc\x01\0\0\
-\xdf\x0c\0\0\x1d\x54\x01\0\x50\x08\0\0\xdc\x01\0\0\xdf\x0c\0\0\x1d\x54\x01\0\
-\x60\x08\0\0\xdc\x01\0\0\x20\x0d\0\0\x12\x04\x02\0\x90\x08\0\0\xdc\x01\0\0\xa8\
-\x0c\0\0\x36\x50\x01\0\xa0\x08\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xa8\x08\0\0\xdc\
-\x01\0\0\x70\x09\0\0\x03\x18\x02\0\xc0\x08\0\0\xdc\x01\0\0\x3c\x0d\0\0\x08\x1c\
-\x02\0\xc8\x08\0\0\xdc\x01\0\0\x3c\x0d\0\0\x10\x1c\x02\0\xd0\x08\0\0\xdc\x01\0\
-\0\x3c\x0d\0\0\x07\x1c\x02\0\xf0\x08\0\0\xdc\x01\0\0\xcb\x0d\0\0\x03\x28\x02\0\
-\x38\x09\0\0\xdc\x01\0\0\x02\x0e\0\0\x03\x2c\x02\0\x58\x09\0\0\xdc\x01\0\0\x31\
-\x0e\0\0\x12\x30\x02\0\x68\x09\0\0\xdc\x01\0\0\x31\x0e\0\0\x03\x30\x02\0\x80\
-\x09\0\0\xdc\x01\0\0\xb1\x0a\0\0\x0d\x34\x02\0\x88\x09\0\0\xdc\x01\0\0\xb1\x0a\
-\0\0\x1b\x34\x02\0\xb0\x09\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xb8\x09\0\0\xdc\x01\
-\0\0\xeb\x0a\0\0\x07\x38\x02\0\xd0\x09\0\0\xdc\x01\0\0\xeb\x0a\0\0\x07\x38\x02\
-\0\xd8\x09\0\0\xdc\x01\0\0\xb1\x0a\0\0\x07\x34\x02\0\xe8\x09\0\0\xdc\x01\0\0\0\
-\0\0\0\0\0\0\0\xf0\x09\0\0\xdc\x01\0\0\x1e\x0b\0\0\x1c\x28\x01\0\x08\x0a\0\0\
-\xdc\x01\0\0\x1e\x0b\0\0\x1c\x28\x01\0\x18\x0a\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\
-\x20\x0a\0\0\xdc\x01\0\0\x54\x0b\0\0\x1c\x2c\x01\0\x38\x0a\0\0\xdc\x01\0\0\x54\
-\x0b\0\0\x1c\x2c\x01\0\x40\x0a\0\0\xdc\x01\0\0\x1e\x0b\0\0\x35\x28\x01\0\x60\
-\x0a\0\0\xdc\x01\0\0\xf2\x0b\0\0\x05\x40\x02\0\x90\x0a\0\0\xdc\x01\0\0\x27\x0c\
-\0\0\x05\x44\x02\0\xb8\x0a\0\0\xdc\x01\0\0\x5a\x0c\0\0\x07\x4c\x02\0\xd0\x0a\0\
-\0\xdc\x01\0\0\x5a\x0c\0\0\x07\x4c\x02\0\0\x0b\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\
-\x08\x0b\0\0\xdc\x01\0\0\x69\x0c\0\0\x0a\x54\x02\0\x20\x0b\0\0\xdc\x01\0\0\x9a\
-\x0c\0\0\x07\x58\x02\0\x30\x0b\0\0\xdc\x01\0\0\x60\x0e\0\0\x10\xd4\x01\0\x40\
-\x0b\0\0\xdc\x01\0\0\x60\x0e\0\0\x09\xd4\x01\0\x50\x0b\0\0\xdc\x01\0\0\x60\x0e\
-\0\0\x10\xd4\x01\0\x68\x0b\0\0\xdc\x01\0\0\x74\x0e\0\0\x09\xd8\x01\0\x80\x0b\0\
-\0\xdc\x01\0\0\x74\x0e\0\0\x09\xd8\x01\0\x90\x0b\0\0\xdc\x01\0\0\xc0\x0e\0\0\
-\x0e\xdc\x01\0\xa8\x0b\0\0\xdc\x01\0\0\xf5\x0e\0\0\x0b\xe0\x01\0\xb8\x0b\0\0\
-\xdc\x01\0\0\x60\x0e\0\0\x10\x64\x02\0\xc8\x0b\0\0\xdc\x01\0\0\x60\x0e\0\0\x09\
-\x64\x02\0\xd8\x0b\0\0\xdc\x01\0\0\x60\x0e\0\0\x10\x64\x02\0\xf0\x0b\0\0\xdc\
-\x01\0\0\x74\x0e\0\0\x09\x68\x02\0\x08\x0c\0\0\xdc\x01\0\0\x74\x0e\0\0\x09\x68\
-\x02\0\x18\x0c\0\0\xdc\x01\0\0\xc0\x0e\0\0\x0e\x6c\x02\0\x30\x0c\0\0\xdc\x01\0\
-\0\xf5\x0e\0\0\x0b\x70\x02\0\x38\x0c\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x40\x0c\0\
-\0\xdc\x01\0\0\x05\x0f\0\0\x03\xe8\x02\0\xc9\x0f\0\0\xb2\0\0\0\0\0\0\0\xdc\x01\
-\0\0\xeb\x0f\0\0\0\xf8\x02\0\x10\0\0\0\xdc\x01\0\0\x2d\x02\0\0\x08\0\x03\0\x20\
-\0\0\0\xdc\x01\0\0\x44\x02\0\0\x30\x0c\x03\0\x28\0\0\0\xdc\x01\0\0\x80\x02\0\0\
-\x03\x10\x03\0\x50\0\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x58\0\0\0\xdc\x01\0\0\x80\
-\x02\0\0\x03\x10\x03\0\x68\0\0\0\xdc\x01\0\0\x13\x10\0\0\x16\x14\x03\0\x70\0\0\
-\0\xdc\x01\0\0\x13\x10\0\0\x21\x14\x03\0\xa8\0\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\
-\xb0\0\0\0\xdc\x01\0\0\x7f\x07\0\0\x1c\x64\x01\0\xe0\0\0\0\xdc\x01\0\0\x7f\x07\
-\0\0\x1c\x64\x01\0\xf0\0\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xf8\0\0\0\xdc\x01\0\0\
-\xba\x07\0\0\x1d\x68\x01\0\x08\x01\0\0\xdc\x01\0\0\xba\x07\0\0\x1d\x68\x01\0\
-\x10\x01\0\0\xdc\x01\0\0\x7f\x07\0\0\x35\x64\x01\0\x30\x01\0\0\xdc\x01\0\0\0\0\
-\0\0\0\0\0\0\x38\x01\0\0\xdc\x01\0\0\x16\x08\0\0\x0e\x94\x02\0\x50\x01\0\0\xdc\
-\x01\0\0\x16\x08\0\0\x0e\x94\x02\0\x58\x01\0\0\xdc\x01\0\0\x40\x08\0\0\x07\xf8\
-\0\0\x70\x01\0\0\xdc\x01\0\0\x40\x08\0\0\x07\xf8\0\0\x78\x01\0\0\xdc\x01\0\0\
-\x57\x08\0\0\x13\x04\x01\0\x80\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\
-\x88\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x98\x01\0\0\xdc\x01\0\0\x72\
-\x08\0\0\x09\x0c\x01\0\xa0\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\xb8\
-\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xc0\x01\0\0\xdc\x01\0\0\x57\x08\
-\0\0\x09\x04\x01\0\xd0\x01\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\xd8\x01\0\
-\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\xf0\x01\0\0\xdc\x01\0\0\x57\x08\0\0\
-\x09\x04\x01\0\xf8\x01\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\0\x02\0\0\xdc\
-\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\x08\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\
-\x01\0\x20\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x28\x02\0\0\xdc\x01\0\
-\0\x57\x08\0\0\x09\x04\x01\0\x30\x02\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\
-\x38\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\x50\x02\0\0\xdc\x01\0\0\x57\
-\x08\0\0\x09\x04\x01\0\x58\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x60\
-\x02\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\x68\x02\0\0\xdc\x01\0\0\x57\x08\
-\0\0\x13\x04\x01\0\x80\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x88\x02\0\
-\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x90\x02\0\0\xdc\x01\0\0\x72\x08\0\0\
-\x09\x0c\x01\0\x98\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\xb0\x02\0\0\
-\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xb8\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\
-\x04\x01\0\xc0\x02\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\xc8\x02\0\0\xdc\
-\x01\0\0\x57\x08\0\0\x13\x04\x01\0\xe0\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\
-\x01\0\xe8\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xf0\x02\0\0\xdc\x01\0\
-\0\x72\x08\0\0\x09\x0c\x01\0\xf8\x02\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\
-\x10\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x18\x03\0\0\xdc\x01\0\0\x57\
-\x08\0\0\x09\x04\x01\0\x20\x03\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\x28\
-\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\x40\x03\0\0\xdc\x01\0\0\x57\x08\
-\0\0\x09\x04\x01\0\x48\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x50\x03\0\
-\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\x58\x03\0\0\xdc\x01\0\0\x57\x08\0\0\
-\x13\x04\x01\0\x70\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x78\x03\0\0\
-\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x80\x03\0\0\xdc\x01\0\0\x72\x08\0\0\x09\
-\x0c\x01\0\x88\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\xa0\x03\0\0\xdc\
-\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xa8\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\
-\x01\0\xb0\x03\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\xb8\x03\0\0\xdc\x01\0\
-\0\x57\x08\0\0\x13\x04\x01\0\xd0\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\
-\xd8\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\xe0\x03\0\0\xdc\x01\0\0\x72\
-\x08\0\0\x09\x0c\x01\0\xe8\x03\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\0\x04\
-\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x08\x04\0\0\xdc\x01\0\0\x57\x08\0\0\
-\x09\x04\x01\0\x10\x04\0\0\xdc\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\x18\x04\0\0\
-\xdc\x01\0\0\x57\x08\0\0\x13\x04\x01\0\x30\x04\0\0\xdc\x01\0\0\x57\x08\0\0\x09\
-\x04\x01\0\x38\x04\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x40\x04\0\0\xdc\
-\x01\0\0\x72\x08\0\0\x09\x0c\x01\0\x48\x04\0\0\xdc\x01\0\0\x57\x08\0\0\x13\x04\
-\x01\0\x60\x04\0\0\xdc\x01\0\0\x57\x08\0\0\x09\x04\x01\0\x68\x04\0\0\xdc\x01\0\
-\0\x57\x08\0\0\x09\x04\x01\0\xa0\x04\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xa8\x04\0\
-\0\xdc\x01\0\0\x8f\x08\0\0\x07\xa0\x02\0\xc0\x04\0\0\xdc\x01\0\0\x8f\x08\0\0\
-\x07\xa0\x02\0\xc8\x04\0\0\xdc\x01\0\0\x8f\x08\0\0\x07\xa0\x02\0\xe8\x04\0\0\
-\xdc\x01\0\0\xbe\x08\0\0\x07\xa8\x02\0\x08\x05\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\
-\x10\x05\0\0\xdc\x01\0\0\xe1\x08\0\0\x1b\x3c\x01\0\x28\x05\0\0\xdc\x01\0\0\xe1\
-\x08\0\0\x1b\x3c\x01\0\x38\x05\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x40\x05\0\0\xdc\
-\x01\0\0\x16\x09\0\0\x1b\x40\x01\0\x58\x05\0\0\xdc\x01\0\0\x16\x09\0\0\x1b\x40\
-\x01\0\x68\x05\0\0\xdc\x01\0\0\x55\x09\0\0\x11\x7c\x01\0\x78\x05\0\0\xdc\x01\0\
-\0\xe1\x08\0\0\x34\x3c\x01\0\x88\x05\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x90\x05\0\
-\0\xdc\x01\0\0\x70\x09\0\0\x03\x8c\x01\0\xa8\x05\0\0\xdc\x01\0\0\x93\x09\0\0\
-\x08\x90\x01\0\xb0\x05\0\0\xdc\x01\0\0\x93\x09\0\0\x10\x90\x01\0\xb8\x05\0\0\
-\xdc\x01\0\0\x93\x09\0\0\x07\x90\x01\0\xd8\x05\0\0\xdc\x01\0\0\x16\x0a\0\0\x03\
-\x98\x01\0\x20\x06\0\0\xdc\x01\0\0\x4e\x0a\0\0\x03\x9c\x01\0\x40\x06\0\0\xdc\
-\x01\0\0\x82\x0a\0\0\x03\xa0\x01\0\x68\x06\0\0\xdc\x01\0\0\xb1\x0a\0\0\x0d\xa4\
-\x01\0\x70\x06\0\0\xdc\x01\0\0\xb1\x0a\0\0\x1b\xa4\x01\0\x98\x06\0\0\xdc\x01\0\
-\0\0\0\0\0\0\0\0\0\xa0\x06\0\0\xdc\x01\0\0\xeb\x0a\0\0\x07\xa8\x01\0\xb8\x06\0\
-\0\xdc\x01\0\0\xeb\x0a\0\0\x07\xa8\x01\0\xc0\x06\0\0\xdc\x01\0\0\xb1\x0a\0\0\
-\x07\xa4\x01\0\xd0\x06\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xd8\x06\0\0\xdc\x01\0\0\
-\x1e\x0b\0\0\x1c\x28\x01\0\xf0\x06\0\0\xdc\x01\0\0\x1e\x0b\0\0\x1c\x28\x01\0\0\
-\x07\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x08\x07\0\0\xdc\x01\0\0\x54\x0b\0\0\x1c\
-\x2c\x01\0\x20\x07\0\0\xdc\x01\0\0\x54\x0b\0\0\x1c\x2c\x01\0\x28\x07\0\0\xdc\
-\x01\0\0\x1e\x0b\0\0\x35\x28\x01\0\x48\x07\0\0\xdc\x01\0\0\xf2\x0b\0\0\x05\xb0\
-\x01\0\x78\x07\0\0\xdc\x01\0\0\x27\x0c\0\0\x05\xb4\x01\0\xa0\x07\0\0\xdc\x01\0\
-\0\x5a\x0c\0\0\x07\xbc\x01\0\xb8\x07\0\0\xdc\x01\0\0\x5a\x0c\0\0\x07\xbc\x01\0\
-\xe8\x07\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xf0\x07\0\0\xdc\x01\0\0\x69\x0c\0\0\
-\x0a\xc4\x01\0\x08\x08\0\0\xdc\x01\0\0\x9a\x0c\0\0\x07\xc8\x01\0\x10\x08\0\0\
-\xdc\x01\0\0\0\0\0\0\0\0\0\0\x30\x08\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x38\x08\0\
-\0\xdc\x01\0\0\xa8\x0c\0\0\x1d\x50\x01\0\x50\x08\0\0\xdc\x01\0\0\xa8\x0c\0\0\
-\x1d\x50\x01\0\x60\x08\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x68\x08\0\0\xdc\x01\0\0\
-\xdf\x0c\0\0\x1d\x54\x01\0\x80\x08\0\0\xdc\x01\0\0\xdf\x0c\0\0\x1d\x54\x01\0\
-\x90\x08\0\0\xdc\x01\0\0\x20\x0d\0\0\x12\x04\x02\0\xc0\x08\0\0\xdc\x01\0\0\xa8\
-\x0c\0\0\x36\x50\x01\0\xd0\x08\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xd8\x08\0\0\xdc\
-\x01\0\0\x70\x09\0\0\x03\x18\x02\0\xf0\x08\0\0\xdc\x01\0\0\x3c\x0d\0\0\x08\x1c\
-\x02\0\xf8\x08\0\0\xdc\x01\0\0\x3c\x0d\0\0\x10\x1c\x02\0\0\x09\0\0\xdc\x01\0\0\
-\x3c\x0d\0\0\x07\x1c\x02\0\x20\x09\0\0\xdc\x01\0\0\xcb\x0d\0\0\x03\x28\x02\0\
-\x68\x09\0\0\xdc\x01\0\0\x02\x0e\0\0\x03\x2c\x02\0\x88\x09\0\0\xdc\x01\0\0\x31\
-\x0e\0\0\x12\x30\x02\0\x98\x09\0\0\xdc\x01\0\0\x31\x0e\0\0\x03\x30\x02\0\xb0\
-\x09\0\0\xdc\x01\0\0\xb1\x0a\0\0\x0d\x34\x02\0\xb8\x09\0\0\xdc\x01\0\0\xb1\x0a\
-\0\0\x1b\x34\x02\0\xe0\x09\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\xe8\x09\0\0\xdc\x01\
-\0\0\xeb\x0a\0\0\x07\x38\x02\0\0\x0a\0\0\xdc\x01\0\0\xeb\x0a\0\0\x07\x38\x02\0\
-\x08\x0a\0\0\xdc\x01\0\0\xb1\x0a\0\0\x07\x34\x02\0\x18\x0a\0\0\xdc\x01\0\0\0\0\
-\0\0\0\0\0\0\x20\x0a\0\0\xdc\x01\0\0\x1e\x0b\0\0\x1c\x28\x01\0\x38\x0a\0\0\xdc\
-\x01\0\0\x1e\x0b\0\0\x1c\x28\x01\0\x48\x0a\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x50\
-\x0a\0\0\xdc\x01\0\0\x54\x0b\0\0\x1c\x2c\x01\0\x68\x0a\0\0\xdc\x01\0\0\x54\x0b\
-\0\0\x1c\x2c\x01\0\x70\x0a\0\0\xdc\x01\0\0\x1e\x0b\0\0\x35\x28\x01\0\x90\x0a\0\
-\0\xdc\x01\0\0\xf2\x0b\0\0\x05\x40\x02\0\xc0\x0a\0\0\xdc\x01\0\0\x27\x0c\0\0\
-\x05\x44\x02\0\xe8\x0a\0\0\xdc\x01\0\0\x5a\x0c\0\0\x07\x4c\x02\0\0\x0b\0\0\xdc\
-\x01\0\0\x5a\x0c\0\0\x07\x4c\x02\0\x30\x0b\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x38\
-\x0b\0\0\xdc\x01\0\0\x69\x0c\0\0\x0a\x54\x02\0\x50\x0b\0\0\xdc\x01\0\0\x9a\x0c\
-\0\0\x07\x58\x02\0\x60\x0b\0\0\xdc\x01\0\0\x60\x0e\0\0\x10\xd4\x01\0\x70\x0b\0\
-\0\xdc\x01\0\0\x60\x0e\0\0\x09\xd4\x01\0\x80\x0b\0\0\xdc\x01\0\0\x60\x0e\0\0\
-\x10\xd4\x01\0\x98\x0b\0\0\xdc\x01\0\0\x74\x0e\0\0\x09\xd8\x01\0\xb0\x0b\0\0\
-\xdc\x01\0\0\x74\x0e\0\0\x09\xd8\x01\0\xc0\x0b\0\0\xdc\x01\0\0\xc0\x0e\0\0\x0e\
-\xdc\x01\0\xd8\x0b\0\0\xdc\x01\0\0\xf5\x0e\0\0\x0b\xe0\x01\0\xe8\x0b\0\0\xdc\
-\x01\0\0\x60\x0e\0\0\x10\x64\x02\0\xf8\x0b\0\0\xdc\x01\0\0\x60\x0e\0\0\x09\x64\
-\x02\0\x08\x0c\0\0\xdc\x01\0\0\x60\x0e\0\0\x10\x64\x02\0\x20\x0c\0\0\xdc\x01\0\
-\0\x74\x0e\0\0\x09\x68\x02\0\x38\x0c\0\0\xdc\x01\0\0\x74\x0e\0\0\x09\x68\x02\0\
-\x48\x0c\0\0\xdc\x01\0\0\xc0\x0e\0\0\x0e\x6c\x02\0\x60\x0c\0\0\xdc\x01\0\0\xf5\
-\x0e\0\0\x0b\x70\x02\0\x68\x0c\0\0\xdc\x01\0\0\0\0\0\0\0\0\0\0\x70\x0c\0\0\xdc\
-\x01\0\0\x05\x0f\0\0\x03\x18\x03\0\x10\0\0\0\xbb\x01\0\0\x13\0\0\0\x20\0\0\0\
-\x20\0\0\0\x40\x02\0\0\0\0\0\0\x28\0\0\0\x20\0\0\0\x7c\x02\0\0\0\0\0\0\x68\0\0\
-\0\x20\0\0\0\xa9\x02\0\0\0\0\0\0\x78\0\0\0\x27\0\0\0\x7a\x07\0\0\0\0\0\0\xb8\0\
-\0\0\x27\0\0\0\xb5\x07\0\0\0\0\0\0\x08\x01\0\0\x4e\0\0\0\xa9\x02\0\0\0\0\0\0\
-\x70\x04\0\0\x27\0\0\0\x8a\x08\0\0\0\0\0\0\x90\x05\0\0\x50\0\0\0\x12\x0a\0\0\0\
-\0\0\0\xc8\x05\0\0\x50\0\0\0\x4a\x0a\0\0\0\0\0\0\0\x06\0\0\x50\0\0\0\x7d\x0a\0\
-\0\0\0\0\0\x50\x06\0\0\x27\0\0\0\xe6\x0a\0\0\0\0\0\0\0\x07\0\0\x53\0\0\0\xee\
-\x0b\0\0\0\0\0\0\x38\x07\0\0\x53\0\0\0\x40\x02\0\0\0\0\0\0\xd8\x08\0\0\x54\0\0\
-\0\xc7\x0d\0\0\0\0\0\0\x10\x09\0\0\x54\0\0\0\xfe\x0d\0\0\0\0\0\0\x48\x09\0\0\
-\x54\0\0\0\x12\x0a\0\0\0\0\0\0\x98\x09\0\0\x27\0\0\0\xe6\x0a\0\0\0\0\0\0\x48\
-\x0a\0\0\x53\0\0\0\xee\x0b\0\0\0\0\0\0\x80\x0a\0\0\x53\0\0\0\x40\x02\0\0\0\0\0\
-\0\xc9\x0f\0\0\x14\0\0\0\x20\0\0\0\x5c\0\0\0\x7c\x02\0\0\0\0\0\0\x28\0\0\0\x5c\
-\0\0\0\x40\x02\0\0\0\0\0\0\x68\0\0\0\x5c\0\0\0\x4a\x0a\0\0\0\0\0\0\x78\0\0\0\
-\x5c\0\0\0\x49\x10\0\0\0\0\0\0\x88\0\0\0\x27\0\0\0\x7a\x07\0\0\0\0\0\0\xc8\0\0\
-\0\x27\0\0\0\xb5\x07\0\0\0\0\0\0\x18\x01\0\0\x4e\0\0\0\xa9\x02\0\0\0\0\0\0\x80\
-\x04\0\0\x27\0\0\0\x8a\x08\0\0\0\0\0\0\xc0\x05\0\0\x50\0\0\0\x12\x0a\0\0\0\0\0\
-\0\xf8\x05\0\0\x50\0\0\0\x4a\x0a\0\0\0\0\0\0\x30\x06\0\0\x50\0\0\0\x7d\x0a\0\0\
-\0\0\0\0\x80\x06\0\0\x27\0\0\0\xe6\x0a\0\0\0\0\0\0\x30\x07\0\0\x53\0\0\0\xee\
-\x0b\0\0\0\0\0\0\x68\x07\0\0\x53\0\0\0\x40\x02\0\0\0\0\0\0\x08\x09\0\0\x54\0\0\
-\0\xc7\x0d\0\0\0\0\0\0\x40\x09\0\0\x54\0\0\0\xfe\x0d\0\0\0\0\0\0\x78\x09\0\0\
-\x54\0\0\0\x12\x0a\0\0\0\0\0\0\xc8\x09\0\0\x27\0\0\0\xe6\x0a\0\0\0\0\0\0\x78\
-\x0a\0\0\x53\0\0\0\xee\x0b\0\0\0\0\0\0\xb0\x0a\0\0\x53\0\0\0\x40\x02\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xa1\x01\0\0\0\0\x02\0\x88\
-\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xe0\x01\0\0\0\0\x02\0\x70\x04\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\0\x36\x01\0\0\0\0\x02\0\x50\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x73\x01\
-\0\0\0\0\x02\0\xc0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x02\0\0\0\0\x02\0\x70\x07\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xd0\x01\0\0\0\0\x02\0\xb0\x07\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\xb0\x01\0\0\0\0\x02\0\xe0\x07\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x92\x01\0\0\
-\0\0\x02\0\x30\x0b\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x46\x01\0\0\0\0\x02\0\xf8\x07\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\xf0\x01\0\0\0\0\x02\0\x98\x09\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\xc0\x01\0\0\0\0\x02\0\xb8\x0a\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x82\x01\0\0\0\
-\0\x02\0\xf8\x0a\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x64\x01\0\0\0\0\x02\0\xb8\x0b\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\x26\x01\0\0\0\0\x02\0\x38\x0c\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\x16\x01\0\0\0\0\x02\0\x40\x0c\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x9a\x01\0\0\0\0\
-\x03\0\x98\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xd8\x01\0\0\0\0\x03\0\x80\x04\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\x2e\x01\0\0\0\0\x03\0\x80\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\x6c\x01\0\0\0\0\x03\0\xd0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xf8\x01\0\0\0\0\
-\x03\0\xa0\x07\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xc8\x01\0\0\0\0\x03\0\xe0\x07\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\xa8\x01\0\0\0\0\x03\0\x10\x08\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\x8a\x01\0\0\0\0\x03\0\x60\x0b\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x3e\x01\0\0\0\0\
-\x03\0\x28\x08\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xe8\x01\0\0\0\0\x03\0\xc8\x09\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\xb8\x01\0\0\0\0\x03\0\xe8\x0a\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\x7a\x01\0\0\0\0\x03\0\x28\x0b\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x5c\x01\0\0\0\0\
-\x03\0\xe8\x0b\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x1e\x01\0\0\0\0\x03\0\x68\x0c\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\x0e\x01\0\0\0\0\x03\0\x70\x0c\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\x03\0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x03\0\x03\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x06\x01\0\0\x11\0\x06\0\0\0\0\0\0\0\0\0\x04\0\0\0\
-\0\0\0\0\x68\0\0\0\x11\0\x07\0\x20\0\0\0\0\0\0\0\x20\0\0\0\0\0\0\0\x4e\x01\0\0\
-\x11\0\x07\0\x60\0\0\0\0\0\0\0\x20\0\0\0\0\0\0\0\x69\0\0\0\x11\0\x07\0\0\0\0\0\
-\0\0\0\0\x20\0\0\0\0\0\0\0\x4f\x01\0\0\x11\0\x07\0\x40\0\0\0\0\0\0\0\x20\0\0\0\
-\0\0\0\0\x93\0\0\0\x11\0\x04\0\0\0\0\0\0\0\0\0\x10\0\0\0\0\0\0\0\x14\0\0\0\x12\
-\0\x03\0\0\0\0\0\0\0\0\0\x80\x0c\0\0\0\0\0\0\x9e\0\0\0\x12\0\x02\0\0\0\0\0\0\0\
-\0\0\x50\x0c\0\0\0\0\0\0\x75\0\0\0\x11\0\x05\0\0\0\0\0\0\0\0\0\x04\0\0\0\0\0\0\
-\0\x48\x01\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x90\x01\0\0\0\0\0\0\x01\0\0\0\x26\0\
-\0\0\xc8\x01\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\xf8\x01\0\0\0\0\0\0\x01\0\0\0\x26\
-\0\0\0\x28\x02\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x58\x02\0\0\0\0\0\0\x01\0\0\0\
-\x26\0\0\0\x88\x02\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\xb8\x02\0\0\0\0\0\0\x01\0\0\
-\0\x26\0\0\0\xe8\x02\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x18\x03\0\0\0\0\0\0\x01\0\
-\0\0\x26\0\0\0\x48\x03\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x78\x03\0\0\0\0\0\0\x01\
-\0\0\0\x26\0\0\0\xa8\x03\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\xd8\x03\0\0\0\0\0\0\
-\x01\0\0\0\x26\0\0\0\x08\x04\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x38\x04\0\0\0\0\0\
-\0\x01\0\0\0\x26\0\0\0\x70\x07\0\0\0\0\0\0\x01\0\0\0\x29\0\0\0\x88\x07\0\0\0\0\
-\0\0\x01\0\0\0\x24\0\0\0\xa0\x07\0\0\0\0\0\0\x01\0\0\0\x22\0\0\0\xb8\x0a\0\0\0\
-\0\0\0\x01\0\0\0\x29\0\0\0\xd0\x0a\0\0\0\0\0\0\x01\0\0\0\x25\0\0\0\xe8\x0a\0\0\
-\0\0\0\0\x01\0\0\0\x23\0\0\0\x58\x01\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\xa0\x01\0\
-\0\0\0\0\0\x01\0\0\0\x26\0\0\0\xd8\x01\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x08\x02\
-\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x38\x02\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x68\
-\x02\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x98\x02\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\
-\xc8\x02\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\xf8\x02\0\0\0\0\0\0\x01\0\0\0\x26\0\0\
-\0\x28\x03\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x58\x03\0\0\0\0\0\0\x01\0\0\0\x26\0\
-\0\0\x88\x03\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\xb8\x03\0\0\0\0\0\0\x01\0\0\0\x26\
-\0\0\0\xe8\x03\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\x18\x04\0\0\0\0\0\0\x01\0\0\0\
-\x26\0\0\0\x48\x04\0\0\0\0\0\0\x01\0\0\0\x26\0\0\0\xa0\x07\0\0\0\0\0\0\x01\0\0\
-\0\x29\0\0\0\xb8\x07\0\0\0\0\0\0\x01\0\0\0\x24\0\0\0\xd0\x07\0\0\0\0\0\0\x01\0\
-\0\0\x22\0\0\0\xe8\x0a\0\0\0\0\0\0\x01\0\0\0\x29\0\0\0\0\x0b\0\0\0\0\0\0\x01\0\
-\0\0\x25\0\0\0\x18\x0b\0\0\0\0\0\0\x01\0\0\0\x23\0\0\0\x78\x0f\0\0\0\0\0\0\0\0\
-\0\0\x29\0\0\0\x90\x0f\0\0\0\0\0\0\0\0\0\0\x24\0\0\0\x9c\x0f\0\0\0\0\0\0\0\0\0\
-\0\x22\0\0\0\xa8\x0f\0\0\0\0\0\0\0\0\0\0\x25\0\0\0\xb4\x0f\0\0\0\0\0\0\0\0\0\0\
-\x23\0\0\0\xcc\x0f\0\0\0\0\0\0\x0a\0\0\0\x26\0\0\0\xe4\x0f\0\0\0\0\0\0\0\0\0\0\
-\x21\0\0\0\x2c\0\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x3c\0\0\0\0\0\0\0\0\0\0\0\x20\0\
-\0\0\x50\0\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x60\0\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\x70\0\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x80\0\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x90\0\
-\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xa0\0\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xb0\0\0\0\0\
-\0\0\0\0\0\0\0\x1f\0\0\0\xc0\0\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xd0\0\0\0\0\0\0\0\
-\0\0\0\0\x1f\0\0\0\xe0\0\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xf0\0\0\0\0\0\0\0\0\0\0\
-\0\x1f\0\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x10\x01\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x20\x01\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x30\x01\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x40\x01\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x50\x01\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x60\x01\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x70\x01\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x80\x01\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x90\x01\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\xa0\x01\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xb0\x01\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\xc0\x01\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xd0\x01\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\xe0\x01\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xf0\x01\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\0\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x10\x02\0\0\0\0\0\0\0\0\0\0\x1f\
-\0\0\0\x20\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x30\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\
-\0\0\x40\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x50\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\
-\0\x60\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x70\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\x80\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x90\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\xa0\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xb0\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\xc0\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xd0\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\xe0\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xf0\x02\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\0\
-\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x10\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x20\
-\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x30\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x40\
-\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x50\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x60\
-\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x70\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x80\
-\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x90\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xa0\
-\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xb0\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xc0\
-\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xd0\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xe0\
-\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xf0\x03\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\0\x04\
-\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x10\x04\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x20\x04\0\
-\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x30\x04\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x40\x04\0\0\
-\0\0\0\0\0\0\0\0\x1f\0\0\0\x50\x04\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x60\x04\0\0\0\
-\0\0\0\0\0\0\0\x1f\0\0\0\x70\x04\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x80\x04\0\0\0\0\
-\0\0\0\0\0\0\x1f\0\0\0\x90\x04\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xa0\x04\0\0\0\0\0\
-\0\0\0\0\0\x1f\0\0\0\xb0\x04\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xc0\x04\0\0\0\0\0\0\
-\0\0\0\0\x1f\0\0\0\xd0\x04\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xe0\x04\0\0\0\0\0\0\0\
-\0\0\0\x1f\0\0\0\xf0\x04\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\0\x05\0\0\0\0\0\0\0\0\0\
-\0\x1f\0\0\0\x10\x05\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x20\x05\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x30\x05\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x40\x05\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x50\x05\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x60\x05\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x70\x05\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x80\x05\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x90\x05\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xa0\x05\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\xb0\x05\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xc0\x05\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\xd0\x05\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xe0\x05\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\xf0\x05\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\0\x06\0\0\0\0\0\0\0\0\0\0\x1f\
-\0\0\0\x10\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x20\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\
-\0\0\x30\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x40\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\
-\0\x50\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x60\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\x70\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x80\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\x90\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xa0\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\xb0\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xc0\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\xd0\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xe0\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\xf0\x06\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\0\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x10\
-\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x20\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x30\
-\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x40\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x50\
-\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x60\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x70\
-\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x80\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x90\
-\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xa0\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xb0\
-\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xc0\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xd0\
-\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xe0\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xf0\
-\x07\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\0\x08\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x10\x08\
-\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x20\x08\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x30\x08\0\
-\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x40\x08\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x50\x08\0\0\
-\0\0\0\0\0\0\0\0\x1f\0\0\0\x60\x08\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x70\x08\0\0\0\
-\0\0\0\0\0\0\0\x1f\0\0\0\x80\x08\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x90\x08\0\0\0\0\
-\0\0\0\0\0\0\x1f\0\0\0\xa0\x08\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xb0\x08\0\0\0\0\0\
-\0\0\0\0\0\x1f\0\0\0\xc0\x08\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xd0\x08\0\0\0\0\0\0\
-\0\0\0\0\x1f\0\0\0\xe0\x08\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xf0\x08\0\0\0\0\0\0\0\
-\0\0\0\x1f\0\0\0\0\x09\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x10\x09\0\0\0\0\0\0\0\0\0\
-\0\x1f\0\0\0\x20\x09\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x30\x09\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x40\x09\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x50\x09\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x60\x09\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x70\x09\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\x80\x09\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x90\x09\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\xa0\x09\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xb0\x09\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\xc0\x09\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xd0\x09\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\xe0\x09\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xf0\x09\0\0\0\0\0\0\0\0\0\0\
-\x1f\0\0\0\0\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x10\x0a\0\0\0\0\0\0\0\0\0\0\x1f\
-\0\0\0\x20\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x30\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\
-\0\0\x40\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x50\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\
-\0\x60\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x70\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\x80\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x90\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\xa0\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xb0\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\xc0\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xd0\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\
-\xe0\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xf0\x0a\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\0\
-\x0b\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x10\x0b\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x20\
-\x0b\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x30\x0b\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x40\
-\x0b\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x50\x0b\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x68\
-\x0b\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x0b\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x0b\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x0b\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x0b\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x0b\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x0b\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x0b\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x0b\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x0b\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x0c\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x0d\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x0e\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x0f\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x10\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x11\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x12\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x13\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x14\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x88\
-\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x98\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa8\
-\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xb8\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xc8\
-\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xd8\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xe8\
-\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xf8\x15\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x08\
-\x16\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x18\x16\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x28\
-\x16\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x38\x16\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x48\
-\x16\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x58\x16\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x68\
-\x16\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x78\x16\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x94\
-\x16\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xa4\x16\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xb4\
-\x16\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xc4\x16\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xd4\
-\x16\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xe4\x16\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xf4\
-\x16\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x04\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x14\
-\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x24\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x34\
-\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x44\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x54\
-\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x64\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x74\
-\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x84\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\x94\
-\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xa4\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xb4\
-\x17\0\0\0\0\0\0\0\0\0\0\x1f\0\0\0\xcc\x17\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xdc\
-\x17\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xec\x17\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xfc\
-\x17\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x0c\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x1c\
-\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x2c\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x3c\
-\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x4c\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x5c\
-\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x6c\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x7c\
-\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x8c\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\x9c\
-\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xac\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xbc\
-\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xcc\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xdc\
-\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xec\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xfc\
-\x18\0\0\0\0\0\0\0\0\0\0\x20\0\0\0\xa3\x56\xa2\x56\xa1\x56\xa4\x56\x9c\x56\x9f\
-\x56\x9d\x56\xa0\x56\x9e\x56\0\x2e\x74\x65\x78\x74\0\x2e\x72\x65\x6c\x2e\x42\
-\x54\x46\x2e\x65\x78\x74\0\x74\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x5f\x5f\x6e\
-\x65\x74\x5f\x6e\x65\x74\x5f\x64\x65\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\
-\x69\x74\0\x2e\x72\x65\x6c\x74\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x2f\x6e\x65\
-\x74\x2f\x6e\x65\x74\x5f\x64\x65\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\x69\
-\x74\0\x2e\x62\x73\x73\0\x2e\x6d\x61\x70\x73\0\x62\x63\x6f\x6e\x6e\x65\x63\x74\
-\x69\x6f\x6e\x73\0\x75\x73\x65\x5f\x6d\x61\x70\0\x2e\x6c\x6c\x76\x6d\x5f\x61\
-\x64\x64\x72\x73\x69\x67\0\x6c\x69\x63\x65\x6e\x73\x65\0\x74\x61\x72\x67\x5f\
-\x69\x66\x61\x63\x65\0\x74\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x5f\x5f\x6e\x65\
-\x74\x5f\x6e\x65\x74\x69\x66\x5f\x72\x65\x63\x65\x69\x76\x65\x5f\x73\x6b\x62\0\
-\x2e\x72\x65\x6c\x74\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x2f\x6e\x65\x74\x2f\
-\x6e\x65\x74\x69\x66\x5f\x72\x65\x63\x65\x69\x76\x65\x5f\x73\x6b\x62\0\x2e\x73\
-\x74\x72\x74\x61\x62\0\x2e\x73\x79\x6d\x74\x61\x62\0\x2e\x72\x6f\x64\x61\x74\
-\x61\0\x2e\x72\x65\x6c\x2e\x42\x54\x46\0\x4c\x49\x43\x45\x4e\x53\x45\0\x4c\x42\
-\x42\x31\x5f\x35\x39\0\x4c\x42\x42\x30\x5f\x35\x39\0\x4c\x42\x42\x31\x5f\x35\
-\x38\0\x4c\x42\x42\x30\x5f\x35\x38\0\x4c\x42\x42\x31\x5f\x33\x38\0\x4c\x42\x42\
-\x30\x5f\x33\x38\0\x4c\x42\x42\x31\x5f\x34\x37\0\x4c\x42\x42\x30\x5f\x34\x37\0\
-\x62\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x73\x36\0\x4c\x42\x42\x31\x5f\x35\
-\x36\0\x4c\x42\x42\x30\x5f\x35\x36\0\x4c\x42\x42\x31\x5f\x34\0\x4c\x42\x42\x30\
-\x5f\x34\0\x4c\x42\x42\x31\x5f\x35\x34\0\x4c\x42\x42\x30\x5f\x35\x34\0\x4c\x42\
-\x42\x31\x5f\x34\x34\0\x4c\x42\x42\x30\x5f\x34\x34\0\x4c\x42\x42\x31\x5f\x33\0\
-\x4c\x42\x42\x30\x5f\x33\0\x4c\x42\x42\x31\x5f\x34\x33\0\x4c\x42\x42\x30\x5f\
-\x34\x33\0\x4c\x42\x42\x31\x5f\x35\x32\0\x4c\x42\x42\x30\x5f\x35\x32\0\x4c\x42\
-\x42\x31\x5f\x34\x32\0\x4c\x42\x42\x30\x5f\x34\x32\0\x4c\x42\x42\x31\x5f\x33\
-\x32\0\x4c\x42\x42\x30\x5f\x33\x32\0\x4c\x42\x42\x31\x5f\x35\x30\0\x4c\x42\x42\
-\x30\x5f\x35\x30\0\x4c\x42\x42\x31\x5f\x34\x30\0\x4c\x42\x42\x30\x5f\x34\x30\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x01\0\0\0\x01\0\
-\0\0\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x40\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xc4\0\0\0\x01\0\0\0\x06\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\x40\0\0\0\0\0\0\0\x50\x0c\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x08\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x3b\0\0\0\x01\0\0\0\x06\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\x90\x0c\0\0\0\0\0\0\x80\x0c\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x08\0\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\xf5\0\0\0\x01\0\0\0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x10\
-\x19\0\0\0\0\0\0\x10\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\x5d\0\0\0\x08\0\0\0\x03\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x20\x19\0\0\0\0\0\
-\0\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x8b\0\0\
-\0\x01\0\0\0\x03\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x20\x19\0\0\0\0\0\0\x04\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x62\0\0\0\x01\0\0\0\
-\x03\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x28\x19\0\0\0\0\0\0\x80\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\x08\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x01\x01\0\0\x01\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\xa8\x19\0\0\0\0\0\0\xac\x20\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x0b\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\0\0\x54\x3a\0\0\0\0\0\0\x0c\x19\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x01\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\xed\0\0\0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x60\
-\x53\0\0\0\0\0\0\xf0\x03\0\0\0\0\0\0\x10\0\0\0\x21\0\0\0\x08\0\0\0\0\0\0\0\x18\
-\0\0\0\0\0\0\0\xc0\0\0\0\x09\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x50\x57\0\0\
-\0\0\0\0\x60\x01\0\0\0\0\0\0\x0a\0\0\0\x02\0\0\0\x08\0\0\0\0\0\0\0\x10\0\0\0\0\
-\0\0\0\x37\0\0\0\x09\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xb0\x58\0\0\0\0\0\0\
-\x60\x01\0\0\0\0\0\0\x0a\0\0\0\x03\0\0\0\x08\0\0\0\0\0\0\0\x10\0\0\0\0\0\0\0\
-\xfd\0\0\0\x09\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x10\x5a\0\0\0\0\0\0\x70\0\
-\0\0\0\0\0\0\x0a\0\0\0\x08\0\0\0\x08\0\0\0\0\0\0\0\x10\0\0\0\0\0\0\0\x07\0\0\0\
-\x09\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x80\x5a\0\0\0\0\0\0\xc0\x18\0\0\0\0\
-\0\0\x0a\0\0\0\x09\0\0\0\x08\0\0\0\0\0\0\0\x10\0\0\0\0\0\0\0\x7d\0\0\0\x03\x4c\
-\xff\x6f\0\0\0\x80\0\0\0\0\0\0\0\0\0\0\0\0\x40\x73\0\0\0\0\0\0\x12\0\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xe5\0\0\0\x03\0\0\0\0\0\0\
-\0\0\0\0\0\0\0\0\0\0\0\0\0\x52\x73\0\0\0\0\0\0\x08\x02\0\0\0\0\0\0\0\0\0\0\0\0\
-\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0";
+\x20\x3d\x3d\x20\x31\x37\x29\x20\x26\x26\0\x20\x20\x20\x20\x20\x20\x28\x62\x70\
+\x66\x5f\x6e\x74\x6f\x68\x73\x28\x66\x72\x61\x67\x5f\x6f\x66\x66\x29\x20\x26\
+\x20\x30\x78\x31\x66\x66\x66\x29\x20\x3d\x3d\x20\x30\x20\x26\x26\0\x30\x3a\x36\
+\x36\0\x20\x20\x20\x20\x20\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\
+\x44\x28\x73\x6b\x62\x2c\x20\x74\x72\x61\x6e\x73\x70\x6f\x72\x74\x5f\x68\x65\
+\x61\x64\x65\x72\x29\x20\x21\x3d\x20\x30\x29\x20\x7b\0\x20\x20\x7d\x20\x65\x6c\
+\x73\x65\x20\x69\x66\x20\x28\x63\x6f\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\
+\x6c\x20\x3d\x3d\x20\x31\x20\x26\x26\x20\x28\x62\x70\x66\x5f\x6e\x74\x6f\x68\
+\x73\x28\x66\x72\x61\x67\x5f\x6f\x66\x66\x29\x20\x26\x20\x30\x78\x31\x66\x66\
+\x66\x29\x20\x3d\x3d\x20\x30\x29\x20\x7b\0\x20\x20\x20\x20\x63\x6f\x6e\x6e\x2e\
+\x64\x73\x74\x5f\x70\x6f\x72\x74\x20\x3d\x20\x69\x63\x6d\x70\x5f\x70\x6f\x72\
+\x74\x28\x28\x75\x38\x20\x2a\x29\x69\x70\x20\x2b\x20\x28\x76\x65\x72\x73\x69\
+\x6f\x6e\x20\x26\x20\x30\x78\x30\x66\x29\x20\x2a\x20\x34\x29\x3b\0\x20\x20\x75\
+\x31\x36\x20\x70\x6f\x72\x74\x20\x3d\x20\x30\x3b\0\x20\x20\x62\x70\x66\x5f\x70\
+\x72\x6f\x62\x65\x5f\x72\x65\x61\x64\x28\x26\x70\x6f\x72\x74\x2c\x20\x73\x69\
+\x7a\x65\x6f\x66\x28\x70\x6f\x72\x74\x29\x2c\x20\x6c\x34\x29\x3b\0\x20\x20\x72\
+\x65\x74\x75\x72\x6e\x20\x70\x6f\x72\x74\x3b\0\x20\x20\x72\x65\x74\x75\x72\x6e\
+\x20\x28\x73\x74\x72\x75\x63\x74\x20\x69\x70\x76\x36\x68\x64\x72\x20\x2a\x29\
+\x28\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\
+\x20\x68\x65\x61\x64\x29\x20\x2b\0\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\
+\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x42\x50\
+\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x6e\x65\
+\x74\x77\x6f\x72\x6b\x5f\x68\x65\x61\x64\x65\x72\x29\x29\x3b\0\x20\x20\x73\x74\
+\x72\x75\x63\x74\x20\x63\x6f\x6e\x6e\x36\x5f\x73\x20\x63\x6f\x6e\x6e\x20\x3d\
+\x20\x7b\x7d\x3b\0\x20\x20\x76\x6f\x69\x64\x20\x2a\x63\x6f\x6e\x6e\x5f\x74\x61\
+\x62\x6c\x65\x20\x3d\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\x6c\x6f\x6f\x6b\x75\
+\x70\x5f\x65\x6c\x65\x6d\x28\x26\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x73\
+\x36\x2c\x20\x26\x67\x65\x6e\x29\x3b\0\x20\x20\x69\x66\x20\x28\x28\x76\x65\x72\
+\x73\x69\x6f\x6e\x20\x26\x20\x30\x78\x66\x30\x29\x20\x21\x3d\x20\x30\x78\x36\
+\x30\x29\x20\x2f\x2a\x20\x49\x50\x76\x36\x20\x6f\x6e\x6c\x79\x20\x2a\x2f\0\x69\
+\x70\x76\x36\x68\x64\x72\0\x66\x6c\x6f\x77\x5f\x6c\x62\x6c\0\x70\x61\x79\x6c\
+\x6f\x61\x64\x5f\x6c\x65\x6e\0\x6e\x65\x78\x74\x68\x64\x72\0\x68\x6f\x70\x5f\
+\x6c\x69\x6d\x69\x74\0\x69\x6e\x36\x5f\x61\x64\x64\x72\0\x69\x6e\x36\x5f\x75\0\
+\x75\x36\x5f\x61\x64\x64\x72\x38\0\x75\x36\x5f\x61\x64\x64\x72\x31\x36\0\x75\
+\x36\x5f\x61\x64\x64\x72\x33\x32\0\x30\x3a\x34\0\x20\x20\x42\x50\x46\x5f\x43\
+\x4f\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\x54\x4f\x28\x26\x6e\x65\x78\x74\
+\x68\x64\x72\x2c\x20\x69\x70\x2c\x20\x6e\x65\x78\x74\x68\x64\x72\x29\x3b\0\x20\
+\x20\x63\x6f\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x20\x3d\x20\x69\x70\
+\x76\x36\x5f\x75\x70\x70\x65\x72\x28\x28\x75\x38\x20\x2a\x29\x69\x70\x2c\x20\
+\x6e\x65\x78\x74\x68\x64\x72\x2c\x20\x26\x6f\x66\x66\x2c\x20\x26\x66\x72\x61\
+\x67\x29\x3b\0\x20\x20\x20\x20\x69\x66\x20\x28\x6e\x65\x78\x74\x68\x64\x72\x20\
+\x21\x3d\x20\x4e\x45\x58\x54\x48\x44\x52\x5f\x48\x4f\x50\x20\x26\x26\x20\x6e\
+\x65\x78\x74\x68\x64\x72\x20\x21\x3d\x20\x4e\x45\x58\x54\x48\x44\x52\x5f\x52\
+\x4f\x55\x54\x49\x4e\x47\x20\x26\x26\0\x20\x20\x20\x20\x69\x66\x20\x28\x62\x70\
+\x66\x5f\x70\x72\x6f\x62\x65\x5f\x72\x65\x61\x64\x28\x68\x2c\x20\x73\x69\x7a\
+\x65\x6f\x66\x28\x68\x29\x2c\x20\x69\x70\x20\x2b\x20\x6f\x29\x20\x21\x3d\x20\
+\x30\x29\0\x20\x20\x20\x20\x69\x66\x20\x28\x6e\x65\x78\x74\x68\x64\x72\x20\x3d\
+\x3d\x20\x4e\x45\x58\x54\x48\x44\x52\x5f\x46\x52\x41\x47\x4d\x45\x4e\x54\x29\
+\x20\x7b\0\x20\x20\x20\x20\x20\x20\x6e\x65\x78\x74\x68\x64\x72\x20\x3d\x20\x68\
+\x5b\x30\x5d\x3b\0\x20\x20\x20\x20\x20\x20\x6f\x20\x2b\x3d\x20\x38\x3b\0\x30\
+\x3a\x36\0\x20\x20\x62\x70\x66\x5f\x70\x72\x6f\x62\x65\x5f\x72\x65\x61\x64\x28\
+\x63\x6f\x6e\x6e\x2e\x73\x72\x63\x5f\x69\x70\x2c\x20\x31\x36\x2c\x20\x26\x69\
+\x70\x2d\x3e\x73\x61\x64\x64\x72\x29\x3b\0\x20\x20\x62\x70\x66\x5f\x70\x72\x6f\
+\x62\x65\x5f\x72\x65\x61\x64\x28\x63\x6f\x6e\x6e\x2e\x64\x73\x74\x5f\x69\x70\
+\x2c\x20\x31\x36\x2c\x20\x26\x69\x70\x2d\x3e\x64\x61\x64\x64\x72\x29\x3b\0\x20\
+\x20\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\x54\x4f\
+\x28\x26\x70\x61\x79\x6c\x6f\x61\x64\x5f\x6c\x65\x6e\x2c\x20\x69\x70\x2c\x20\
+\x70\x61\x79\x6c\x6f\x61\x64\x5f\x6c\x65\x6e\x29\x3b\0\x20\x20\x69\x66\x20\x28\
+\x28\x63\x6f\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x20\x3d\x3d\x20\x36\
+\x20\x7c\x7c\x20\x63\x6f\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x20\x3d\
+\x3d\x20\x31\x37\x29\x20\x26\x26\x20\x21\x66\x72\x61\x67\x29\x20\x7b\0\x20\x20\
+\x20\x20\x62\x70\x66\x5f\x70\x72\x6f\x62\x65\x5f\x72\x65\x61\x64\x28\x70\x6f\
+\x72\x74\x73\x2c\x20\x73\x69\x7a\x65\x6f\x66\x28\x70\x6f\x72\x74\x73\x29\x2c\
+\x20\x28\x75\x38\x20\x2a\x29\x69\x70\x20\x2b\x20\x6f\x66\x66\x29\x3b\0\x20\x20\
+\x20\x20\x5f\x5f\x62\x65\x31\x36\x20\x70\x6f\x72\x74\x73\x5b\x32\x5d\x20\x3d\
+\x20\x7b\x7d\x3b\0\x20\x20\x20\x20\x63\x6f\x6e\x6e\x2e\x73\x72\x63\x5f\x70\x6f\
+\x72\x74\x20\x3d\x20\x70\x6f\x72\x74\x73\x5b\x30\x5d\x3b\0\x20\x20\x20\x20\x63\
+\x6f\x6e\x6e\x2e\x64\x73\x74\x5f\x70\x6f\x72\x74\x20\x3d\x20\x70\x6f\x72\x74\
+\x73\x5b\x31\x5d\x3b\0\x20\x20\x20\x20\x6c\x34\x5f\x6c\x65\x6e\x20\x3d\x20\x6c\
+\x34\x5f\x68\x64\x72\x6c\x65\x6e\x28\x28\x75\x38\x20\x2a\x29\x69\x70\x20\x2b\
+\x20\x6f\x66\x66\x2c\x20\x63\x6f\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\
+\x29\x3b\0\x20\x20\x75\x38\x20\x64\x6f\x66\x66\x20\x3d\x20\x30\x3b\0\x20\x20\
+\x69\x66\x20\x28\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x20\x3d\x3d\x20\x31\x37\x29\0\
+\x20\x20\x62\x70\x66\x5f\x70\x72\x6f\x62\x65\x5f\x72\x65\x61\x64\x28\x26\x64\
+\x6f\x66\x66\x2c\x20\x31\x2c\x20\x6c\x34\x20\x2b\x20\x31\x32\x29\x3b\0\x20\x20\
+\x72\x65\x74\x75\x72\x6e\x20\x28\x64\x6f\x66\x66\x20\x3e\x3e\x20\x34\x29\x20\
+\x2a\x20\x34\x3b\0\x20\x20\x7d\x20\x65\x6c\x73\x65\x20\x69\x66\x20\x28\x63\x6f\
+\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x20\x3d\x3d\x20\x35\x38\x20\x26\
+\x26\x20\x21\x66\x72\x61\x67\x29\x20\x7b\0\x20\x20\x20\x20\x63\x6f\x6e\x6e\x2e\
+\x64\x73\x74\x5f\x70\x6f\x72\x74\x20\x3d\x20\x69\x63\x6d\x70\x5f\x70\x6f\x72\
+\x74\x28\x28\x75\x38\x20\x2a\x29\x69\x70\x20\x2b\x20\x6f\x66\x66\x29\x3b\0\x20\
+\x20\x6c\x65\x6e\x20\x3d\x20\x61\x63\x63\x6f\x75\x6e\x74\x5f\x6c\x65\x6e\x28\
+\x62\x70\x66\x5f\x6e\x74\x6f\x68\x73\x28\x70\x61\x79\x6c\x6f\x61\x64\x5f\x6c\
+\x65\x6e\x29\x20\x2b\x20\x73\x69\x7a\x65\x6f\x66\x28\x73\x74\x72\x75\x63\x74\
+\x20\x69\x70\x76\x36\x68\x64\x72\x29\x2c\x20\x6f\x66\x66\x2c\0\x20\x20\x69\x66\
+\x20\x28\x61\x63\x63\x6f\x75\x6e\x74\x69\x6e\x67\x20\x3d\x3d\x20\x41\x43\x43\
+\x4f\x55\x4e\x54\x5f\x4c\x32\x29\0\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\
+\x69\x70\x5f\x6c\x65\x6e\x20\x2b\x20\x45\x54\x48\x5f\x48\x4c\x45\x4e\x3b\0\x20\
+\x20\x69\x66\x20\x28\x61\x63\x63\x6f\x75\x6e\x74\x69\x6e\x67\x20\x3d\x3d\x20\
+\x41\x43\x43\x4f\x55\x4e\x54\x5f\x50\x41\x59\x4c\x4f\x41\x44\x29\x20\x7b\0\x20\
+\x20\x20\x20\x69\x66\x20\x28\x69\x70\x5f\x6c\x65\x6e\x20\x3c\x20\x69\x70\x5f\
+\x68\x64\x72\x5f\x6c\x65\x6e\x20\x2b\x20\x6c\x34\x5f\x68\x64\x72\x5f\x6c\x65\
+\x6e\x29\0\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x70\x5f\x6c\x65\x6e\
+\x20\x2d\x20\x69\x70\x5f\x68\x64\x72\x5f\x6c\x65\x6e\x20\x2d\x20\x6c\x34\x5f\
+\x68\x64\x72\x5f\x6c\x65\x6e\x3b\0\x20\x20\x69\x66\x20\x28\x21\x63\x6f\x6e\x6e\
+\x5f\x74\x61\x62\x6c\x65\x29\0\x20\x20\x75\x36\x34\x20\x2a\x6f\x76\x61\x6c\x20\
+\x3d\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\x6c\x6f\x6f\x6b\x75\x70\x5f\x65\x6c\
+\x65\x6d\x28\x74\x61\x62\x6c\x65\x2c\x20\x6b\x65\x79\x29\x3b\0\x20\x20\x75\x36\
+\x34\x20\x6e\x76\x61\x6c\x20\x3d\x20\x6c\x65\x6e\x3b\0\x20\x20\x69\x66\x20\x28\
+\x6f\x76\x61\x6c\x29\x20\x7b\0\x20\x20\x69\x66\x20\x28\x62\x70\x66\x5f\x6d\x61\
+\x70\x5f\x75\x70\x64\x61\x74\x65\x5f\x65\x6c\x65\x6d\x28\x74\x61\x62\x6c\x65\
+\x2c\x20\x6b\x65\x79\x2c\x20\x26\x6e\x76\x61\x6c\x2c\x20\x42\x50\x46\x5f\x4e\
+\x4f\x45\x58\x49\x53\x54\x29\x20\x3d\x3d\x20\x30\x29\0\x20\x20\x6f\x76\x61\x6c\
+\x20\x3d\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\x6c\x6f\x6f\x6b\x75\x70\x5f\x65\
+\x6c\x65\x6d\x28\x74\x61\x62\x6c\x65\x2c\x20\x6b\x65\x79\x29\x3b\0\x20\x20\x69\
+\x66\x20\x28\x21\x6f\x76\x61\x6c\x29\0\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x28\
+\x73\x74\x72\x75\x63\x74\x20\x74\x63\x70\x68\x64\x72\x20\x2a\x29\x28\x42\x50\
+\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x68\x65\
+\x61\x64\x29\x20\x2b\0\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\
+\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x42\x50\x46\x5f\x43\x4f\
+\x52\x45\x5f\x52\x45\x41\x44\x28\x73\x6b\x62\x2c\x20\x74\x72\x61\x6e\x73\x70\
+\x6f\x72\x74\x5f\x68\x65\x61\x64\x65\x72\x29\x29\x3b\0\x74\x63\x70\x68\x64\x72\
+\0\x73\x6f\x75\x72\x63\x65\0\x64\x65\x73\x74\0\x61\x63\x6b\x5f\x73\x65\x71\0\
+\x72\x65\x73\x31\0\x64\x6f\x66\x66\0\x66\x69\x6e\0\x73\x79\x6e\0\x72\x73\x74\0\
+\x70\x73\x68\0\x61\x63\x6b\0\x75\x72\x67\0\x65\x63\x65\0\x63\x77\x72\0\x77\x69\
+\x6e\x64\x6f\x77\0\x75\x72\x67\x5f\x70\x74\x72\0\x30\x3a\x30\0\x20\x20\x20\x20\
+\x42\x50\x46\x5f\x43\x4f\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\x54\x4f\x28\
+\x26\x63\x6f\x6e\x6e\x2e\x73\x72\x63\x5f\x70\x6f\x72\x74\x2c\x20\x74\x63\x70\
+\x2c\x20\x73\x6f\x75\x72\x63\x65\x29\x3b\0\x20\x20\x20\x20\x42\x50\x46\x5f\x43\
+\x4f\x52\x45\x5f\x52\x45\x41\x44\x5f\x49\x4e\x54\x4f\x28\x26\x63\x6f\x6e\x6e\
+\x2e\x64\x73\x74\x5f\x70\x6f\x72\x74\x2c\x20\x74\x63\x70\x2c\x20\x64\x65\x73\
+\x74\x29\x3b\0\x20\x20\x20\x20\x6c\x34\x5f\x6c\x65\x6e\x20\x3d\x20\x6c\x34\x5f\
+\x68\x64\x72\x6c\x65\x6e\x28\x28\x75\x38\x20\x2a\x29\x74\x63\x70\x2c\x20\x63\
+\x6f\x6e\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x29\x3b\0\x20\x20\x6c\x65\x6e\
+\x20\x3d\x20\x61\x63\x63\x6f\x75\x6e\x74\x5f\x6c\x65\x6e\x28\x62\x70\x66\x5f\
+\x6e\x74\x6f\x68\x73\x28\x74\x6f\x74\x5f\x6c\x65\x6e\x29\x2c\x20\x28\x76\x65\
+\x72\x73\x69\x6f\x6e\x20\x26\x20\x30\x78\x30\x66\x29\x20\x2a\x20\x34\x2c\x20\
+\x6c\x34\x5f\x6c\x65\x6e\x29\x3b\0\x20\x20\x69\x66\x20\x28\x63\x6e\x74\x29\0\
+\x20\x20\x20\x20\x5f\x5f\x73\x79\x6e\x63\x5f\x66\x65\x74\x63\x68\x5f\x61\x6e\
+\x64\x5f\x61\x64\x64\x28\x63\x6e\x74\x2c\x20\x2d\x31\x29\x3b\0\x20\x20\x72\x65\
+\x74\x75\x72\x6e\x20\x30\x3b\0\x20\x20\x20\x20\x6e\x65\x78\x74\x68\x64\x72\x20\
+\x3d\x20\x68\x5b\x30\x5d\x3b\0\x20\x20\x20\x20\x6c\x6f\x73\x74\x2e\x63\x6f\x6e\
+\x6e\x20\x3d\x20\x63\x6f\x6e\x6e\x3b\0\x20\x20\x20\x20\x6c\x6f\x73\x74\x2e\x73\
+\x65\x71\x20\x3d\x20\x73\x65\x71\x3b\0\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\
+\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\
+\x75\x70\x64\x61\x74\x65\x5f\x65\x6c\x65\x6d\x28\x26\x6c\x6f\x73\x74\x36\x2c\
+\x20\x26\x6c\x6f\x73\x74\x2c\x20\x26\x6f\x6e\x65\x2c\0\x20\x20\x75\x33\x32\x20\
+\x69\x64\x78\x20\x3d\x20\x28\x28\x28\x73\x65\x71\x20\x26\x20\x31\x29\x20\x3c\
+\x3c\x20\x31\x29\x20\x7c\x20\x76\x36\x29\x20\x2a\x20\x4f\x56\x45\x52\x46\x4c\
+\x4f\x57\x5f\x46\x49\x45\x4c\x44\x53\x3b\0\x20\x20\x75\x36\x34\x20\x2a\x63\x6e\
+\x74\x20\x3d\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\x6c\x6f\x6f\x6b\x75\x70\x5f\
+\x65\x6c\x65\x6d\x28\x26\x6f\x76\x65\x72\x66\x6c\x6f\x77\x2c\x20\x26\x69\x64\
+\x78\x29\x3b\0\x20\x20\x20\x20\x5f\x5f\x73\x79\x6e\x63\x5f\x66\x65\x74\x63\x68\
+\x5f\x61\x6e\x64\x5f\x61\x64\x64\x28\x63\x6e\x74\x2c\x20\x6e\x29\x3b\0\x20\x20\
+\x61\x64\x64\x5f\x63\x6f\x75\x6e\x74\x65\x72\x28\x69\x64\x78\x20\x2b\x20\x4f\
+\x56\x45\x52\x46\x4c\x4f\x57\x5f\x50\x41\x43\x4b\x45\x54\x53\x2c\x20\x31\x29\
+\x3b\0\x20\x20\x69\x66\x20\x28\x6e\x65\x77\x5f\x66\x6c\x6f\x77\x29\0\x20\x20\
+\x20\x20\x61\x64\x64\x5f\x63\x6f\x75\x6e\x74\x65\x72\x28\x69\x64\x78\x20\x2b\
+\x20\x4f\x56\x45\x52\x46\x4c\x4f\x57\x5f\x46\x4c\x4f\x57\x53\x2c\x20\x31\x29\
+\x3b\0\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\
+\x20\x20\x20\x62\x70\x66\x5f\x6d\x61\x70\x5f\x75\x70\x64\x61\x74\x65\x5f\x65\
+\x6c\x65\x6d\x28\x26\x6c\x6f\x73\x74\x34\x2c\x20\x26\x6c\x6f\x73\x74\x2c\x20\
+\x26\x6f\x6e\x65\x2c\0\x74\x72\x61\x63\x65\x5f\x65\x76\x65\x6e\x74\x5f\x72\x61\
+\x77\x5f\x6e\x65\x74\x5f\x64\x65\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\x69\
+\x74\0\x76\x6c\x61\x6e\x5f\x74\x61\x67\x67\x65\x64\0\x6e\x65\x74\x77\x6f\x72\
+\x6b\x5f\x6f\x66\x66\x73\x65\x74\0\x74\x72\x61\x6e\x73\x70\x6f\x72\x74\x5f\x6f\
+\x66\x66\x73\x65\x74\x5f\x76\x61\x6c\x69\x64\0\x74\x72\x61\x6e\x73\x70\x6f\x72\
+\x74\x5f\x6f\x66\x66\x73\x65\x74\0\x74\x78\x5f\x66\x6c\x61\x67\x73\0\x67\x73\
+\x6f\x5f\x73\x69\x7a\x65\0\x67\x73\x6f\x5f\x73\x65\x67\x73\0\x67\x73\x6f\x5f\
+\x74\x79\x70\x65\0\x74\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x5f\x5f\x6e\x65\x74\
+\x5f\x6e\x65\x74\x5f\x64\x65\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\x69\x74\0\
+\x74\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x2f\x6e\x65\x74\x2f\x6e\x65\x74\x5f\
+\x64\x65\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\x69\x74\0\x61\x6c\x6c\x5f\x69\
+\x66\x61\x63\x65\x73\0\x61\x63\x63\x6f\x75\x6e\x74\x69\x6e\x67\0\x73\x61\x6d\
+\x70\x6c\x65\x5f\x72\x61\x74\x65\0\x73\x61\x6d\x70\x6c\x65\x5f\x6d\x6f\x64\x65\
+\0\x75\x73\x65\x5f\x6d\x61\x70\0\x4c\x49\x43\x45\x4e\x53\x45\0\x2e\x62\x73\x73\
+\0\x2e\x6d\x61\x70\x73\0\x2e\x72\x6f\x64\x61\x74\x61\0\x6c\x69\x63\x65\x6e\x73\
+\x65\0\x51\x64\x69\x73\x63\0\x61\x74\x74\x72\x69\x62\x75\x74\x65\x5f\x67\x72\
+\x6f\x75\x70\0\x62\x70\x66\x5f\x70\x72\x6f\x67\0\x62\x70\x66\x5f\x78\x64\x70\
+\x5f\x6c\x69\x6e\x6b\0\x62\x75\x73\x5f\x64\x6d\x61\x5f\x72\x65\x67\x69\x6f\x6e\
+\0\x62\x75\x73\x5f\x74\x79\x70\x65\0\x63\x6d\x61\0\x63\x70\x75\x5f\x72\x6d\x61\
+\x70\0\x64\x63\x62\x6e\x6c\x5f\x72\x74\x6e\x6c\x5f\x6f\x70\x73\0\x64\x65\x76\
+\x5f\x69\x66\x61\x6c\x69\x61\x73\0\x64\x65\x76\x5f\x69\x6f\x6d\x6d\x75\0\x64\
+\x65\x76\x5f\x70\x69\x6e\x5f\x69\x6e\x66\x6f\0\x64\x65\x76\x5f\x70\x6d\x5f\x64\
+\x6f\x6d\x61\x69\x6e\0\x64\x65\x76\x5f\x70\x6d\x5f\x71\x6f\x73\0\x64\x65\x76\
+\x69\x63\x65\x5f\x64\x6d\x61\x5f\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\0\x64\
+\x65\x76\x69\x63\x65\x5f\x64\x72\x69\x76\x65\x72\0\x64\x65\x76\x69\x63\x65\x5f\
+\x6e\x6f\x64\x65\0\x64\x65\x76\x69\x63\x65\x5f\x70\x72\x69\x76\x61\x74\x65\0\
+\x64\x65\x76\x69\x63\x65\x5f\x74\x79\x70\x65\0\x64\x6d\x61\x5f\x6d\x61\x70\x5f\
+\x6f\x70\x73\0\x64\x73\x61\x5f\x70\x6f\x72\x74\0\x65\x6d\x5f\x70\x65\x72\x66\
+\x5f\x64\x6f\x6d\x61\x69\x6e\0\x66\x77\x6e\x6f\x64\x65\x5f\x68\x61\x6e\x64\x6c\
+\x65\0\x68\x72\x74\x69\x6d\x65\x72\x5f\x63\x6c\x6f\x63\x6b\x5f\x62\x61\x73\x65\
+\0\x69\x6e\x5f\x64\x65\x76\x69\x63\x65\0\x69\x6e\x65\x74\x36\x5f\x64\x65\x76\0\
+\x69\x72\x71\x5f\x64\x6f\x6d\x61\x69\x6e\0\x69\x77\x5f\x68\x61\x6e\x64\x6c\x65\
+\x72\x5f\x64\x65\x66\0\x69\x77\x5f\x70\x75\x62\x6c\x69\x63\x5f\x64\x61\x74\x61\
+\0\x6b\x65\x72\x6e\x66\x73\x5f\x6e\x6f\x64\x65\0\x6b\x6f\x62\x6a\x5f\x74\x79\
+\x70\x65\0\x6c\x6f\x63\x6b\x5f\x63\x6c\x61\x73\x73\x5f\x6b\x65\x79\0\x6d\x69\
+\x6e\x69\x5f\x51\x64\x69\x73\x63\0\x6e\x65\x74\x5f\x64\x65\x76\x69\x63\x65\x5f\
+\x6f\x70\x73\0\x6e\x65\x74\x64\x65\x76\x5f\x6e\x61\x6d\x65\x5f\x6e\x6f\x64\x65\
+\0\x6e\x65\x74\x64\x65\x76\x5f\x71\x75\x65\x75\x65\0\x6e\x65\x74\x64\x65\x76\
+\x5f\x72\x78\x5f\x71\x75\x65\x75\x65\0\x6e\x65\x74\x70\x6f\x6c\x6c\x5f\x69\x6e\
+\x66\x6f\0\x6e\x65\x74\x70\x72\x69\x6f\x5f\x6d\x61\x70\0\x6e\x66\x5f\x68\x6f\
+\x6f\x6b\x5f\x65\x6e\x74\x72\x69\x65\x73\0\x70\x63\x70\x75\x5f\x6c\x73\x74\x61\
+\x74\x73\0\x70\x63\x70\x75\x5f\x73\x77\x5f\x6e\x65\x74\x73\x74\x61\x74\x73\0\
+\x70\x68\x79\x5f\x64\x65\x76\x69\x63\x65\0\x70\x6d\x5f\x73\x75\x62\x73\x79\x73\
+\x5f\x64\x61\x74\x61\0\x73\x6b\x62\x5f\x65\x78\x74\0\x73\x6f\x63\x6b\0\x77\x61\
+\x6b\x65\x5f\x69\x72\x71\0\x77\x61\x6b\x65\x75\x70\x5f\x73\x6f\x75\x72\x63\x65\
+\0\x77\x69\x72\x65\x6c\x65\x73\x73\x5f\x64\x65\x76\0\x77\x70\x61\x6e\x5f\x64\
+\x65\x76\0\x78\x64\x70\x5f\x64\x65\x76\x5f\x62\x75\x6c\x6b\x5f\x71\x75\x65\x75\
+\x65\0\x78\x70\x73\x5f\x64\x65\x76\x5f\x6d\x61\x70\x73\0\0\0\0\x9f\xeb\x01\0\
+\x20\0\0\0\0\0\0\0\x24\0\0\0\x24\0\0\0\xd4\x26\0\0\xf8\x26\0\0\x14\x03\0\0\x08\
+\0\0\0\xd7\x01\0\0\x01\0\0\0\0\0\0\0\x3e\0\0\0\x6a\x2b\0\0\x01\0\0\0\0\0\0\0\
+\x34\x01\0\0\x10\0\0\0\xd7\x01\0\0\x36\x01\0\0\0\0\0\0\xfc\x01\0\0\x11\x02\0\0\
+\x30\x50\x07\0\x28\0\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x30\0\0\0\xfc\x01\0\0\xf3\
+\x06\0\0\x1c\x10\x03\0\x58\0\0\0\xfc\x01\0\0\xf3\x06\0\0\x1c\x10\x03\0\x68\0\0\
+\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x70\0\0\0\xfc\x01\0\0\x2e\x07\0\0\x1d\x14\x03\0\
+\x80\0\0\0\xfc\x01\0\0\x2e\x07\0\0\x1d\x14\x03\0\x88\0\0\0\xfc\x01\0\0\xf3\x06\
+\0\0\x35\x10\x03\0\xa8\0\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xb0\0\0\0\xfc\x01\0\0\
+\x8e\x07\0\0\x0e\xec\x06\0\xc8\0\0\0\xfc\x01\0\0\x8e\x07\0\0\x0e\xec\x06\0\xd0\
+\0\0\0\xfc\x01\0\0\xb8\x07\0\0\x08\xf8\x06\0\xe8\0\0\0\xfc\x01\0\0\xb8\x07\0\0\
+\x07\xf8\x06\0\x10\x01\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x18\x01\0\0\xfc\x01\0\0\
+\xd7\x07\0\0\x13\xfc\x06\0\x48\x01\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x50\x01\0\0\
+\xfc\x01\0\0\xd7\x07\0\0\x13\xfc\x06\0\x60\x01\0\0\xfc\x01\0\0\xd7\x07\0\0\x13\
+\xfc\x06\0\x68\x01\0\0\xfc\x01\0\0\xd7\x07\0\0\x09\xfc\x06\0\x78\x01\0\0\xfc\
+\x01\0\0\0\0\0\0\0\0\0\0\x80\x01\0\0\xfc\x01\0\0\xbb\x19\0\0\x0a\0\x07\0\xc0\
+\x01\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xd0\x01\0\0\xfc\x01\0\0\xf2\x19\0\0\x07\
+\x0c\x07\0\xe0\x01\0\0\xfc\x01\0\0\xf2\x19\0\0\x07\x0c\x07\0\xe8\x01\0\0\xfc\
+\x01\0\0\xf2\x19\0\0\x07\x0c\x07\0\xf8\x01\0\0\xfc\x01\0\0\x21\x1a\0\0\x07\x50\
+\x04\0\0\x02\0\0\xfc\x01\0\0\x31\x1a\0\0\x07\x58\x04\0\x18\x02\0\0\xfc\x01\0\0\
+\x31\x1a\0\0\x07\x58\x04\0\x20\x02\0\0\xfc\x01\0\0\x49\x1a\0\0\x07\x60\x04\0\
+\x38\x02\0\0\xfc\x01\0\0\x49\x1a\0\0\x07\x60\x04\0\x40\x02\0\0\xfc\x01\0\0\x6d\
+\x1a\0\0\x0c\x64\x04\0\x48\x02\0\0\xfc\x01\0\0\x6d\x1a\0\0\x24\x64\x04\0\x60\
+\x02\0\0\xfc\x01\0\0\x6d\x1a\0\0\x0c\x64\x04\0\x70\x02\0\0\xfc\x01\0\0\x6d\x1a\
+\0\0\x22\x64\x04\0\x90\x02\0\0\xfc\x01\0\0\xa2\x1a\0\0\x07\x14\x07\0\xa8\x02\0\
+\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xb0\x02\0\0\xfc\x01\0\0\xb4\x1a\0\0\x0a\x68\x04\
+\0\xc8\x02\0\0\xfc\x01\0\0\xe2\x1a\0\0\x07\x6c\x04\0\xd8\x02\0\0\xfc\x01\0\0\
+\xef\x1a\0\0\x11\x74\x04\0\xf8\x02\0\0\xfc\x01\0\0\xef\x1a\0\0\x16\x74\x04\0\
+\x10\x03\0\0\xfc\x01\0\0\xef\x1a\0\0\x14\x74\x04\0\x30\x03\0\0\xfc\x01\0\0\xa2\
+\x1a\0\0\x07\x14\x07\0\x38\x03\0\0\xfc\x01\0\0\x16\x1b\0\0\x0d\xa4\x04\0\x58\
+\x03\0\0\xfc\x01\0\0\x2b\x1b\0\0\x11\xa8\x04\0\x60\x03\0\0\xfc\x01\0\0\x2b\x1b\
+\0\0\x07\xa8\x04\0\x70\x03\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x78\x03\0\0\xfc\x01\
+\0\0\x40\x1b\0\0\x0c\xac\x04\0\x98\x03\0\0\xfc\x01\0\0\x6f\x1b\0\0\x07\xb4\x04\
+\0\xa8\x03\0\0\xfc\x01\0\0\x79\x1b\0\0\x03\xbc\x04\0\xb0\x03\0\0\xfc\x01\0\0\
+\x97\x1b\0\0\x08\xc0\x04\0\xb8\x03\0\0\xfc\x01\0\0\x97\x1b\0\0\x10\xc0\x04\0\
+\xc0\x03\0\0\xfc\x01\0\0\x97\x1b\0\0\x18\xc0\x04\0\xd0\x03\0\0\xfc\x01\0\0\x97\
+\x1b\0\0\x07\xc0\x04\0\xe0\x03\0\0\xfc\x01\0\0\xb5\x1b\0\0\x05\xc4\x04\0\xe8\
+\x03\0\0\xfc\x01\0\0\xd6\x1b\0\0\x0b\xc8\x04\0\x08\x04\0\0\xfc\x01\0\0\xe9\x1b\
+\0\0\x0f\xcc\x04\0\x10\x04\0\0\xfc\x01\0\0\xe9\x1b\0\0\x09\xcc\x04\0\x20\x04\0\
+\0\xfc\x01\0\0\xb5\x1b\0\0\x05\xc4\x04\0\x28\x04\0\0\xfc\x01\0\0\xfc\x1b\0\0\
+\x09\xd0\x04\0\x48\x04\0\0\xfc\x01\0\0\x28\x1c\0\0\x09\xd4\x04\0\x58\x04\0\0\
+\xfc\x01\0\0\x34\x1c\0\0\x05\xdc\x04\0\x68\x04\0\0\xfc\x01\0\0\x54\x1c\0\0\x07\
+\x20\x07\0\xa0\x04\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xa8\x04\0\0\xfc\x01\0\0\x77\
+\x1c\0\0\x1b\xe8\x02\0\xd0\x04\0\0\xfc\x01\0\0\x77\x1c\0\0\x1b\xe8\x02\0\xe0\
+\x04\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xe8\x04\0\0\xfc\x01\0\0\xac\x1c\0\0\x1b\
+\xec\x02\0\xf8\x04\0\0\xfc\x01\0\0\xac\x1c\0\0\x1b\xec\x02\0\x08\x05\0\0\xfc\
+\x01\0\0\xeb\x1c\0\0\x11\x94\x05\0\x18\x05\0\0\xfc\x01\0\0\x2b\x1b\0\0\x11\xac\
+\x05\0\x30\x05\0\0\xfc\x01\0\0\x06\x1d\0\0\x06\x98\x05\0\x38\x05\0\0\xfc\x01\0\
+\0\x2b\x1b\0\0\x07\xac\x05\0\x48\x05\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x50\x05\0\
+\0\xfc\x01\0\0\x14\x1d\0\0\x16\xb0\x05\0\x70\x05\0\0\xfc\x01\0\0\x77\x1c\0\0\
+\x34\xe8\x02\0\x80\x05\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x88\x05\0\0\xfc\x01\0\0\
+\x52\x1d\0\0\x03\xb4\x05\0\xa0\x05\0\0\xfc\x01\0\0\x75\x1d\0\0\x08\xb8\x05\0\
+\xa8\x05\0\0\xfc\x01\0\0\x75\x1d\0\0\x10\xb8\x05\0\xb0\x05\0\0\xfc\x01\0\0\x75\
+\x1d\0\0\x07\xb8\x05\0\xd0\x05\0\0\xfc\x01\0\0\xf5\x1d\0\0\x03\xc0\x05\0\x18\
+\x06\0\0\xfc\x01\0\0\x2d\x1e\0\0\x03\xc4\x05\0\x40\x06\0\0\xfc\x01\0\0\x61\x1e\
+\0\0\x03\xc8\x05\0\x80\x06\0\0\xfc\x01\0\0\xf5\x1d\0\0\x03\xc0\x05\0\x88\x06\0\
+\0\xfc\x01\0\0\x94\x1e\0\0\x03\xcc\x05\0\xb8\x06\0\0\xfc\x01\0\0\xf5\x1d\0\0\
+\x03\xc0\x05\0\xc0\x06\0\0\xfc\x01\0\0\xc5\x1e\0\0\x03\xd0\x05\0\xd0\x06\0\0\
+\xfc\x01\0\0\xf4\x1e\0\0\x0d\xd4\x05\0\xd8\x06\0\0\xfc\x01\0\0\xf4\x1e\0\0\x1b\
+\xd4\x05\0\xe8\x06\0\0\xfc\x01\0\0\x29\x1f\0\0\x08\xd8\x05\0\xf0\x06\0\0\xfc\
+\x01\0\0\x29\x1f\0\0\x1c\xd8\x05\0\xf8\x06\0\0\xfc\x01\0\0\x29\x1f\0\0\x2b\xd8\
+\x05\0\x20\x07\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x28\x07\0\0\xfc\x01\0\0\x5b\x1f\
+\0\0\x07\xdc\x05\0\x40\x07\0\0\xfc\x01\0\0\x5b\x1f\0\0\x07\xdc\x05\0\x48\x07\0\
+\0\xfc\x01\0\0\xf4\x1e\0\0\x07\xd4\x05\0\x50\x07\0\0\xfc\x01\0\0\x8e\x1f\0\0\
+\x13\xf0\x05\0\x60\x07\0\0\xfc\x01\0\0\x8e\x1f\0\0\x21\xf0\x05\0\x68\x07\0\0\
+\xfc\x01\0\0\x8e\x1f\0\0\x25\xf0\x05\0\x70\x07\0\0\xfc\x01\0\0\x8e\x1f\0\0\x39\
+\xf0\x05\0\x78\x07\0\0\xfc\x01\0\0\x8e\x1f\0\0\x0e\xf0\x05\0\x80\x07\0\0\xfc\
+\x01\0\0\xd8\x1f\0\0\x2b\xf4\x05\0\x88\x07\0\0\xfc\x01\0\0\xd8\x1f\0\0\x3b\xf4\
+\x05\0\x98\x07\0\0\xfc\x01\0\0\xd8\x1f\0\0\x28\xf4\x05\0\xa8\x07\0\0\xfc\x01\0\
+\0\x18\x20\0\0\x07\x94\x03\0\xb8\x07\0\0\xfc\x01\0\0\xd8\x1f\0\0\x2b\xf4\x05\0\
+\xc0\x07\0\0\xfc\x01\0\0\x28\x20\0\0\x03\x98\x03\0\xd8\x07\0\0\xfc\x01\0\0\x53\
+\x20\0\0\x0a\x9c\x03\0\xe0\x07\0\0\xfc\x01\0\0\xd8\x1f\0\0\x13\xf4\x05\0\x18\
+\x08\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x20\x08\0\0\xfc\x01\0\0\x62\x20\0\0\x1d\
+\xfc\x02\0\x40\x08\0\0\xfc\x01\0\0\x62\x20\0\0\x1d\xfc\x02\0\x50\x08\0\0\xfc\
+\x01\0\0\0\0\0\0\0\0\0\0\x58\x08\0\0\xfc\x01\0\0\x99\x20\0\0\x1d\0\x03\0\x70\
+\x08\0\0\xfc\x01\0\0\x99\x20\0\0\x1d\0\x03\0\x80\x08\0\0\xfc\x01\0\0\xda\x20\0\
+\0\x12\x3c\x06\0\xb0\x08\0\0\xfc\x01\0\0\x2b\x1b\0\0\x11\x5c\x06\0\xc8\x08\0\0\
+\xfc\x01\0\0\x06\x1d\0\0\x06\x40\x06\0\xd0\x08\0\0\xfc\x01\0\0\x2b\x1b\0\0\x07\
+\x5c\x06\0\xe0\x08\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xe8\x08\0\0\xfc\x01\0\0\xf6\
+\x20\0\0\x16\x60\x06\0\x08\x09\0\0\xfc\x01\0\0\x62\x20\0\0\x36\xfc\x02\0\x18\
+\x09\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x20\x09\0\0\xfc\x01\0\0\x52\x1d\0\0\x03\
+\x64\x06\0\x38\x09\0\0\xfc\x01\0\0\x35\x21\0\0\x08\x68\x06\0\x40\x09\0\0\xfc\
+\x01\0\0\x35\x21\0\0\x10\x68\x06\0\x48\x09\0\0\xfc\x01\0\0\x35\x21\0\0\x07\x68\
+\x06\0\x80\x09\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x88\x09\0\0\xfc\x01\0\0\xc4\x21\
+\0\0\x03\x70\x06\0\xa0\x09\0\0\xfc\x01\0\0\xf1\x21\0\0\x28\x74\x06\0\xb0\x09\0\
+\0\xfc\x01\0\0\x2f\x22\0\0\x20\xe0\x03\0\xf0\x09\0\0\xfc\x01\0\0\x6f\x22\0\0\
+\x29\xf0\x03\0\x18\x0a\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\x28\x0a\0\0\
+\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\x30\x0a\0\0\xfc\x01\0\0\xa2\x22\0\0\x09\
+\xf8\x03\0\x50\x0a\0\0\xfc\x01\0\0\xc9\x22\0\0\x11\0\x04\0\x58\x0a\0\0\xfc\x01\
+\0\0\xdf\x22\0\0\x09\x04\x04\0\x68\x0a\0\0\xfc\x01\0\0\xf1\x21\0\0\x11\x74\x06\
+\0\x90\x0a\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x98\x0a\0\0\xfc\x01\0\0\xf1\x22\0\0\
+\x03\x78\x06\0\xc0\x0a\0\0\xfc\x01\0\0\x20\x23\0\0\x12\x7c\x06\0\xd0\x0a\0\0\
+\xfc\x01\0\0\x20\x23\0\0\x03\x7c\x06\0\0\x0b\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\
+\x08\x0b\0\0\xfc\x01\0\0\x4f\x23\0\0\x03\x80\x06\0\x18\x0b\0\0\xfc\x01\0\0\x84\
+\x23\0\0\x0d\x84\x06\0\x40\x0b\0\0\xfc\x01\0\0\x84\x23\0\0\x16\x84\x06\0\x50\
+\x0b\0\0\xfc\x01\0\0\x84\x23\0\0\x1b\x84\x06\0\x70\x0b\0\0\xfc\x01\0\0\xc2\x23\
+\0\0\x33\x8c\x06\0\x88\x0b\0\0\xfc\x01\0\0\xfc\x23\0\0\x0c\x88\x06\0\x98\x0b\0\
+\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xa0\x0b\0\0\xfc\x01\0\0\xc2\x23\0\0\x05\x8c\x06\
+\0\xb8\x0b\0\0\xfc\x01\0\0\x16\x24\0\0\x15\x90\x06\0\xc0\x0b\0\0\xfc\x01\0\0\
+\x16\x24\0\0\x13\x90\x06\0\xc8\x0b\0\0\xfc\x01\0\0\x34\x24\0\0\x15\x94\x06\0\
+\xd0\x0b\0\0\xfc\x01\0\0\x34\x24\0\0\x13\x94\x06\0\xd8\x0b\0\0\xfc\x01\0\0\x52\
+\x24\0\0\x2d\x98\x06\0\xe0\x0b\0\0\xfc\x01\0\0\x89\x24\0\0\x06\x60\x03\0\xf8\
+\x0b\0\0\xfc\x01\0\0\x98\x24\0\0\x07\x64\x03\0\0\x0c\0\0\xfc\x01\0\0\xae\x24\0\
+\0\x1f\x6c\x03\0\x10\x0c\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x18\x0c\0\0\xfc\x01\0\
+\0\xae\x24\0\0\x03\x6c\x03\0\x30\x0c\0\0\xfc\x01\0\0\xd3\x24\0\0\x0b\x70\x03\0\
+\x38\x0c\0\0\xfc\x01\0\0\xd3\x24\0\0\x16\x70\x03\0\x68\x0c\0\0\xfc\x01\0\0\xed\
+\x24\0\0\x1c\x9c\x06\0\x78\x0c\0\0\xfc\x01\0\0\xed\x24\0\0\x22\x9c\x06\0\x90\
+\x0c\0\0\xfc\x01\0\0\x1a\x25\0\0\x28\xa0\x06\0\xa8\x0c\0\0\xfc\x01\0\0\x18\x20\
+\0\0\x07\x94\x03\0\xb8\x0c\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xc0\x0c\0\0\xfc\x01\
+\0\0\x28\x20\0\0\x03\x98\x03\0\xd8\x0c\0\0\xfc\x01\0\0\x53\x20\0\0\x0a\x9c\x03\
+\0\xe0\x0c\0\0\xfc\x01\0\0\x1a\x25\0\0\x13\xa0\x06\0\xe8\x0c\0\0\xfc\x01\0\0\
+\x49\x25\0\0\x15\xa8\x06\0\xf8\x0c\0\0\xfc\x01\0\0\x93\x25\0\0\x07\x2c\x03\0\
+\x10\x0d\0\0\xfc\x01\0\0\x93\x25\0\0\x07\x2c\x03\0\x18\x0d\0\0\xfc\x01\0\0\xb3\
+\x25\0\0\x13\x30\x03\0\x38\x0d\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x40\x0d\0\0\xfc\
+\x01\0\0\xd1\x25\0\0\x07\x34\x03\0\x58\x0d\0\0\xfc\x01\0\0\xd1\x25\0\0\x07\x34\
+\x03\0\x60\x0d\0\0\xfc\x01\0\0\xf8\x25\0\0\x1d\x38\x03\0\x78\x0d\0\0\xfc\x01\0\
+\0\xf8\x25\0\0\x09\x38\x03\0\x80\x0d\0\0\xfc\x01\0\0\x22\x26\0\0\x20\x40\x03\0\
+\x98\x0d\0\0\xfc\x01\0\0\x4f\x26\0\0\x07\xb0\x06\0\xa0\x0d\0\0\xfc\x01\0\0\0\0\
+\0\0\0\0\0\0\xc0\x0d\0\0\xfc\x01\0\0\x62\x26\0\0\x0f\xfc\x04\0\xd0\x0d\0\0\xfc\
+\x01\0\0\x91\x26\0\0\x07\0\x05\0\xd8\x0d\0\0\xfc\x01\0\0\xa3\x26\0\0\x07\x04\
+\x05\0\xe8\x0d\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\0\x0e\0\0\xfc\x01\0\0\xb1\x26\0\
+\0\x07\x14\x05\0\x18\x0e\0\0\xfc\x01\0\0\xb1\x26\0\0\x07\x14\x05\0\x28\x0e\0\0\
+\xfc\x01\0\0\xf1\x26\0\0\x0a\x20\x05\0\x40\x0e\0\0\xfc\x01\0\0\x1b\x27\0\0\x07\
+\x24\x05\0\x48\x0e\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x70\x0e\0\0\xfc\x01\0\0\0\0\
+\0\0\0\0\0\0\x88\x0e\0\0\xfc\x01\0\0\x28\x27\0\0\x1c\xd4\x02\0\xa0\x0e\0\0\xfc\
+\x01\0\0\x28\x27\0\0\x1c\xd4\x02\0\xb0\x0e\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xb8\
+\x0e\0\0\xfc\x01\0\0\x5e\x27\0\0\x1c\xd8\x02\0\xd0\x0e\0\0\xfc\x01\0\0\x5e\x27\
+\0\0\x1c\xd8\x02\0\xd8\x0e\0\0\xfc\x01\0\0\x28\x27\0\0\x35\xd4\x02\0\xf8\x0e\0\
+\0\xfc\x01\0\0\xf8\x27\0\0\x05\xe4\x05\0\x30\x0f\0\0\xfc\x01\0\0\x2d\x28\0\0\
+\x05\xe8\x05\0\x50\x0f\0\0\xfc\x01\0\0\x60\x28\0\0\x28\xec\x05\0\x60\x0f\0\0\
+\xfc\x01\0\0\x89\x24\0\0\x06\x60\x03\0\x68\x0f\0\0\xfc\x01\0\0\x98\x24\0\0\x07\
+\x64\x03\0\x70\x0f\0\0\xfc\x01\0\0\xae\x24\0\0\x1f\x6c\x03\0\x80\x0f\0\0\xfc\
+\x01\0\0\0\0\0\0\0\0\0\0\x88\x0f\0\0\xfc\x01\0\0\xae\x24\0\0\x03\x6c\x03\0\xa0\
+\x0f\0\0\xfc\x01\0\0\xd3\x24\0\0\x0b\x70\x03\0\xa8\x0f\0\0\xfc\x01\0\0\xd3\x24\
+\0\0\x16\x70\x03\0\xb8\x0f\0\0\xfc\x01\0\0\x92\x28\0\0\x15\xfc\x05\0\xc8\x0f\0\
+\0\xfc\x01\0\0\x92\x28\0\0\x2a\xfc\x05\0\xd0\x0f\0\0\xfc\x01\0\0\x93\x25\0\0\
+\x07\x2c\x03\0\xe8\x0f\0\0\xfc\x01\0\0\x93\x25\0\0\x07\x2c\x03\0\xf0\x0f\0\0\
+\xfc\x01\0\0\xb3\x25\0\0\x13\x30\x03\0\0\x10\0\0\xfc\x01\0\0\xd1\x25\0\0\x07\
+\x34\x03\0\x10\x10\0\0\xfc\x01\0\0\xd1\x25\0\0\x07\x34\x03\0\x18\x10\0\0\xfc\
+\x01\0\0\0\0\0\0\0\0\0\0\x28\x10\0\0\xfc\x01\0\0\xf8\x25\0\0\x1d\x38\x03\0\x38\
+\x10\0\0\xfc\x01\0\0\xf8\x25\0\0\x09\x38\x03\0\x40\x10\0\0\xfc\x01\0\0\x22\x26\
+\0\0\x20\x40\x03\0\x50\x10\0\0\xfc\x01\0\0\x4f\x26\0\0\x07\0\x06\0\x58\x10\0\0\
+\xfc\x01\0\0\0\0\0\0\0\0\0\0\x78\x10\0\0\xfc\x01\0\0\x62\x26\0\0\x0f\xfc\x04\0\
+\x88\x10\0\0\xfc\x01\0\0\x91\x26\0\0\x07\0\x05\0\x90\x10\0\0\xfc\x01\0\0\xa3\
+\x26\0\0\x07\x04\x05\0\xa0\x10\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xb8\x10\0\0\xfc\
+\x01\0\0\xb1\x26\0\0\x07\x14\x05\0\xd0\x10\0\0\xfc\x01\0\0\xb1\x26\0\0\x07\x14\
+\x05\0\xe0\x10\0\0\xfc\x01\0\0\xf1\x26\0\0\x0a\x20\x05\0\xf8\x10\0\0\xfc\x01\0\
+\0\x1b\x27\0\0\x07\x24\x05\0\0\x11\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x10\x11\0\0\
+\xfc\x01\0\0\xd9\x28\0\0\x07\x30\x07\0\x20\x11\0\0\xfc\x01\0\0\xe4\x28\0\0\x05\
+\x34\x07\0\x28\x11\0\0\xfc\x01\0\0\x07\x29\0\0\x03\x58\x07\0\x40\x11\0\0\xfc\
+\x01\0\0\0\0\0\0\0\0\0\0\x68\x11\0\0\xfc\x01\0\0\x13\x29\0\0\x0f\x20\x04\0\x70\
+\x11\0\0\xfc\x01\0\0\x2f\x22\0\0\x20\xe0\x03\0\xb0\x11\0\0\xfc\x01\0\0\x6f\x22\
+\0\0\x29\xf0\x03\0\xd8\x11\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\xe8\x11\0\
+\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\xf8\x11\0\0\xfc\x01\0\0\xa2\x22\0\0\
+\x09\xf8\x03\0\x18\x12\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x40\x12\0\0\xfc\x01\0\0\
+\x13\x29\0\0\x0f\x20\x04\0\x58\x12\0\0\xfc\x01\0\0\x2f\x22\0\0\x20\xe0\x03\0\
+\xa8\x12\0\0\xfc\x01\0\0\x6f\x22\0\0\x29\xf0\x03\0\xd0\x12\0\0\xfc\x01\0\0\x6f\
+\x22\0\0\x09\xf0\x03\0\xe8\x12\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\xf8\
+\x12\0\0\xfc\x01\0\0\xa2\x22\0\0\x09\xf8\x03\0\x18\x13\0\0\xfc\x01\0\0\0\0\0\0\
+\0\0\0\0\x40\x13\0\0\xfc\x01\0\0\x13\x29\0\0\x0f\x20\x04\0\x58\x13\0\0\xfc\x01\
+\0\0\x2f\x22\0\0\x20\xe0\x03\0\xa8\x13\0\0\xfc\x01\0\0\x6f\x22\0\0\x29\xf0\x03\
+\0\xd0\x13\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\xe8\x13\0\0\xfc\x01\0\0\
+\x6f\x22\0\0\x09\xf0\x03\0\xf8\x13\0\0\xfc\x01\0\0\xa2\x22\0\0\x09\xf8\x03\0\
+\x18\x14\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x40\x14\0\0\xfc\x01\0\0\x13\x29\0\0\
+\x0f\x20\x04\0\x58\x14\0\0\xfc\x01\0\0\x2f\x22\0\0\x20\xe0\x03\0\xa8\x14\0\0\
+\xfc\x01\0\0\x6f\x22\0\0\x29\xf0\x03\0\xd8\x14\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\
+\xf0\x03\0\xf8\x14\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\x08\x15\0\0\xfc\
+\x01\0\0\xa2\x22\0\0\x09\xf8\x03\0\x30\x15\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x60\
+\x15\0\0\xfc\x01\0\0\x13\x29\0\0\x0f\x20\x04\0\x80\x15\0\0\xfc\x01\0\0\x2f\x22\
+\0\0\x20\xe0\x03\0\xd0\x15\0\0\xfc\x01\0\0\x6f\x22\0\0\x29\xf0\x03\0\0\x16\0\0\
+\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\x20\x16\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\
+\xf0\x03\0\x30\x16\0\0\xfc\x01\0\0\xa2\x22\0\0\x09\xf8\x03\0\x50\x16\0\0\xfc\
+\x01\0\0\0\0\0\0\0\0\0\0\x78\x16\0\0\xfc\x01\0\0\x13\x29\0\0\x0f\x20\x04\0\x80\
+\x16\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xa8\x16\0\0\xfc\x01\0\0\x27\x29\0\0\x11\
+\xc0\x06\0\x18\x17\0\0\xfc\x01\0\0\x3d\x29\0\0\x0e\xc4\x06\0\x28\x17\0\0\xfc\
+\x01\0\0\x27\x29\0\0\x11\xc0\x06\0\x40\x17\0\0\xfc\x01\0\0\x51\x29\0\0\x16\xcc\
+\x06\0\x68\x17\0\0\xfc\x01\0\0\x8f\x29\0\0\x19\x70\x05\0\x78\x17\0\0\xfc\x01\0\
+\0\x8f\x29\0\0\x1f\x70\x05\0\x80\x17\0\0\xfc\x01\0\0\x8f\x29\0\0\x25\x70\x05\0\
+\xa0\x17\0\0\xfc\x01\0\0\x27\x29\0\0\x11\xc0\x06\0\xa8\x17\0\0\xfc\x01\0\0\xc6\
+\x29\0\0\x0e\x40\x05\0\xc0\x17\0\0\xfc\x01\0\0\xd9\x28\0\0\x07\x44\x05\0\xc8\
+\x17\0\0\xfc\x01\0\0\xf9\x29\0\0\x05\x48\x05\0\xd0\x17\0\0\xfc\x01\0\0\x1b\x2a\
+\0\0\x13\x78\x05\0\xf8\x17\0\0\xfc\x01\0\0\xc6\x29\0\0\x0e\x40\x05\0\x10\x18\0\
+\0\xfc\x01\0\0\xd9\x28\0\0\x07\x44\x05\0\x20\x18\0\0\xfc\x01\0\0\xf9\x29\0\0\
+\x05\x48\x05\0\x28\x18\0\0\xfc\x01\0\0\x45\x2a\0\0\x07\x7c\x05\0\x30\x18\0\0\
+\xfc\x01\0\0\x55\x2a\0\0\x15\x80\x05\0\x50\x18\0\0\xfc\x01\0\0\xc6\x29\0\0\x0e\
+\x40\x05\0\x68\x18\0\0\xfc\x01\0\0\xd9\x28\0\0\x07\x44\x05\0\x78\x18\0\0\xfc\
+\x01\0\0\0\0\0\0\0\0\0\0\x88\x18\0\0\xfc\x01\0\0\x27\x29\0\0\x11\x10\x06\0\xb0\
+\x18\0\0\xfc\x01\0\0\x3d\x29\0\0\x0e\x14\x06\0\xc0\x18\0\0\xfc\x01\0\0\x27\x29\
+\0\0\x11\x10\x06\0\xd8\x18\0\0\xfc\x01\0\0\x7f\x2a\0\0\x16\x1c\x06\0\0\x19\0\0\
+\xfc\x01\0\0\x8f\x29\0\0\x19\x70\x05\0\x10\x19\0\0\xfc\x01\0\0\x8f\x29\0\0\x25\
+\x70\x05\0\x30\x19\0\0\xfc\x01\0\0\x27\x29\0\0\x11\x10\x06\0\x38\x19\0\0\xfc\
+\x01\0\0\xc6\x29\0\0\x0e\x40\x05\0\x50\x19\0\0\xfc\x01\0\0\xd9\x28\0\0\x07\x44\
+\x05\0\x58\x19\0\0\xfc\x01\0\0\xf9\x29\0\0\x05\x48\x05\0\x60\x19\0\0\xfc\x01\0\
+\0\x1b\x2a\0\0\x13\x78\x05\0\x88\x19\0\0\xfc\x01\0\0\xc6\x29\0\0\x0e\x40\x05\0\
+\xa0\x19\0\0\xfc\x01\0\0\xd9\x28\0\0\x07\x44\x05\0\xb0\x19\0\0\xfc\x01\0\0\xf9\
+\x29\0\0\x05\x48\x05\0\xb8\x19\0\0\xfc\x01\0\0\x45\x2a\0\0\x07\x7c\x05\0\xc0\
+\x19\0\0\xfc\x01\0\0\x55\x2a\0\0\x15\x80\x05\0\xe0\x19\0\0\xfc\x01\0\0\xc6\x29\
+\0\0\x0e\x40\x05\0\xf8\x19\0\0\xfc\x01\0\0\xd9\x28\0\0\x07\x44\x05\0\x6a\x2b\0\
+\0\x36\x01\0\0\0\0\0\0\xfc\x01\0\0\x11\x02\0\0\x30\x70\x07\0\x28\0\0\0\xfc\x01\
+\0\0\0\0\0\0\0\0\0\0\x30\0\0\0\xfc\x01\0\0\xf3\x06\0\0\x1c\x10\x03\0\x58\0\0\0\
+\xfc\x01\0\0\xf3\x06\0\0\x1c\x10\x03\0\x68\0\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\
+\x70\0\0\0\xfc\x01\0\0\x2e\x07\0\0\x1d\x14\x03\0\x80\0\0\0\xfc\x01\0\0\x2e\x07\
+\0\0\x1d\x14\x03\0\x88\0\0\0\xfc\x01\0\0\xf3\x06\0\0\x35\x10\x03\0\xa8\0\0\0\
+\xfc\x01\0\0\0\0\0\0\0\0\0\0\xb0\0\0\0\xfc\x01\0\0\x8e\x07\0\0\x0e\xec\x06\0\
+\xc8\0\0\0\xfc\x01\0\0\x8e\x07\0\0\x0e\xec\x06\0\xd0\0\0\0\xfc\x01\0\0\xb8\x07\
+\0\0\x08\xf8\x06\0\xe8\0\0\0\xfc\x01\0\0\xb8\x07\0\0\x07\xf8\x06\0\x10\x01\0\0\
+\xfc\x01\0\0\0\0\0\0\0\0\0\0\x18\x01\0\0\xfc\x01\0\0\xd7\x07\0\0\x13\xfc\x06\0\
+\x48\x01\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x50\x01\0\0\xfc\x01\0\0\xd7\x07\0\0\
+\x13\xfc\x06\0\x60\x01\0\0\xfc\x01\0\0\xd7\x07\0\0\x13\xfc\x06\0\x68\x01\0\0\
+\xfc\x01\0\0\xd7\x07\0\0\x09\xfc\x06\0\x78\x01\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\
+\x80\x01\0\0\xfc\x01\0\0\xbb\x19\0\0\x0a\0\x07\0\xc0\x01\0\0\xfc\x01\0\0\0\0\0\
+\0\0\0\0\0\xd0\x01\0\0\xfc\x01\0\0\xf2\x19\0\0\x07\x0c\x07\0\xe0\x01\0\0\xfc\
+\x01\0\0\xf2\x19\0\0\x07\x0c\x07\0\xe8\x01\0\0\xfc\x01\0\0\xf2\x19\0\0\x07\x0c\
+\x07\0\xf8\x01\0\0\xfc\x01\0\0\x21\x1a\0\0\x07\x50\x04\0\0\x02\0\0\xfc\x01\0\0\
+\x31\x1a\0\0\x07\x58\x04\0\x18\x02\0\0\xfc\x01\0\0\x31\x1a\0\0\x07\x58\x04\0\
+\x20\x02\0\0\xfc\x01\0\0\x49\x1a\0\0\x07\x60\x04\0\x38\x02\0\0\xfc\x01\0\0\x49\
+\x1a\0\0\x07\x60\x04\0\x40\x02\0\0\xfc\x01\0\0\x6d\x1a\0\0\x0c\x64\x04\0\x48\
+\x02\0\0\xfc\x01\0\0\x6d\x1a\0\0\x24\x64\x04\0\x60\x02\0\0\xfc\x01\0\0\x6d\x1a\
+\0\0\x0c\x64\x04\0\x70\x02\0\0\xfc\x01\0\0\x6d\x1a\0\0\x22\x64\x04\0\x90\x02\0\
+\0\xfc\x01\0\0\xa2\x1a\0\0\x07\x14\x07\0\xa8\x02\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\
+\0\xb0\x02\0\0\xfc\x01\0\0\xb4\x1a\0\0\x0a\x68\x04\0\xc8\x02\0\0\xfc\x01\0\0\
+\xe2\x1a\0\0\x07\x6c\x04\0\xd8\x02\0\0\xfc\x01\0\0\xef\x1a\0\0\x11\x74\x04\0\
+\xf8\x02\0\0\xfc\x01\0\0\xef\x1a\0\0\x16\x74\x04\0\x10\x03\0\0\xfc\x01\0\0\xef\
+\x1a\0\0\x14\x74\x04\0\x30\x03\0\0\xfc\x01\0\0\xa2\x1a\0\0\x07\x14\x07\0\x38\
+\x03\0\0\xfc\x01\0\0\x16\x1b\0\0\x0d\xa4\x04\0\x58\x03\0\0\xfc\x01\0\0\x2b\x1b\
+\0\0\x11\xa8\x04\0\x60\x03\0\0\xfc\x01\0\0\x2b\x1b\0\0\x07\xa8\x04\0\x70\x03\0\
+\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x78\x03\0\0\xfc\x01\0\0\x40\x1b\0\0\x0c\xac\x04\
+\0\x98\x03\0\0\xfc\x01\0\0\x6f\x1b\0\0\x07\xb4\x04\0\xa8\x03\0\0\xfc\x01\0\0\
+\x79\x1b\0\0\x03\xbc\x04\0\xb0\x03\0\0\xfc\x01\0\0\x97\x1b\0\0\x08\xc0\x04\0\
+\xb8\x03\0\0\xfc\x01\0\0\x97\x1b\0\0\x10\xc0\x04\0\xc0\x03\0\0\xfc\x01\0\0\x97\
+\x1b\0\0\x18\xc0\x04\0\xd0\x03\0\0\xfc\x01\0\0\x97\x1b\0\0\x07\xc0\x04\0\xe0\
+\x03\0\0\xfc\x01\0\0\xb5\x1b\0\0\x05\xc4\x04\0\xe8\x03\0\0\xfc\x01\0\0\xd6\x1b\
+\0\0\x0b\xc8\x04\0\x08\x04\0\0\xfc\x01\0\0\xe9\x1b\0\0\x0f\xcc\x04\0\x10\x04\0\
+\0\xfc\x01\0\0\xe9\x1b\0\0\x09\xcc\x04\0\x20\x04\0\0\xfc\x01\0\0\xb5\x1b\0\0\
+\x05\xc4\x04\0\x28\x04\0\0\xfc\x01\0\0\xfc\x1b\0\0\x09\xd0\x04\0\x48\x04\0\0\
+\xfc\x01\0\0\x28\x1c\0\0\x09\xd4\x04\0\x58\x04\0\0\xfc\x01\0\0\x34\x1c\0\0\x05\
+\xdc\x04\0\x68\x04\0\0\xfc\x01\0\0\x54\x1c\0\0\x07\x20\x07\0\xa0\x04\0\0\xfc\
+\x01\0\0\0\0\0\0\0\0\0\0\xa8\x04\0\0\xfc\x01\0\0\x77\x1c\0\0\x1b\xe8\x02\0\xd0\
+\x04\0\0\xfc\x01\0\0\x77\x1c\0\0\x1b\xe8\x02\0\xe0\x04\0\0\xfc\x01\0\0\0\0\0\0\
+\0\0\0\0\xe8\x04\0\0\xfc\x01\0\0\xac\x1c\0\0\x1b\xec\x02\0\xf8\x04\0\0\xfc\x01\
+\0\0\xac\x1c\0\0\x1b\xec\x02\0\x08\x05\0\0\xfc\x01\0\0\xeb\x1c\0\0\x11\x94\x05\
+\0\x18\x05\0\0\xfc\x01\0\0\x2b\x1b\0\0\x11\xac\x05\0\x30\x05\0\0\xfc\x01\0\0\
+\x06\x1d\0\0\x06\x98\x05\0\x38\x05\0\0\xfc\x01\0\0\x2b\x1b\0\0\x07\xac\x05\0\
+\x48\x05\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x50\x05\0\0\xfc\x01\0\0\x14\x1d\0\0\
+\x16\xb0\x05\0\x70\x05\0\0\xfc\x01\0\0\x77\x1c\0\0\x34\xe8\x02\0\x80\x05\0\0\
+\xfc\x01\0\0\0\0\0\0\0\0\0\0\x88\x05\0\0\xfc\x01\0\0\x52\x1d\0\0\x03\xb4\x05\0\
+\xa0\x05\0\0\xfc\x01\0\0\x75\x1d\0\0\x08\xb8\x05\0\xa8\x05\0\0\xfc\x01\0\0\x75\
+\x1d\0\0\x10\xb8\x05\0\xb0\x05\0\0\xfc\x01\0\0\x75\x1d\0\0\x07\xb8\x05\0\xd0\
+\x05\0\0\xfc\x01\0\0\xf5\x1d\0\0\x03\xc0\x05\0\x18\x06\0\0\xfc\x01\0\0\x2d\x1e\
+\0\0\x03\xc4\x05\0\x40\x06\0\0\xfc\x01\0\0\x61\x1e\0\0\x03\xc8\x05\0\x80\x06\0\
+\0\xfc\x01\0\0\xf5\x1d\0\0\x03\xc0\x05\0\x88\x06\0\0\xfc\x01\0\0\x94\x1e\0\0\
+\x03\xcc\x05\0\xb8\x06\0\0\xfc\x01\0\0\xf5\x1d\0\0\x03\xc0\x05\0\xc0\x06\0\0\
+\xfc\x01\0\0\xc5\x1e\0\0\x03\xd0\x05\0\xd0\x06\0\0\xfc\x01\0\0\xf4\x1e\0\0\x0d\
+\xd4\x05\0\xd8\x06\0\0\xfc\x01\0\0\xf4\x1e\0\0\x1b\xd4\x05\0\xe8\x06\0\0\xfc\
+\x01\0\0\x29\x1f\0\0\x08\xd8\x05\0\xf0\x06\0\0\xfc\x01\0\0\x29\x1f\0\0\x1c\xd8\
+\x05\0\xf8\x06\0\0\xfc\x01\0\0\x29\x1f\0\0\x2b\xd8\x05\0\x20\x07\0\0\xfc\x01\0\
+\0\0\0\0\0\0\0\0\0\x28\x07\0\0\xfc\x01\0\0\x5b\x1f\0\0\x07\xdc\x05\0\x40\x07\0\
+\0\xfc\x01\0\0\x5b\x1f\0\0\x07\xdc\x05\0\x48\x07\0\0\xfc\x01\0\0\xf4\x1e\0\0\
+\x07\xd4\x05\0\x50\x07\0\0\xfc\x01\0\0\x8e\x1f\0\0\x13\xf0\x05\0\x60\x07\0\0\
+\xfc\x01\0\0\x8e\x1f\0\0\x21\xf0\x05\0\x68\x07\0\0\xfc\x01\0\0\x8e\x1f\0\0\x25\
+\xf0\x05\0\x70\x07\0\0\xfc\x01\0\0\x8e\x1f\0\0\x39\xf0\x05\0\x78\x07\0\0\xfc\
+\x01\0\0\x8e\x1f\0\0\x0e\xf0\x05\0\x80\x07\0\0\xfc\x01\0\0\xd8\x1f\0\0\x2b\xf4\
+\x05\0\x88\x07\0\0\xfc\x01\0\0\xd8\x1f\0\0\x3b\xf4\x05\0\x98\x07\0\0\xfc\x01\0\
+\0\xd8\x1f\0\0\x28\xf4\x05\0\xa8\x07\0\0\xfc\x01\0\0\x18\x20\0\0\x07\x94\x03\0\
+\xb8\x07\0\0\xfc\x01\0\0\xd8\x1f\0\0\x2b\xf4\x05\0\xc0\x07\0\0\xfc\x01\0\0\x28\
+\x20\0\0\x03\x98\x03\0\xd8\x07\0\0\xfc\x01\0\0\x53\x20\0\0\x0a\x9c\x03\0\xe0\
+\x07\0\0\xfc\x01\0\0\xd8\x1f\0\0\x13\xf4\x05\0\x18\x08\0\0\xfc\x01\0\0\0\0\0\0\
+\0\0\0\0\x20\x08\0\0\xfc\x01\0\0\x62\x20\0\0\x1d\xfc\x02\0\x40\x08\0\0\xfc\x01\
+\0\0\x62\x20\0\0\x1d\xfc\x02\0\x50\x08\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x58\x08\
+\0\0\xfc\x01\0\0\x99\x20\0\0\x1d\0\x03\0\x70\x08\0\0\xfc\x01\0\0\x99\x20\0\0\
+\x1d\0\x03\0\x80\x08\0\0\xfc\x01\0\0\xda\x20\0\0\x12\x3c\x06\0\xb0\x08\0\0\xfc\
+\x01\0\0\x2b\x1b\0\0\x11\x5c\x06\0\xc8\x08\0\0\xfc\x01\0\0\x06\x1d\0\0\x06\x40\
+\x06\0\xd0\x08\0\0\xfc\x01\0\0\x2b\x1b\0\0\x07\x5c\x06\0\xe0\x08\0\0\xfc\x01\0\
+\0\0\0\0\0\0\0\0\0\xe8\x08\0\0\xfc\x01\0\0\xf6\x20\0\0\x16\x60\x06\0\x08\x09\0\
+\0\xfc\x01\0\0\x62\x20\0\0\x36\xfc\x02\0\x18\x09\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\
+\0\x20\x09\0\0\xfc\x01\0\0\x52\x1d\0\0\x03\x64\x06\0\x38\x09\0\0\xfc\x01\0\0\
+\x35\x21\0\0\x08\x68\x06\0\x40\x09\0\0\xfc\x01\0\0\x35\x21\0\0\x10\x68\x06\0\
+\x48\x09\0\0\xfc\x01\0\0\x35\x21\0\0\x07\x68\x06\0\x80\x09\0\0\xfc\x01\0\0\0\0\
+\0\0\0\0\0\0\x88\x09\0\0\xfc\x01\0\0\xc4\x21\0\0\x03\x70\x06\0\xa0\x09\0\0\xfc\
+\x01\0\0\xf1\x21\0\0\x28\x74\x06\0\xb0\x09\0\0\xfc\x01\0\0\x2f\x22\0\0\x20\xe0\
+\x03\0\xf0\x09\0\0\xfc\x01\0\0\x6f\x22\0\0\x29\xf0\x03\0\x18\x0a\0\0\xfc\x01\0\
+\0\x6f\x22\0\0\x09\xf0\x03\0\x28\x0a\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\
+\x30\x0a\0\0\xfc\x01\0\0\xa2\x22\0\0\x09\xf8\x03\0\x50\x0a\0\0\xfc\x01\0\0\xc9\
+\x22\0\0\x11\0\x04\0\x58\x0a\0\0\xfc\x01\0\0\xdf\x22\0\0\x09\x04\x04\0\x68\x0a\
+\0\0\xfc\x01\0\0\xf1\x21\0\0\x11\x74\x06\0\x90\x0a\0\0\xfc\x01\0\0\0\0\0\0\0\0\
+\0\0\x98\x0a\0\0\xfc\x01\0\0\xf1\x22\0\0\x03\x78\x06\0\xc0\x0a\0\0\xfc\x01\0\0\
+\x20\x23\0\0\x12\x7c\x06\0\xd0\x0a\0\0\xfc\x01\0\0\x20\x23\0\0\x03\x7c\x06\0\0\
+\x0b\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x08\x0b\0\0\xfc\x01\0\0\x4f\x23\0\0\x03\
+\x80\x06\0\x18\x0b\0\0\xfc\x01\0\0\x84\x23\0\0\x0d\x84\x06\0\x40\x0b\0\0\xfc\
+\x01\0\0\x84\x23\0\0\x16\x84\x06\0\x50\x0b\0\0\xfc\x01\0\0\x84\x23\0\0\x1b\x84\
+\x06\0\x70\x0b\0\0\xfc\x01\0\0\xc2\x23\0\0\x33\x8c\x06\0\x88\x0b\0\0\xfc\x01\0\
+\0\xfc\x23\0\0\x0c\x88\x06\0\x98\x0b\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xa0\x0b\0\
+\0\xfc\x01\0\0\xc2\x23\0\0\x05\x8c\x06\0\xb8\x0b\0\0\xfc\x01\0\0\x16\x24\0\0\
+\x15\x90\x06\0\xc0\x0b\0\0\xfc\x01\0\0\x16\x24\0\0\x13\x90\x06\0\xc8\x0b\0\0\
+\xfc\x01\0\0\x34\x24\0\0\x15\x94\x06\0\xd0\x0b\0\0\xfc\x01\0\0\x34\x24\0\0\x13\
+\x94\x06\0\xd8\x0b\0\0\xfc\x01\0\0\x52\x24\0\0\x2d\x98\x06\0\xe0\x0b\0\0\xfc\
+\x01\0\0\x89\x24\0\0\x06\x60\x03\0\xf8\x0b\0\0\xfc\x01\0\0\x98\x24\0\0\x07\x64\
+\x03\0\0\x0c\0\0\xfc\x01\0\0\xae\x24\0\0\x1f\x6c\x03\0\x10\x0c\0\0\xfc\x01\0\0\
+\0\0\0\0\0\0\0\0\x18\x0c\0\0\xfc\x01\0\0\xae\x24\0\0\x03\x6c\x03\0\x30\x0c\0\0\
+\xfc\x01\0\0\xd3\x24\0\0\x0b\x70\x03\0\x38\x0c\0\0\xfc\x01\0\0\xd3\x24\0\0\x16\
+\x70\x03\0\x68\x0c\0\0\xfc\x01\0\0\xed\x24\0\0\x1c\x9c\x06\0\x78\x0c\0\0\xfc\
+\x01\0\0\xed\x24\0\0\x22\x9c\x06\0\x90\x0c\0\0\xfc\x01\0\0\x1a\x25\0\0\x28\xa0\
+\x06\0\xa8\x0c\0\0\xfc\x01\0\0\x18\x20\0\0\x07\x94\x03\0\xb8\x0c\0\0\xfc\x01\0\
+\0\0\0\0\0\0\0\0\0\xc0\x0c\0\0\xfc\x01\0\0\x28\x20\0\0\x03\x98\x03\0\xd8\x0c\0\
+\0\xfc\x01\0\0\x53\x20\0\0\x0a\x9c\x03\0\xe0\x0c\0\0\xfc\x01\0\0\x1a\x25\0\0\
+\x13\xa0\x06\0\xe8\x0c\0\0\xfc\x01\0\0\x49\x25\0\0\x15\xa8\x06\0\xf8\x0c\0\0\
+\xfc\x01\0\0\x93\x25\0\0\x07\x2c\x03\0\x10\x0d\0\0\xfc\x01\0\0\x93\x25\0\0\x07\
+\x2c\x03\0\x18\x0d\0\0\xfc\x01\0\0\xb3\x25\0\0\x13\x30\x03\0\x38\x0d\0\0\xfc\
+\x01\0\0\0\0\0\0\0\0\0\0\x40\x0d\0\0\xfc\x01\0\0\xd1\x25\0\0\x07\x34\x03\0\x58\
+\x0d\0\0\xfc\x01\0\0\xd1\x25\0\0\x07\x34\x03\0\x60\x0d\0\0\xfc\x01\0\0\xf8\x25\
+\0\0\x1d\x38\x03\0\x78\x0d\0\0\xfc\x01\0\0\xf8\x25\0\0\x09\x38\x03\0\x80\x0d\0\
+\0\xfc\x01\0\0\x22\x26\0\0\x20\x40\x03\0\x98\x0d\0\0\xfc\x01\0\0\x4f\x26\0\0\
+\x07\xb0\x06\0\xa0\x0d\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xc0\x0d\0\0\xfc\x01\0\0\
+\x62\x26\0\0\x0f\xfc\x04\0\xd0\x0d\0\0\xfc\x01\0\0\x91\x26\0\0\x07\0\x05\0\xd8\
+\x0d\0\0\xfc\x01\0\0\xa3\x26\0\0\x07\x04\x05\0\xe8\x0d\0\0\xfc\x01\0\0\0\0\0\0\
+\0\0\0\0\0\x0e\0\0\xfc\x01\0\0\xb1\x26\0\0\x07\x14\x05\0\x18\x0e\0\0\xfc\x01\0\
+\0\xb1\x26\0\0\x07\x14\x05\0\x28\x0e\0\0\xfc\x01\0\0\xf1\x26\0\0\x0a\x20\x05\0\
+\x40\x0e\0\0\xfc\x01\0\0\x1b\x27\0\0\x07\x24\x05\0\x48\x0e\0\0\xfc\x01\0\0\0\0\
+\0\0\0\0\0\0\x70\x0e\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x88\x0e\0\0\xfc\x01\0\0\
+\x28\x27\0\0\x1c\xd4\x02\0\xa0\x0e\0\0\xfc\x01\0\0\x28\x27\0\0\x1c\xd4\x02\0\
+\xb0\x0e\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\xb8\x0e\0\0\xfc\x01\0\0\x5e\x27\0\0\
+\x1c\xd8\x02\0\xd0\x0e\0\0\xfc\x01\0\0\x5e\x27\0\0\x1c\xd8\x02\0\xd8\x0e\0\0\
+\xfc\x01\0\0\x28\x27\0\0\x35\xd4\x02\0\xf8\x0e\0\0\xfc\x01\0\0\xf8\x27\0\0\x05\
+\xe4\x05\0\x30\x0f\0\0\xfc\x01\0\0\x2d\x28\0\0\x05\xe8\x05\0\x50\x0f\0\0\xfc\
+\x01\0\0\x60\x28\0\0\x28\xec\x05\0\x60\x0f\0\0\xfc\x01\0\0\x89\x24\0\0\x06\x60\
+\x03\0\x68\x0f\0\0\xfc\x01\0\0\x98\x24\0\0\x07\x64\x03\0\x70\x0f\0\0\xfc\x01\0\
+\0\xae\x24\0\0\x1f\x6c\x03\0\x80\x0f\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x88\x0f\0\
+\0\xfc\x01\0\0\xae\x24\0\0\x03\x6c\x03\0\xa0\x0f\0\0\xfc\x01\0\0\xd3\x24\0\0\
+\x0b\x70\x03\0\xa8\x0f\0\0\xfc\x01\0\0\xd3\x24\0\0\x16\x70\x03\0\xb8\x0f\0\0\
+\xfc\x01\0\0\x92\x28\0\0\x15\xfc\x05\0\xc8\x0f\0\0\xfc\x01\0\0\x92\x28\0\0\x2a\
+\xfc\x05\0\xd0\x0f\0\0\xfc\x01\0\0\x93\x25\0\0\x07\x2c\x03\0\xe8\x0f\0\0\xfc\
+\x01\0\0\x93\x25\0\0\x07\x2c\x03\0\xf0\x0f\0\0\xfc\x01\0\0\xb3\x25\0\0\x13\x30\
+\x03\0\0\x10\0\0\xfc\x01\0\0\xd1\x25\0\0\x07\x34\x03\0\x10\x10\0\0\xfc\x01\0\0\
+\xd1\x25\0\0\x07\x34\x03\0\x18\x10\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x28\x10\0\0\
+\xfc\x01\0\0\xf8\x25\0\0\x1d\x38\x03\0\x38\x10\0\0\xfc\x01\0\0\xf8\x25\0\0\x09\
+\x38\x03\0\x40\x10\0\0\xfc\x01\0\0\x22\x26\0\0\x20\x40\x03\0\x50\x10\0\0\xfc\
+\x01\0\0\x4f\x26\0\0\x07\0\x06\0\x58\x10\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x78\
+\x10\0\0\xfc\x01\0\0\x62\x26\0\0\x0f\xfc\x04\0\x88\x10\0\0\xfc\x01\0\0\x91\x26\
+\0\0\x07\0\x05\0\x90\x10\0\0\xfc\x01\0\0\xa3\x26\0\0\x07\x04\x05\0\xa0\x10\0\0\
+\xfc\x01\0\0\0\0\0\0\0\0\0\0\xb8\x10\0\0\xfc\x01\0\0\xb1\x26\0\0\x07\x14\x05\0\
+\xd0\x10\0\0\xfc\x01\0\0\xb1\x26\0\0\x07\x14\x05\0\xe0\x10\0\0\xfc\x01\0\0\xf1\
+\x26\0\0\x0a\x20\x05\0\xf8\x10\0\0\xfc\x01\0\0\x1b\x27\0\0\x07\x24\x05\0\0\x11\
+\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x10\x11\0\0\xfc\x01\0\0\xd9\x28\0\0\x07\x30\
+\x07\0\x20\x11\0\0\xfc\x01\0\0\xe4\x28\0\0\x05\x34\x07\0\x28\x11\0\0\xfc\x01\0\
+\0\x07\x29\0\0\x03\x78\x07\0\x40\x11\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x68\x11\0\
+\0\xfc\x01\0\0\x13\x29\0\0\x0f\x20\x04\0\x70\x11\0\0\xfc\x01\0\0\x2f\x22\0\0\
+\x20\xe0\x03\0\xb0\x11\0\0\xfc\x01\0\0\x6f\x22\0\0\x29\xf0\x03\0\xd8\x11\0\0\
+\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\xe8\x11\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\
+\xf0\x03\0\xf8\x11\0\0\xfc\x01\0\0\xa2\x22\0\0\x09\xf8\x03\0\x18\x12\0\0\xfc\
+\x01\0\0\0\0\0\0\0\0\0\0\x40\x12\0\0\xfc\x01\0\0\x13\x29\0\0\x0f\x20\x04\0\x58\
+\x12\0\0\xfc\x01\0\0\x2f\x22\0\0\x20\xe0\x03\0\xa8\x12\0\0\xfc\x01\0\0\x6f\x22\
+\0\0\x29\xf0\x03\0\xd0\x12\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\xe8\x12\0\
+\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\xf8\x12\0\0\xfc\x01\0\0\xa2\x22\0\0\
+\x09\xf8\x03\0\x18\x13\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x40\x13\0\0\xfc\x01\0\0\
+\x13\x29\0\0\x0f\x20\x04\0\x58\x13\0\0\xfc\x01\0\0\x2f\x22\0\0\x20\xe0\x03\0\
+\xa8\x13\0\0\xfc\x01\0\0\x6f\x22\0\0\x29\xf0\x03\0\xd0\x13\0\0\xfc\x01\0\0\x6f\
+\x22\0\0\x09\xf0\x03\0\xe8\x13\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\xf8\
+\x13\0\0\xfc\x01\0\0\xa2\x22\0\0\x09\xf8\x03\0\x18\x14\0\0\xfc\x01\0\0\0\0\0\0\
+\0\0\0\0\x40\x14\0\0\xfc\x01\0\0\x13\x29\0\0\x0f\x20\x04\0\x58\x14\0\0\xfc\x01\
+\0\0\x2f\x22\0\0\x20\xe0\x03\0\xa8\x14\0\0\xfc\x01\0\0\x6f\x22\0\0\x29\xf0\x03\
+\0\xd8\x14\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\xf8\x14\0\0\xfc\x01\0\0\
+\x6f\x22\0\0\x09\xf0\x03\0\x08\x15\0\0\xfc\x01\0\0\xa2\x22\0\0\x09\xf8\x03\0\
+\x30\x15\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x60\x15\0\0\xfc\x01\0\0\x13\x29\0\0\
+\x0f\x20\x04\0\x80\x15\0\0\xfc\x01\0\0\x2f\x22\0\0\x20\xe0\x03\0\xd0\x15\0\0\
+\xfc\x01\0\0\x6f\x22\0\0\x29\xf0\x03\0\0\x16\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\
+\xf0\x03\0\x20\x16\0\0\xfc\x01\0\0\x6f\x22\0\0\x09\xf0\x03\0\x30\x16\0\0\xfc\
+\x01\0\0\xa2\x22\0\0\x09\xf8\x03\0\x50\x16\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x78\
+\x16\0\0\xfc\x01\0\0\x13\x29\0\0\x0f\x20\x04\0\x80\x16\0\0\xfc\x01\0\0\0\0\0\0\
+\0\0\0\0\xa8\x16\0\0\xfc\x01\0\0\x27\x29\0\0\x11\xc0\x06\0\x18\x17\0\0\xfc\x01\
+\0\0\x3d\x29\0\0\x0e\xc4\x06\0\x28\x17\0\0\xfc\x01\0\0\x27\x29\0\0\x11\xc0\x06\
+\0\x40\x17\0\0\xfc\x01\0\0\x51\x29\0\0\x16\xcc\x06\0\x68\x17\0\0\xfc\x01\0\0\
+\x8f\x29\0\0\x19\x70\x05\0\x78\x17\0\0\xfc\x01\0\0\x8f\x29\0\0\x1f\x70\x05\0\
+\x80\x17\0\0\xfc\x01\0\0\x8f\x29\0\0\x25\x70\x05\0\xa0\x17\0\0\xfc\x01\0\0\x27\
+\x29\0\0\x11\xc0\x06\0\xa8\x17\0\0\xfc\x01\0\0\xc6\x29\0\0\x0e\x40\x05\0\xc0\
+\x17\0\0\xfc\x01\0\0\xd9\x28\0\0\x07\x44\x05\0\xc8\x17\0\0\xfc\x01\0\0\xf9\x29\
+\0\0\x05\x48\x05\0\xd0\x17\0\0\xfc\x01\0\0\x1b\x2a\0\0\x13\x78\x05\0\xf8\x17\0\
+\0\xfc\x01\0\0\xc6\x29\0\0\x0e\x40\x05\0\x10\x18\0\0\xfc\x01\0\0\xd9\x28\0\0\
+\x07\x44\x05\0\x20\x18\0\0\xfc\x01\0\0\xf9\x29\0\0\x05\x48\x05\0\x28\x18\0\0\
+\xfc\x01\0\0\x45\x2a\0\0\x07\x7c\x05\0\x30\x18\0\0\xfc\x01\0\0\x55\x2a\0\0\x15\
+\x80\x05\0\x50\x18\0\0\xfc\x01\0\0\xc6\x29\0\0\x0e\x40\x05\0\x68\x18\0\0\xfc\
+\x01\0\0\xd9\x28\0\0\x07\x44\x05\0\x78\x18\0\0\xfc\x01\0\0\0\0\0\0\0\0\0\0\x88\
+\x18\0\0\xfc\x01\0\0\x27\x29\0\0\x11\x10\x06\0\xb0\x18\0\0\xfc\x01\0\0\x3d\x29\
+\0\0\x0e\x14\x06\0\xc0\x18\0\0\xfc\x01\0\0\x27\x29\0\0\x11\x10\x06\0\xd8\x18\0\
+\0\xfc\x01\0\0\x7f\x2a\0\0\x16\x1c\x06\0\0\x19\0\0\xfc\x01\0\0\x8f\x29\0\0\x19\
+\x70\x05\0\x10\x19\0\0\xfc\x01\0\0\x8f\x29\0\0\x25\x70\x05\0\x30\x19\0\0\xfc\
+\x01\0\0\x27\x29\0\0\x11\x10\x06\0\x38\x19\0\0\xfc\x01\0\0\xc6\x29\0\0\x0e\x40\
+\x05\0\x50\x19\0\0\xfc\x01\0\0\xd9\x28\0\0\x07\x44\x05\0\x58\x19\0\0\xfc\x01\0\
+\0\xf9\x29\0\0\x05\x48\x05\0\x60\x19\0\0\xfc\x01\0\0\x1b\x2a\0\0\x13\x78\x05\0\
+\x88\x19\0\0\xfc\x01\0\0\xc6\x29\0\0\x0e\x40\x05\0\xa0\x19\0\0\xfc\x01\0\0\xd9\
+\x28\0\0\x07\x44\x05\0\xb0\x19\0\0\xfc\x01\0\0\xf9\x29\0\0\x05\x48\x05\0\xb8\
+\x19\0\0\xfc\x01\0\0\x45\x2a\0\0\x07\x7c\x05\0\xc0\x19\0\0\xfc\x01\0\0\x55\x2a\
+\0\0\x15\x80\x05\0\xe0\x19\0\0\xfc\x01\0\0\xc6\x29\0\0\x0e\x40\x05\0\xf8\x19\0\
+\0\xfc\x01\0\0\xd9\x28\0\0\x07\x44\x05\0\x10\0\0\0\xd7\x01\0\0\x18\0\0\0\0\0\0\
+\0\x38\0\0\0\xf8\x01\0\0\0\0\0\0\x08\0\0\0\x3f\0\0\0\xee\x06\0\0\0\0\0\0\x40\0\
+\0\0\x3f\0\0\0\x29\x07\0\0\0\0\0\0\x90\0\0\0\x66\0\0\0\x8a\x07\0\0\0\0\0\0\xf0\
+\0\0\0\x3f\0\0\0\xcd\x07\0\0\0\0\0\0\x28\x01\0\0\x68\0\0\0\xb6\x19\0\0\0\0\0\0\
+\xa0\x01\0\0\x3f\0\0\0\xed\x19\0\0\0\0\0\0\x80\x04\0\0\x3f\0\0\0\xee\x06\0\0\0\
+\0\0\0\xb8\x04\0\0\x3f\0\0\0\xed\x19\0\0\0\0\0\0\xb8\x05\0\0\x24\x01\0\0\xf1\
+\x1d\0\0\0\0\0\0\xf0\x05\0\0\x24\x01\0\0\x29\x1e\0\0\0\0\0\0\x28\x06\0\0\x24\
+\x01\0\0\x5c\x1e\0\0\0\0\0\0\x60\x06\0\0\x24\x01\0\0\x90\x1e\0\0\0\0\0\0\x98\
+\x06\0\0\x24\x01\0\0\xc1\x1e\0\0\0\0\0\0\0\x07\0\0\x3f\0\0\0\x56\x1f\0\0\0\0\0\
+\0\xf8\x07\0\0\x3f\0\0\0\xee\x06\0\0\0\0\0\0\x30\x08\0\0\x3f\0\0\0\xed\x19\0\0\
+\0\0\0\0\x60\x09\0\0\x27\x01\0\0\xc0\x21\0\0\0\0\0\0\x70\x0a\0\0\x27\x01\0\0\
+\xed\x22\0\0\0\0\0\0\xa8\x0a\0\0\x27\x01\0\0\xf1\x1d\0\0\0\0\0\0\xe0\x0a\0\0\
+\x27\x01\0\0\x90\x1e\0\0\0\0\0\0\x58\x0e\0\0\x3f\0\0\0\xee\x06\0\0\0\0\0\0\xe0\
+\x0e\0\0\x2e\x01\0\0\xf4\x27\0\0\0\0\0\0\x18\x0f\0\0\x2e\x01\0\0\xf8\x01\0\0\0\
+\0\0\0\x6a\x2b\0\0\x18\0\0\0\0\0\0\0\x30\x01\0\0\x90\x1e\0\0\0\0\0\0\x08\0\0\0\
+\x3f\0\0\0\xee\x06\0\0\0\0\0\0\x40\0\0\0\x3f\0\0\0\x29\x07\0\0\0\0\0\0\x90\0\0\
+\0\x66\0\0\0\x8a\x07\0\0\0\0\0\0\xf0\0\0\0\x3f\0\0\0\xcd\x07\0\0\0\0\0\0\x28\
+\x01\0\0\x68\0\0\0\xb6\x19\0\0\0\0\0\0\xa0\x01\0\0\x3f\0\0\0\xed\x19\0\0\0\0\0\
+\0\x80\x04\0\0\x3f\0\0\0\xee\x06\0\0\0\0\0\0\xb8\x04\0\0\x3f\0\0\0\xed\x19\0\0\
+\0\0\0\0\xb8\x05\0\0\x24\x01\0\0\xf1\x1d\0\0\0\0\0\0\xf0\x05\0\0\x24\x01\0\0\
+\x29\x1e\0\0\0\0\0\0\x28\x06\0\0\x24\x01\0\0\x5c\x1e\0\0\0\0\0\0\x60\x06\0\0\
+\x24\x01\0\0\x90\x1e\0\0\0\0\0\0\x98\x06\0\0\x24\x01\0\0\xc1\x1e\0\0\0\0\0\0\0\
+\x07\0\0\x3f\0\0\0\x56\x1f\0\0\0\0\0\0\xf8\x07\0\0\x3f\0\0\0\xee\x06\0\0\0\0\0\
+\0\x30\x08\0\0\x3f\0\0\0\xed\x19\0\0\0\0\0\0\x60\x09\0\0\x27\x01\0\0\xc0\x21\0\
+\0\0\0\0\0\x70\x0a\0\0\x27\x01\0\0\xed\x22\0\0\0\0\0\0\xa8\x0a\0\0\x27\x01\0\0\
+\xf1\x1d\0\0\0\0\0\0\xe0\x0a\0\0\x27\x01\0\0\x90\x1e\0\0\0\0\0\0\x58\x0e\0\0\
+\x3f\0\0\0\xee\x06\0\0\0\0\0\0\xe0\x0e\0\0\x2e\x01\0\0\xf4\x27\0\0\0\0\0\0\x18\
+\x0f\0\0\x2e\x01\0\0\xf8\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\x03\0\x03\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x63\x03\0\0\0\0\
+\x03\0\xa0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xb7\x02\0\0\0\0\x03\0\x28\x11\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\xd4\x01\0\0\0\0\x03\0\xd0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\x47\x02\0\0\0\0\x03\0\xa0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x56\x01\0\0\0\0\
+\x03\0\x38\x03\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x86\x01\0\0\0\0\x03\0\xd8\x02\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\x42\x03\0\0\0\0\x03\0\x68\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\x03\0\0\0\0\x03\0\xf0\x07\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x53\x03\0\0\0\0\x03\
+\0\x10\x11\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xc3\x03\0\0\0\0\x03\0\x08\x11\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\xc5\x01\0\0\0\0\x03\0\xe8\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x14\x04\0\0\0\0\x03\0\x58\x07\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xb2\x03\0\0\0\0\x03\
+\0\x58\x0e\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x86\x02\0\0\0\0\x03\0\xb8\x0f\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\x32\x03\0\0\0\0\x03\0\x68\x0a\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x03\x02\0\0\0\0\x03\0\xf0\x09\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x92\x03\0\0\0\0\x03\
+\0\x40\x11\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xe4\x03\0\0\0\0\x03\0\x38\x11\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\x56\x04\0\0\0\0\x03\0\x48\x0a\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\xa6\x02\0\0\0\0\x03\0\x38\x0b\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xf3\x01\0\0\0\0\x03\
+\0\x50\x0b\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xd4\x03\0\0\0\0\x03\0\x50\x0c\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\x96\x02\0\0\0\0\x03\0\xe8\x0c\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x22\x03\0\0\0\0\x03\0\x78\x0c\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xe3\x01\0\0\0\0\x03\
+\0\x38\x0d\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x67\x04\0\0\0\0\x03\0\x90\x0d\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\x11\x03\0\0\0\0\x03\0\x48\x0e\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\xc9\x02\0\0\0\0\x03\0\xa0\x16\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xb5\x01\0\0\0\0\x03\
+\0\0\x10\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x04\x04\0\0\0\0\x03\0\x48\x10\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\xa2\x03\0\0\0\0\x03\0\x50\x10\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x76\
+\x02\0\0\0\0\x03\0\0\x11\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x13\x02\0\0\0\0\x03\0\x88\
+\x18\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xf0\x02\0\0\0\0\x03\0\xb0\x11\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\xa5\x01\0\0\0\0\x03\0\x18\x12\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x46\x04\
+\0\0\0\0\x03\0\xa8\x12\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xe0\x02\0\0\0\0\x03\0\x18\
+\x13\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x66\x02\0\0\0\0\x03\0\xa8\x13\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\x36\x04\0\0\0\0\x03\0\x18\x14\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x82\x03\
+\0\0\0\0\x03\0\xa8\x14\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x56\x02\0\0\0\0\x03\0\x30\
+\x15\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x95\x01\0\0\0\0\x03\0\xd0\x15\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\x72\x03\0\0\0\0\x03\0\x50\x16\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x24\x02\
+\0\0\0\0\x03\0\xd0\x17\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x76\x01\0\0\0\0\x03\0\x28\
+\x18\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x25\x04\0\0\0\0\x03\0\x70\x18\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\x65\x01\0\0\0\0\x03\0\x60\x19\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xf4\x03\
+\0\0\0\0\x03\0\xb8\x19\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x03\0\x05\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\x5c\x03\0\0\0\0\x05\0\xa0\x01\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\xae\x02\0\0\0\0\x05\0\x28\x11\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xcd\x01\0\0\0\0\
+\x05\0\xd0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x40\x02\0\0\0\0\x05\0\xa0\x02\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\x4f\x01\0\0\0\0\x05\0\x38\x03\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\x7f\x01\0\0\0\0\x05\0\xd8\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x3a\x03\0\0\0\0\
+\x05\0\x68\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xf8\x02\0\0\0\0\x05\0\xf0\x07\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\x4a\x03\0\0\0\0\x05\0\x10\x11\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\xba\x03\0\0\0\0\x05\0\x08\x11\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xbd\x01\0\0\0\0\
+\x05\0\xe8\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x0c\x04\0\0\0\0\x05\0\x58\x07\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\xaa\x03\0\0\0\0\x05\0\x58\x0e\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\x7e\x02\0\0\0\0\x05\0\xb8\x0f\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x2a\x03\0\0\0\0\
+\x05\0\x68\x0a\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xfb\x01\0\0\0\0\x05\0\xf0\x09\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\x8a\x03\0\0\0\0\x05\0\x40\x11\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\xdc\x03\0\0\0\0\x05\0\x38\x11\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x4e\x04\0\0\0\0\
+\x05\0\x48\x0a\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x9e\x02\0\0\0\0\x05\0\x38\x0b\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\xeb\x01\0\0\0\0\x05\0\x50\x0b\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\xcc\x03\0\0\0\0\x05\0\x50\x0c\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x8e\x02\0\0\0\0\
+\x05\0\xe8\x0c\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x1a\x03\0\0\0\0\x05\0\x78\x0c\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\xdb\x01\0\0\0\0\x05\0\x38\x0d\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\x5e\x04\0\0\0\0\x05\0\x90\x0d\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x08\x03\0\0\0\0\
+\x05\0\x48\x0e\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xc0\x02\0\0\0\0\x05\0\xa0\x16\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\xad\x01\0\0\0\0\x05\0\0\x10\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\xfc\x03\0\0\0\0\x05\0\x48\x10\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x9a\x03\0\0\0\0\x05\
+\0\x50\x10\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x6e\x02\0\0\0\0\x05\0\0\x11\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\x0b\x02\0\0\0\0\x05\0\x88\x18\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xe8\
+\x02\0\0\0\0\x05\0\xb0\x11\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x9d\x01\0\0\0\0\x05\0\
+\x18\x12\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x3e\x04\0\0\0\0\x05\0\xa8\x12\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\xd8\x02\0\0\0\0\x05\0\x18\x13\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x5e\
+\x02\0\0\0\0\x05\0\xa8\x13\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x2e\x04\0\0\0\0\x05\0\
+\x18\x14\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x7a\x03\0\0\0\0\x05\0\xa8\x14\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\x4e\x02\0\0\0\0\x05\0\x30\x15\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x8d\
+\x01\0\0\0\0\x05\0\xd0\x15\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x6a\x03\0\0\0\0\x05\0\
+\x50\x16\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x1b\x02\0\0\0\0\x05\0\xd0\x17\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\x6d\x01\0\0\0\0\x05\0\x28\x18\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x1c\
+\x04\0\0\0\0\x05\0\x70\x18\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x5d\x01\0\0\0\0\x05\0\
+\x60\x19\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xec\x03\0\0\0\0\x05\0\xb8\x19\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\xdf\0\0\0\x12\0\x03\0\0\0\0\0\0\0\0\0\x08\x1a\0\0\0\0\0\0\x7d\
+\0\0\0\x11\0\x07\0\0\0\0\0\0\0\0\0\x04\0\0\0\0\0\0\0\xd7\0\0\0\x11\0\x0a\0\xe0\
+\0\0\0\0\0\0\0\x20\0\0\0\0\0\0\0\xb0\0\0\0\x11\0\x07\0\x08\0\0\0\0\0\0\0\x04\0\
+\0\0\0\0\0\0\xcb\0\0\0\x11\0\x07\0\x0c\0\0\0\0\0\0\0\x04\0\0\0\0\0\0\0\xc4\0\0\
+\0\x11\0\x0a\0\xc0\0\0\0\0\0\0\0\x20\0\0\0\0\0\0\0\x88\0\0\0\x11\0\x08\0\0\0\0\
+\0\0\0\0\0\x04\0\0\0\0\0\0\0\xa9\0\0\0\x11\0\x0a\0\x20\0\0\0\0\0\0\0\x20\0\0\0\
+\0\0\0\0\x71\0\0\0\x11\0\x0a\0\0\0\0\0\0\0\0\0\x20\0\0\0\0\0\0\0\x33\x02\0\0\
+\x11\0\x0a\0\x40\0\0\0\0\0\0\0\x20\0\0\0\0\0\0\0\x90\0\0\0\x11\0\x07\0\x04\0\0\
+\0\0\0\0\0\x04\0\0\0\0\0\0\0\x2d\x02\0\0\x11\0\x0a\0\x80\0\0\0\0\0\0\0\x20\0\0\
+\0\0\0\0\0\x01\0\0\0\x11\0\x0a\0\xa0\0\0\0\0\0\0\0\x20\0\0\0\0\0\0\0\xd2\x02\0\
+\0\x11\0\x0a\0\x60\0\0\0\0\0\0\0\x20\0\0\0\0\0\0\0\x1d\0\0\0\x12\0\x05\0\0\0\0\
+\0\0\0\0\0\x08\x1a\0\0\0\0\0\0\x47\x01\0\0\x11\0\x09\0\0\0\0\0\0\0\0\0\x04\0\0\
+\0\0\0\0\0\xd0\0\0\0\0\0\0\0\x01\0\0\0\x64\0\0\0\x80\x01\0\0\0\0\0\0\x01\0\0\0\
+\x65\0\0\0\0\x02\0\0\0\0\0\0\x01\0\0\0\x66\0\0\0\x20\x02\0\0\0\0\0\0\x01\0\0\0\
+\x67\0\0\0\x48\x02\0\0\0\0\0\0\x01\0\0\0\x66\0\0\0\xb0\x02\0\0\0\0\0\0\x01\0\0\
+\0\x68\0\0\0\xf8\x02\0\0\0\0\0\0\x01\0\0\0\x66\0\0\0\x38\x03\0\0\0\0\0\0\x01\0\
+\0\0\x69\0\0\0\x78\x03\0\0\0\0\0\0\x01\0\0\0\x6a\0\0\0\xe8\x03\0\0\0\0\0\0\x01\
+\0\0\0\x69\0\0\0\x28\x04\0\0\0\0\0\0\x01\0\0\0\x6a\0\0\0\x50\x05\0\0\0\0\0\0\
+\x01\0\0\0\x6b\0\0\0\xe8\x08\0\0\0\0\0\0\x01\0\0\0\x6c\0\0\0\xf8\x0c\0\0\0\0\0\
+\0\x01\0\0\0\x6d\0\0\0\xd0\x0f\0\0\0\0\0\0\x01\0\0\0\x6d\0\0\0\x40\x17\0\0\0\0\
+\0\0\x01\0\0\0\x6e\0\0\0\xa8\x17\0\0\0\0\0\0\x01\0\0\0\x6f\0\0\0\xf8\x17\0\0\0\
+\0\0\0\x01\0\0\0\x6f\0\0\0\x50\x18\0\0\0\0\0\0\x01\0\0\0\x6f\0\0\0\xd8\x18\0\0\
+\0\0\0\0\x01\0\0\0\x70\0\0\0\x38\x19\0\0\0\0\0\0\x01\0\0\0\x6f\0\0\0\x88\x19\0\
+\0\0\0\0\0\x01\0\0\0\x6f\0\0\0\xe0\x19\0\0\0\0\0\0\x01\0\0\0\x6f\0\0\0\xd0\0\0\
+\0\0\0\0\0\x01\0\0\0\x64\0\0\0\x80\x01\0\0\0\0\0\0\x01\0\0\0\x65\0\0\0\0\x02\0\
+\0\0\0\0\0\x01\0\0\0\x66\0\0\0\x20\x02\0\0\0\0\0\0\x01\0\0\0\x67\0\0\0\x48\x02\
+\0\0\0\0\0\0\x01\0\0\0\x66\0\0\0\xb0\x02\0\0\0\0\0\0\x01\0\0\0\x68\0\0\0\xf8\
+\x02\0\0\0\0\0\0\x01\0\0\0\x66\0\0\0\x38\x03\0\0\0\0\0\0\x01\0\0\0\x69\0\0\0\
+\x78\x03\0\0\0\0\0\0\x01\0\0\0\x6a\0\0\0\xe8\x03\0\0\0\0\0\0\x01\0\0\0\x69\0\0\
+\0\x28\x04\0\0\0\0\0\0\x01\0\0\0\x6a\0\0\0\x50\x05\0\0\0\0\0\0\x01\0\0\0\x6b\0\
+\0\0\xe8\x08\0\0\0\0\0\0\x01\0\0\0\x6c\0\0\0\xf8\x0c\0\0\0\0\0\0\x01\0\0\0\x6d\
+\0\0\0\xd0\x0f\0\0\0\0\0\0\x01\0\0\0\x6d\0\0\0\x40\x17\0\0\0\0\0\0\x01\0\0\0\
+\x6e\0\0\0\xa8\x17\0\0\0\0\0\0\x01\0\0\0\x6f\0\0\0\xf8\x17\0\0\0\0\0\0\x01\0\0\
+\0\x6f\0\0\0\x50\x18\0\0\0\0\0\0\x01\0\0\0\x6f\0\0\0\xd8\x18\0\0\0\0\0\0\x01\0\
+\0\0\x70\0\0\0\x38\x19\0\0\0\0\0\0\x01\0\0\0\x6f\0\0\0\x88\x19\0\0\0\0\0\0\x01\
+\0\0\0\x6f\0\0\0\xe0\x19\0\0\0\0\0\0\x01\0\0\0\x6f\0\0\0\x4c\x2c\0\0\0\0\0\0\
+\x04\0\0\0\x69\0\0\0\x64\x2c\0\0\0\0\0\0\x04\0\0\0\x6b\0\0\0\x70\x2c\0\0\0\0\0\
+\0\x04\0\0\0\x6a\0\0\0\x7c\x2c\0\0\0\0\0\0\x04\0\0\0\x6c\0\0\0\x88\x2c\0\0\0\0\
+\0\0\x04\0\0\0\x70\0\0\0\x94\x2c\0\0\0\0\0\0\x04\0\0\0\x6e\0\0\0\xa0\x2c\0\0\0\
+\0\0\0\x04\0\0\0\x6f\0\0\0\xac\x2c\0\0\0\0\0\0\x04\0\0\0\x68\0\0\0\xb8\x2c\0\0\
+\0\0\0\0\x04\0\0\0\x65\0\0\0\xd0\x2c\0\0\0\0\0\0\x03\0\0\0\x64\0\0\0\xdc\x2c\0\
+\0\0\0\0\0\x03\0\0\0\x6d\0\0\0\xe8\x2c\0\0\0\0\0\0\x03\0\0\0\x66\0\0\0\xf4\x2c\
+\0\0\0\0\0\0\x03\0\0\0\x67\0\0\0\x0c\x2d\0\0\0\0\0\0\x04\0\0\0\x72\0\0\0\x2c\0\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x3c\0\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x50\0\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\0\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\0\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\0\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\0\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\xa0\0\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\0\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\xc0\0\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\0\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\xe0\0\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\0\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\0\x01\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x01\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\x20\x01\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x01\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\x40\x01\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x01\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\x60\x01\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x01\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\x80\x01\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x01\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x01\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x01\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x01\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x01\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x01\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\
+\x01\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\
+\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\
+\x30\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\
+\0\x50\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\
+\0\0\x70\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x02\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\x90\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x02\0\0\0\0\0\0\x04\0\0\0\
+\x01\0\0\0\xb0\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x02\0\0\0\0\0\0\x04\0\0\
+\0\x01\0\0\0\xd0\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x02\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\xf0\x02\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x03\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\x10\x03\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x03\0\0\0\0\0\0\x04\
+\0\0\0\x01\0\0\0\x30\x03\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\x03\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\x50\x03\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x03\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\x70\x03\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x03\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\x90\x03\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x03\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x03\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x03\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x03\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x03\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x03\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x04\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x04\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\
+\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\
+\x60\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\0\
+\0\x80\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\
+\0\0\xa0\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x04\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\xc0\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x04\0\0\0\0\0\0\x04\0\0\0\
+\x01\0\0\0\xe0\x04\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x04\0\0\0\0\0\0\x04\0\0\
+\0\x01\0\0\0\0\x05\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x05\0\0\0\0\0\0\x04\0\0\
+\0\x01\0\0\0\x20\x05\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x05\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\x40\x05\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x05\0\0\0\0\0\0\x04\
+\0\0\0\x01\0\0\0\x60\x05\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x05\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\x80\x05\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x05\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\xa0\x05\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x05\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\xc0\x05\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x05\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x05\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x05\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x06\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x06\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x06\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\
+\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\
+\x90\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\
+\0\xb0\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\
+\0\0\xd0\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x06\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\xf0\x06\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x07\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\x10\x07\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x07\0\0\0\0\0\0\x04\0\0\0\
+\x01\0\0\0\x30\x07\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\x07\0\0\0\0\0\0\x04\0\0\
+\0\x01\0\0\0\x50\x07\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x07\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\x70\x07\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x07\0\0\0\0\0\0\x04\
+\0\0\0\x01\0\0\0\x90\x07\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x07\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\xb0\x07\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x07\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\xd0\x07\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x07\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\xf0\x07\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x08\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\x10\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x08\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\x30\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\x08\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x08\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x08\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\
+\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\
+\xc0\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\0\
+\0\xe0\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x08\0\0\0\0\0\0\x04\0\0\0\x01\0\
+\0\0\0\x09\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x09\0\0\0\0\0\0\x04\0\0\0\x01\0\
+\0\0\x20\x09\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x09\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\x40\x09\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x09\0\0\0\0\0\0\x04\0\0\0\
+\x01\0\0\0\x60\x09\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x09\0\0\0\0\0\0\x04\0\0\
+\0\x01\0\0\0\x80\x09\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x09\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\xa0\x09\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x09\0\0\0\0\0\0\x04\
+\0\0\0\x01\0\0\0\xc0\x09\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x09\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\xe0\x09\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x09\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\0\x0a\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x0a\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\x20\x0a\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x0a\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\x40\x0a\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x0a\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\x60\x0a\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x0a\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x0a\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x0a\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x0a\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x0a\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x0a\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\
+\x0a\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x0a\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\
+\xf0\x0a\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\
+\x10\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\0\
+\0\x30\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\
+\0\0\x50\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x0b\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\x70\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x0b\0\0\0\0\0\0\x04\0\0\0\
+\x01\0\0\0\x90\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x0b\0\0\0\0\0\0\x04\0\0\
+\0\x01\0\0\0\xb0\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x0b\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\xd0\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x0b\0\0\0\0\0\0\x04\
+\0\0\0\x01\0\0\0\xf0\x0b\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x0c\0\0\0\0\0\0\x04\
+\0\0\0\x01\0\0\0\x10\x0c\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x0c\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\x30\x0c\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\x0c\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\x50\x0c\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x0c\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\x70\x0c\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x0c\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\x90\x0c\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x0c\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x0c\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x0c\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x0c\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x0c\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x0c\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x0d\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\
+\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\
+\x40\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\0\
+\0\x60\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\
+\0\0\x80\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x0d\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\xa0\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x0d\0\0\0\0\0\0\x04\0\0\0\
+\x01\0\0\0\xc0\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x0d\0\0\0\0\0\0\x04\0\0\
+\0\x01\0\0\0\xe0\x0d\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x0d\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\0\x0e\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x0e\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\x20\x0e\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x0e\0\0\0\0\0\0\x04\
+\0\0\0\x01\0\0\0\x40\x0e\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x0e\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\x60\x0e\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x0e\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\x80\x0e\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x0e\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\xa0\x0e\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x0e\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x0e\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x0e\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x0e\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x0e\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x0f\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x0f\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\
+\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\
+\x70\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\
+\0\x90\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\
+\0\0\xb0\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x0f\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\xd0\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x0f\0\0\0\0\0\0\x04\0\0\0\
+\x01\0\0\0\xf0\x0f\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x10\0\0\0\0\0\0\x04\0\0\0\
+\x01\0\0\0\x10\x10\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x10\0\0\0\0\0\0\x04\0\0\
+\0\x01\0\0\0\x30\x10\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\x10\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\x50\x10\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x10\0\0\0\0\0\0\x04\
+\0\0\0\x01\0\0\0\x70\x10\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x10\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\x90\x10\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x10\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\xb0\x10\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xc0\x10\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\xd0\x10\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xe0\x10\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x10\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\0\x11\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\x10\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x20\x11\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x40\x11\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x11\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\
+\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\
+\xa0\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\0\
+\0\xc0\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\
+\0\0\xe0\x11\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x11\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\0\x12\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x12\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\x20\x12\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x12\0\0\0\0\0\0\x04\0\0\0\
+\x01\0\0\0\x40\x12\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x12\0\0\0\0\0\0\x04\0\0\
+\0\x01\0\0\0\x60\x12\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x12\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\x80\x12\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x12\0\0\0\0\0\0\x04\
+\0\0\0\x01\0\0\0\xa0\x12\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb0\x12\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\xc0\x12\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd0\x12\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\xe0\x12\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf0\x12\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\0\x13\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x10\x13\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\x20\x13\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x30\x13\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\x40\x13\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x50\x13\0\0\
+\0\0\0\0\x04\0\0\0\x01\0\0\0\x60\x13\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x70\x13\0\
+\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x80\x13\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x90\x13\
+\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xa0\x13\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb8\
+\x13\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x13\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\xd8\x13\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x13\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\xf8\x13\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x14\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\x18\x14\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x14\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\x38\x14\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x14\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\x58\x14\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x14\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\x78\x14\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\x14\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\x98\x14\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xa8\x14\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\xb8\x14\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x14\0\0\0\0\0\0\
+\x04\0\0\0\x32\0\0\0\xd8\x14\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x14\0\0\0\0\0\
+\0\x04\0\0\0\x32\0\0\0\xf8\x14\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x15\0\0\0\0\
+\0\0\x04\0\0\0\x32\0\0\0\x18\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x15\0\0\0\
+\0\0\0\x04\0\0\0\x32\0\0\0\x38\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x15\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x15\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\x15\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xa8\
+\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\xc8\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\xe8\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x15\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\x08\x16\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x16\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\x28\x16\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x16\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\x48\x16\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x16\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\x68\x16\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x16\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\x88\x16\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\x16\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\xa8\x16\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x16\0\0\0\0\0\0\
+\x04\0\0\0\x32\0\0\0\xc8\x16\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x16\0\0\0\0\0\
+\0\x04\0\0\0\x32\0\0\0\xe8\x16\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x16\0\0\0\0\
+\0\0\x04\0\0\0\x32\0\0\0\x08\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x17\0\0\0\
+\0\0\0\x04\0\0\0\x32\0\0\0\x28\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x17\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x17\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x17\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\
+\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xa8\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\xb8\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\xd8\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\xf8\x17\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x18\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\x18\x18\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x18\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\x38\x18\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x18\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\x58\x18\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x18\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\x78\x18\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\x18\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\x98\x18\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xa8\x18\0\0\0\0\0\0\
+\x04\0\0\0\x32\0\0\0\xb8\x18\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x18\0\0\0\0\0\
+\0\x04\0\0\0\x32\0\0\0\xd8\x18\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x18\0\0\0\0\
+\0\0\x04\0\0\0\x32\0\0\0\xf8\x18\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x19\0\0\0\
+\0\0\0\x04\0\0\0\x32\0\0\0\x18\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x19\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x19\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x19\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\
+\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\xa8\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\xc8\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\xe8\x19\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x19\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\x08\x1a\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x1a\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\x28\x1a\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x1a\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\x48\x1a\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x1a\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\x68\x1a\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x1a\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\x88\x1a\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\x1a\0\0\0\0\0\0\
+\x04\0\0\0\x32\0\0\0\xa8\x1a\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x1a\0\0\0\0\0\
+\0\x04\0\0\0\x32\0\0\0\xc8\x1a\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x1a\0\0\0\0\
+\0\0\x04\0\0\0\x32\0\0\0\xe8\x1a\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x1a\0\0\0\
+\0\0\0\x04\0\0\0\x32\0\0\0\x08\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x1b\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x1b\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x1b\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\
+\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\x98\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xa8\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\xb8\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\xd8\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x1b\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\xf8\x1b\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x1c\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\x18\x1c\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x1c\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\x38\x1c\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x1c\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\x58\x1c\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x1c\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\x78\x1c\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\x1c\0\0\0\0\0\0\
+\x04\0\0\0\x32\0\0\0\x98\x1c\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xa8\x1c\0\0\0\0\0\
+\0\x04\0\0\0\x32\0\0\0\xb8\x1c\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x1c\0\0\0\0\
+\0\0\x04\0\0\0\x32\0\0\0\xd8\x1c\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x1c\0\0\0\
+\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x1c\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x1d\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x1d\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x1d\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\
+\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\x88\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\xa8\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\xc8\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x1d\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\xe8\x1d\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x1d\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\x08\x1e\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x1e\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\x28\x1e\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x1e\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\x48\x1e\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x1e\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\x68\x1e\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x1e\0\0\0\0\0\0\
+\x04\0\0\0\x32\0\0\0\x88\x1e\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\x1e\0\0\0\0\0\
+\0\x04\0\0\0\x32\0\0\0\xa8\x1e\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x1e\0\0\0\0\
+\0\0\x04\0\0\0\x32\0\0\0\xc8\x1e\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x1e\0\0\0\
+\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x1e\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x1e\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x1f\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x1f\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\
+\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\x78\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\x98\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xa8\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\xb8\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x1f\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\xd8\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x1f\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\xf8\x1f\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x20\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\x18\x20\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x20\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\x38\x20\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x20\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\x58\x20\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x20\0\0\0\0\0\0\
+\x04\0\0\0\x32\0\0\0\x78\x20\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\x20\0\0\0\0\0\
+\0\x04\0\0\0\x32\0\0\0\x98\x20\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xa8\x20\0\0\0\0\
+\0\0\x04\0\0\0\x32\0\0\0\xb8\x20\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x20\0\0\0\
+\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x20\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x20\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x20\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x21\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x21\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\
+\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\x68\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\x88\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\xa8\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x21\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\xc8\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x21\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\xe8\x21\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x21\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\x08\x22\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x22\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\x28\x22\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x22\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\x48\x22\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x22\0\0\0\0\0\0\
+\x04\0\0\0\x32\0\0\0\x68\x22\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x22\0\0\0\0\0\
+\0\x04\0\0\0\x32\0\0\0\x88\x22\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\x22\0\0\0\0\
+\0\0\x04\0\0\0\x32\0\0\0\xa8\x22\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x22\0\0\0\
+\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x22\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x22\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x22\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x22\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x23\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\
+\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\x58\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\x78\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\x98\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xa8\x23\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\xb8\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x23\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\xd8\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x23\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\xf8\x23\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x24\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\x18\x24\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\x24\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\x38\x24\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x48\x24\0\0\0\0\0\0\
+\x04\0\0\0\x32\0\0\0\x58\x24\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x68\x24\0\0\0\0\0\
+\0\x04\0\0\0\x32\0\0\0\x78\x24\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x88\x24\0\0\0\0\
+\0\0\x04\0\0\0\x32\0\0\0\x98\x24\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xa8\x24\0\0\0\
+\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x24\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x24\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x24\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x24\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x24\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x25\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x28\
+\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\x48\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\x68\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\x88\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\x25\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\xa8\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x25\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\xc8\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x25\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\xe8\x25\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x25\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\x08\x26\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x18\x26\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\x28\x26\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x38\x26\0\0\0\0\0\0\
+\x04\0\0\0\x32\0\0\0\x48\x26\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x58\x26\0\0\0\0\0\
+\0\x04\0\0\0\x32\0\0\0\x68\x26\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x78\x26\0\0\0\0\
+\0\0\x04\0\0\0\x32\0\0\0\x88\x26\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x98\x26\0\0\0\
+\0\0\0\x04\0\0\0\x32\0\0\0\xa8\x26\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xb8\x26\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\xc8\x26\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xd8\x26\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xe8\x26\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xf8\x26\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x08\x27\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x24\
+\x27\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x34\x27\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\
+\x44\x27\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x54\x27\0\0\0\0\0\0\x04\0\0\0\x01\0\0\
+\0\x64\x27\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x74\x27\0\0\0\0\0\0\x04\0\0\0\x01\0\
+\0\0\x84\x27\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x94\x27\0\0\0\0\0\0\x04\0\0\0\x01\
+\0\0\0\xa4\x27\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xb4\x27\0\0\0\0\0\0\x04\0\0\0\
+\x01\0\0\0\xc4\x27\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xd4\x27\0\0\0\0\0\0\x04\0\0\
+\0\x01\0\0\0\xe4\x27\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\xf4\x27\0\0\0\0\0\0\x04\0\
+\0\0\x01\0\0\0\x04\x28\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x14\x28\0\0\0\0\0\0\x04\
+\0\0\0\x01\0\0\0\x24\x28\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x34\x28\0\0\0\0\0\0\
+\x04\0\0\0\x01\0\0\0\x44\x28\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x54\x28\0\0\0\0\0\
+\0\x04\0\0\0\x01\0\0\0\x64\x28\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x74\x28\0\0\0\0\
+\0\0\x04\0\0\0\x01\0\0\0\x84\x28\0\0\0\0\0\0\x04\0\0\0\x01\0\0\0\x94\x28\0\0\0\
+\0\0\0\x04\0\0\0\x01\0\0\0\xac\x28\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xbc\x28\0\0\
+\0\0\0\0\x04\0\0\0\x32\0\0\0\xcc\x28\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xdc\x28\0\
+\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xec\x28\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xfc\x28\
+\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x0c\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x1c\
+\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x2c\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\
+\x3c\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x4c\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\
+\0\x5c\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x6c\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\
+\0\0\x7c\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x8c\x29\0\0\0\0\0\0\x04\0\0\0\x32\
+\0\0\0\x9c\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xac\x29\0\0\0\0\0\0\x04\0\0\0\
+\x32\0\0\0\xbc\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xcc\x29\0\0\0\0\0\0\x04\0\0\
+\0\x32\0\0\0\xdc\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\xec\x29\0\0\0\0\0\0\x04\0\
+\0\0\x32\0\0\0\xfc\x29\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x0c\x2a\0\0\0\0\0\0\x04\
+\0\0\0\x32\0\0\0\x1c\x2a\0\0\0\0\0\0\x04\0\0\0\x32\0\0\0\x6d\x7b\x6e\x77\x70\
+\x71\x73\x7c\x75\x74\x76\x7a\x78\x79\x72\x6f\0\x6f\x76\x65\x72\x66\x6c\x6f\x77\
+\0\x2e\x74\x65\x78\x74\0\x2e\x72\x65\x6c\x2e\x42\x54\x46\x2e\x65\x78\x74\0\x74\
+\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x5f\x5f\x6e\x65\x74\x5f\x6e\x65\x74\x5f\
+\x64\x65\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\x69\x74\0\x2e\x72\x65\x6c\x74\
+\x72\x61\x63\x65\x70\x6f\x69\x6e\x74\x2f\x6e\x65\x74\x2f\x6e\x65\x74\x5f\x64\
+\x65\x76\x5f\x73\x74\x61\x72\x74\x5f\x78\x6d\x69\x74\0\x2e\x62\x73\x73\0\x2e\
+\x6d\x61\x70\x73\0\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x73\0\x61\x6c\x6c\
+\x5f\x69\x66\x61\x63\x65\x73\0\x75\x73\x65\x5f\x6d\x61\x70\0\x61\x63\x63\x6f\
+\x75\x6e\x74\x69\x6e\x67\0\x2e\x6c\x6c\x76\x6d\x5f\x61\x64\x64\x72\x73\x69\x67\
+\0\x61\x63\x74\x69\x76\x65\0\x73\x61\x6d\x70\x6c\x65\x5f\x72\x61\x74\x65\0\x6c\
+\x69\x63\x65\x6e\x73\x65\0\x73\x61\x6d\x70\x6c\x65\0\x73\x61\x6d\x70\x6c\x65\
+\x5f\x6d\x6f\x64\x65\0\x61\x6c\x6c\x6f\x77\x65\x64\0\x74\x72\x61\x63\x65\x70\
+\x6f\x69\x6e\x74\x5f\x5f\x6e\x65\x74\x5f\x6e\x65\x74\x69\x66\x5f\x72\x65\x63\
+\x65\x69\x76\x65\x5f\x73\x6b\x62\0\x2e\x72\x65\x6c\x74\x72\x61\x63\x65\x70\x6f\
+\x69\x6e\x74\x2f\x6e\x65\x74\x2f\x6e\x65\x74\x69\x66\x5f\x72\x65\x63\x65\x69\
+\x76\x65\x5f\x73\x6b\x62\0\x2e\x73\x74\x72\x74\x61\x62\0\x2e\x73\x79\x6d\x74\
+\x61\x62\0\x2e\x72\x6f\x64\x61\x74\x61\0\x2e\x72\x65\x6c\x2e\x42\x54\x46\0\x4c\
+\x49\x43\x45\x4e\x53\x45\0\x4c\x42\x42\x31\x5f\x39\0\x4c\x42\x42\x30\x5f\x39\0\
+\x4c\x42\x42\x31\x5f\x33\x39\0\x4c\x42\x42\x30\x5f\x33\x39\0\x4c\x42\x42\x31\
+\x5f\x31\x30\x39\0\x4c\x42\x42\x30\x5f\x31\x30\x39\0\x4c\x42\x42\x31\x5f\x38\0\
+\x4c\x42\x42\x30\x5f\x38\0\x4c\x42\x42\x31\x5f\x37\x38\0\x4c\x42\x42\x30\x5f\
+\x37\x38\0\x4c\x42\x42\x31\x5f\x35\x38\0\x4c\x42\x42\x30\x5f\x35\x38\0\x4c\x42\
+\x42\x31\x5f\x32\x38\0\x4c\x42\x42\x30\x5f\x32\x38\0\x4c\x42\x42\x31\x5f\x31\
+\x38\0\x4c\x42\x42\x30\x5f\x31\x38\0\x4c\x42\x42\x31\x5f\x37\0\x4c\x42\x42\x30\
+\x5f\x37\0\x4c\x42\x42\x31\x5f\x39\x37\0\x4c\x42\x42\x30\x5f\x39\x37\0\x4c\x42\
+\x42\x31\x5f\x38\x37\0\x4c\x42\x42\x30\x5f\x38\x37\0\x4c\x42\x42\x31\x5f\x34\
+\x37\0\x4c\x42\x42\x30\x5f\x34\x37\0\x4c\x42\x42\x31\x5f\x33\x37\0\x4c\x42\x42\
+\x30\x5f\x33\x37\0\x4c\x42\x42\x31\x5f\x31\x30\x37\0\x4c\x42\x42\x30\x5f\x31\
+\x30\x37\0\x6c\x6f\x73\x74\x36\0\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x73\
+\x36\0\x4c\x42\x42\x31\x5f\x36\0\x4c\x42\x42\x30\x5f\x36\0\x4c\x42\x42\x31\x5f\
+\x37\x36\0\x4c\x42\x42\x30\x5f\x37\x36\0\x4c\x42\x42\x31\x5f\x36\x36\0\x4c\x42\
+\x42\x30\x5f\x36\x36\0\x4c\x42\x42\x31\x5f\x33\x36\0\x4c\x42\x42\x30\x5f\x33\
+\x36\0\x4c\x42\x42\x31\x5f\x32\x36\0\x4c\x42\x42\x30\x5f\x32\x36\0\x4c\x42\x42\
+\x31\x5f\x39\x35\0\x4c\x42\x42\x30\x5f\x39\x35\0\x4c\x42\x42\x31\x5f\x38\x35\0\
+\x4c\x42\x42\x30\x5f\x38\x35\0\x4c\x42\x42\x31\x5f\x31\x31\x35\0\x4c\x42\x42\
+\x30\x5f\x31\x31\x35\0\x4c\x42\x42\x31\x5f\x31\x30\x35\0\x4c\x42\x42\x30\x5f\
+\x31\x30\x35\0\x6c\x6f\x73\x74\x34\0\x4c\x42\x42\x31\x5f\x36\x34\0\x4c\x42\x42\
+\x30\x5f\x36\x34\0\x4c\x42\x42\x31\x5f\x35\x34\0\x4c\x42\x42\x30\x5f\x35\x34\0\
+\x4c\x42\x42\x31\x5f\x34\x34\0\x4c\x42\x42\x30\x5f\x34\x34\0\x4c\x42\x42\x31\
+\x5f\x31\x30\x34\0\x4c\x42\x42\x30\x5f\x31\x30\x34\0\x4c\x42\x42\x31\x5f\x39\
+\x33\0\x4c\x42\x42\x30\x5f\x39\x33\0\x4c\x42\x42\x31\x5f\x38\x33\0\x4c\x42\x42\
+\x30\x5f\x38\x33\0\x4c\x42\x42\x31\x5f\x31\x33\0\x4c\x42\x42\x30\x5f\x31\x33\0\
+\x4c\x42\x42\x31\x5f\x31\x31\x33\0\x4c\x42\x42\x30\x5f\x31\x31\x33\0\x4c\x42\
+\x42\x31\x5f\x32\0\x4c\x42\x42\x30\x5f\x32\0\x4c\x42\x42\x31\x5f\x38\x32\0\x4c\
+\x42\x42\x30\x5f\x38\x32\0\x4c\x42\x42\x31\x5f\x37\x32\0\x4c\x42\x42\x30\x5f\
+\x37\x32\0\x4c\x42\x42\x31\x5f\x35\x32\0\x4c\x42\x42\x30\x5f\x35\x32\0\x4c\x42\
+\x42\x31\x5f\x33\x32\0\x4c\x42\x42\x30\x5f\x33\x32\0\x4c\x42\x42\x31\x5f\x32\
+\x32\0\x4c\x42\x42\x30\x5f\x32\x32\0\x4c\x42\x42\x31\x5f\x31\x31\x32\0\x4c\x42\
+\x42\x30\x5f\x31\x31\x32\0\x4c\x42\x42\x31\x5f\x39\x31\0\x4c\x42\x42\x30\x5f\
+\x39\x31\0\x4c\x42\x42\x31\x5f\x35\x31\0\x4c\x42\x42\x30\x5f\x35\x31\0\x4c\x42\
+\x42\x31\x5f\x34\x31\0\x4c\x42\x42\x30\x5f\x34\x31\0\x4c\x42\x42\x31\x5f\x33\
+\x31\0\x4c\x42\x42\x30\x5f\x33\x31\0\x4c\x42\x42\x31\x5f\x32\x31\0\x4c\x42\x42\
+\x30\x5f\x32\x31\0\x4c\x42\x42\x31\x5f\x31\x31\x31\0\x4c\x42\x42\x30\x5f\x31\
+\x31\x31\0\x4c\x42\x42\x31\x5f\x37\x30\0\x4c\x42\x42\x30\x5f\x37\x30\0\x4c\x42\
+\x42\x31\x5f\x36\x30\0\x4c\x42\x42\x30\x5f\x36\x30\0\x4c\x42\x42\x31\x5f\x35\
+\x30\0\x4c\x42\x42\x30\x5f\x35\x30\0\x4c\x42\x42\x31\x5f\x31\x30\x30\0\x4c\x42\
+\x42\x30\x5f\x31\x30\x30\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\x26\x01\0\0\x03\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xb0\xf6\0\0\0\0\0\0\x70\
+\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x0a\0\0\0\
+\x01\0\0\0\x06\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x40\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x05\x01\0\0\x01\0\0\0\x06\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x40\0\0\0\0\0\0\0\x08\x1a\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\x08\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x01\x01\0\0\x09\0\0\0\x40\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\xc9\0\0\0\0\0\0\x70\x01\0\0\0\0\0\0\x10\0\0\0\x03\0\0\0\x08\
+\0\0\0\0\0\0\0\x10\0\0\0\0\0\0\0\x44\0\0\0\x01\0\0\0\x06\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\0\x48\x1a\0\0\0\0\0\0\x08\x1a\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x08\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\x40\0\0\0\x09\0\0\0\x40\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x70\
+\xca\0\0\0\0\0\0\x70\x01\0\0\0\0\0\0\x10\0\0\0\x05\0\0\0\x08\0\0\0\0\0\0\0\x10\
+\0\0\0\0\0\0\0\x36\x01\0\0\x01\0\0\0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x50\x34\
+\0\0\0\0\0\0\x10\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\x66\0\0\0\x08\0\0\0\x03\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x60\x34\0\0\0\0\0\0\
+\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xbc\0\0\0\
+\x01\0\0\0\x03\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x60\x34\0\0\0\0\0\0\x04\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x6b\0\0\0\x01\0\0\0\x03\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x68\x34\0\0\0\0\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\x08\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x42\x01\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\0\0\0\0\0\x68\x35\0\0\0\0\0\0\xa1\x5e\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x04\0\
+\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x3e\x01\0\0\x09\0\0\0\x40\0\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\xe0\xcb\0\0\0\0\0\0\xe0\0\0\0\0\0\0\0\x10\0\0\0\x0b\0\0\0\x08\0\0\0\0\0\
+\0\0\x10\0\0\0\0\0\0\0\x14\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x0c\
+\x94\0\0\0\0\0\0\x2c\x2a\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x04\0\0\0\0\0\0\0\0\0\0\0\
+\0\0\0\0\x10\0\0\0\x09\0\0\0\x40\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\xc0\xcc\0\0\0\0\
+\0\0\xe0\x29\0\0\0\0\0\0\x10\0\0\0\x0d\0\0\0\x08\0\0\0\0\0\0\0\x10\0\0\0\0\0\0\
+\0\x9b\0\0\0\x03\x4c\xff\x6f\0\0\0\x80\0\0\0\0\0\0\0\0\0\0\0\0\xa0\xf6\0\0\0\0\
+\0\0\x10\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x01\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x2e\
+\x01\0\0\x02\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\0\x38\xbe\0\0\0\0\0\0\xc8\x0a\
+\0\0\0\0\0\0\x01\0\0\0\x63\0\0\0\x08\0\0\0\0\0\0\0\x18\0\0\0\0\0\0\0";
 
 	return 0;
 err:
@@ -27,11 +27,14 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"log"
+	"runtime"
+	"syscall"
 	"time"
 	"unsafe"
 
 	"github.com/chripell/flowsnoop/flow"
-	"gopkg.in/restruct.v1"
+	"github.com/chripell/flowsnoop/ifaces"
 )
 
 type connMap struct {
@@ -41,17 +44,33 @@ type connMap struct {
 	vp unsafe.Pointer
 	z  []byte
 	zp unsafe.Pointer
+	// Buffers for batch operations, allocated on first use.
+	keys   []byte
+	values []byte
+	in     []byte
+	out    []byte
 }
 
 type Ebpf2 struct {
 	consumer flow.Consumer
 	obj      *C.struct_flowsnoop2
 	finished chan struct{}
-	conn4    *connMap
-	bconn4   *connMap
-	conn6    *connMap
-	bconn6   *connMap
-	currentB bool
+	maps4    *flowMaps
+	maps6    *flowMaps
+	// Follows the interfaces watched, unless all of them are.
+	tracker   *ifaces.Tracker
+	allowedFd C.int
+	// Sequence number of the generation in use, bit 0 selects
+	// the maps.
+	seq        uint32
+	activeFd   C.int
+	overflowFd C.int
+	// Values of an entry of a per-CPU array.
+	percpu    []uint64
+	overflowR flow.OverflowReader
+	noBatch   bool
+	noGrow    bool
+	sampling  flow.Sampling
 }
 
 func memsetLoop(a []byte, v byte) {
@@ -60,33 +79,160 @@ func memsetLoop(a []byte, v byte) {
 	}
 }
 
-func newConnMap(m *C.struct_bpf_map) *connMap {
-	connDef := C.bpf_map__def(m)
+// newConnMap creates a flow map with ks bytes keys.
+func newConnMap(ks, buckets int) (*connMap, error) {
+	fd, errno := C.bpf_create_map(C.BPF_MAP_TYPE_HASH, C.int(ks), 8, C.int(buckets), 0)
+	if fd < 0 {
+		return nil, fmt.Errorf("cannot create map: %d %v", fd, errno)
+	}
 	cm := &connMap{
-		fd: C.bpf_map__fd(m),
-		ks: int(connDef.key_size),
-		v:  make([]byte, connDef.value_size),
-		z:  make([]byte, connDef.key_size),
+		fd: fd,
+		ks: ks,
+		v:  make([]byte, 8),
+		z:  make([]byte, ks),
 	}
 	cm.vp = unsafe.Pointer(&cm.v[0])
 	memsetLoop(cm.z, 0xff)
 	cm.zp = unsafe.Pointer(&cm.z[0])
-	return cm
+	return cm, nil
+}
+
+func (cm *connMap) close() {
+	syscall.Close(int(cm.fd))
+}
+
+// Sizes of struct conn_s and struct conn6_s in the eBPF program,
+// padding included.
+const (
+	key4Len = 16
+	key6Len = 38
+)
+
+// flowMaps are the flow maps of both generations of an IP version.
+// They are created here and installed in the outer map the eBPF
+// program looks them up from, so that they can be replaced.
+type flowMaps struct {
+	outer   *C.struct_bpf_map
+	ks      int
+	conn    [2]*connMap
+	buckets [2]int
+}
+
+// newFlowMaps creates the maps, it must be called before loading the
+// eBPF program: the kernel checks that the maps installed in outer
+// match the first one, size included before Linux 5.10.
+func newFlowMaps(outer *C.struct_bpf_map, ks, buckets int) (*flowMaps, error) {
+	fm := &flowMaps{
+		outer: outer,
+		ks:    ks,
+	}
+	for gen := range fm.conn {
+		cm, err := newConnMap(ks, buckets)
+		if err != nil {
+			fm.close()
+			return nil, err
+		}
+		fm.conn[gen] = cm
+		fm.buckets[gen] = buckets
+	}
+	if ret := C.bpf_map__set_inner_map_fd(outer, fm.conn[0].fd); ret != 0 {
+		fm.close()
+		return nil, fmt.Errorf("cannot set inner map: %d", ret)
+	}
+	return fm, nil
+}
+
+// install puts the map of generation gen in the outer map, once the
+// eBPF program is loaded.
+func (fm *flowMaps) install(gen uint32) error {
+	key := C.uint(gen)
+	fd := C.uint(fm.conn[gen].fd)
+	if ret, errno := C.bpf_map_update_elem(C.bpf_map__fd(fm.outer),
+		unsafe.Pointer(&key), unsafe.Pointer(&fd), C.BPF_ANY); ret != 0 {
+		return fmt.Errorf("cannot install map: %d %v", ret, errno)
+	}
+	return nil
+}
+
+// grow replaces the map of generation gen with one twice as big, up
+// to max buckets. The eBPF programs must not be using generation gen.
+func (fm *flowMaps) grow(gen uint32, max int) error {
+	n := 2 * fm.buckets[gen]
+	if n > max {
+		n = max
+	}
+	if n <= fm.buckets[gen] {
+		return nil
+	}
+	cm, err := newConnMap(fm.ks, n)
+	if err != nil {
+		return err
+	}
+	old := fm.conn[gen]
+	fm.conn[gen] = cm
+	if err := fm.install(gen); err != nil {
+		fm.conn[gen] = old
+		cm.close()
+		return err
+	}
+	old.close()
+	fm.buckets[gen] = n
+	return nil
+}
+
+func (fm *flowMaps) close() {
+	for _, cm := range fm.conn {
+		if cm != nil {
+			cm.close()
+		}
+	}
 }
 
 const (
-	// Keep the default in sync with BUCKETS in the eBPF program.
+	// Default size of the flow maps.
 	BUCKETS = 10240
+	// Programs run to completion in microseconds, if a generation
+	// is busy for longer something is wrong with the counters.
+	drainTimeout = time.Second
+	// Returned by the kernel for unknown commands, not in syscall.
+	errnoENOTSUPP = syscall.Errno(524)
 )
 
 var (
 	iface = flag.String("ebpf2_iface", "all",
-		"Interface on which should listen or all.")
-	buckets = flag.Int("ebpf2_buckets", BUCKETS, "buckets for in-kernel tables.")
+		"Interfaces on which should listen: comma separated names or glob patterns, ! excludes, or all.")
+	buckets    = flag.Int("ebpf2_buckets", BUCKETS, "buckets for in-kernel tables.")
+	maxBuckets = flag.Int("ebpf2_max_buckets", 0,
+		"if larger than ebpf2_buckets, double the tables that overflow up to this size.")
 )
 
+// allow adds l to the interfaces watched, called by the tracker.
+func (ebpf *Ebpf2) allow(l ifaces.Link) error {
+	key := C.uint(l.Index)
+	val := C.uchar(1)
+	if ret, errno := C.bpf_map_update_elem(ebpf.allowedFd,
+		unsafe.Pointer(&key), unsafe.Pointer(&val), C.BPF_ANY); ret != 0 {
+		return fmt.Errorf("cannot watch %s: %d %v", l.Name, ret, errno)
+	}
+	return nil
+}
+
+// disallow removes l from the interfaces watched, called by the
+// tracker.
+func (ebpf *Ebpf2) disallow(l ifaces.Link, gone bool) {
+	key := C.uint(l.Index)
+	if ret, errno := C.bpf_map_delete_elem(ebpf.allowedFd,
+		unsafe.Pointer(&key)); ret != 0 {
+		log.Printf("ebpf2: cannot stop watching %s: %d %v", l.Name, ret, errno)
+	}
+}
+
 func (ebpf *Ebpf2) Init(consumer flow.Consumer) error {
 	ebpf.consumer = consumer
+	matcher, err := ifaces.Parse(*iface)
+	if err != nil {
+		return err
+	}
 	if ret := C.bump_memlock_rlimit(); ret != 0 {
 		return fmt.Errorf("failed to increase mlock limit: %d", ret)
 	}
@@ -94,34 +240,177 @@ func (ebpf *Ebpf2) Init(consumer flow.Consumer) error {
 	if ebpf.obj == nil {
 		return errors.New("failed to open eBPF object")
 	}
-	target_iface := "\000"
-	if *iface != "all" {
-		target_iface = *iface + "\000"
+	if matcher.All() {
+		ebpf.obj.rodata.all_ifaces = 1
+	} else {
+		ebpf.obj.rodata.all_ifaces = 0
+	}
+	accounting, err := flow.AccountingMode()
+	if err != nil {
+		return err
+	}
+	ebpf.obj.rodata.accounting = C.__u32(accounting)
+	if ebpf.sampling, err = flow.SamplingMode(); err != nil {
+		return err
 	}
-	for i, ch := range []byte(target_iface) {
-		ebpf.obj.rodata.targ_iface[i] = C.char(ch)
+	ebpf.obj.rodata.sample_rate = C.__u32(ebpf.sampling.Rate)
+	ebpf.obj.rodata.sample_mode = C.__u32(ebpf.sampling.Mode)
+	ebpf.maps4, err = newFlowMaps(ebpf.obj.maps.connections, key4Len, *buckets)
+	if err != nil {
+		return err
+	}
+	ebpf.maps6, err = newFlowMaps(ebpf.obj.maps.connections6, key6Len, *buckets)
+	if err != nil {
+		return err
 	}
 	if ret := C.flowsnoop2__load(ebpf.obj); ret != 0 {
 		return fmt.Errorf("failed to load eBPF program: %d", ret)
 	}
+	for _, fm := range []*flowMaps{ebpf.maps4, ebpf.maps6} {
+		for gen := uint32(0); gen < 2; gen++ {
+			if err := fm.install(gen); err != nil {
+				return err
+			}
+		}
+	}
+	if !matcher.All() {
+		ebpf.allowedFd = C.bpf_map__fd(ebpf.obj.maps.allowed)
+		ebpf.tracker = ifaces.NewTracker("ebpf2", matcher, ebpf.allow, ebpf.disallow)
+		if err := ebpf.tracker.Start(); err != nil {
+			return err
+		}
+	}
 	if ret := C.flowsnoop2__attach(ebpf.obj); ret != 0 {
 		return fmt.Errorf("failed to attach eBPF program: %d", ret)
 	}
 	ebpf.finished = make(chan struct{})
-	ebpf.conn4 = newConnMap(ebpf.obj.maps.connections)
-	ebpf.bconn4 = newConnMap(ebpf.obj.maps.bconnections)
-	ebpf.conn6 = newConnMap(ebpf.obj.maps.connections6)
-	ebpf.bconn6 = newConnMap(ebpf.obj.maps.bconnections6)
-	if *buckets != BUCKETS {
-		C.bpf_map__resize(ebpf.obj.maps.connections, C.uint(*buckets))
-		C.bpf_map__resize(ebpf.obj.maps.bconnections, C.uint(*buckets))
-		C.bpf_map__resize(ebpf.obj.maps.connections6, C.uint(*buckets))
-		C.bpf_map__resize(ebpf.obj.maps.bconnections6, C.uint(*buckets))
+	ncpus := C.libbpf_num_possible_cpus()
+	if ncpus <= 0 {
+		return fmt.Errorf("cannot get number of cpus: %d", ncpus)
 	}
+	ebpf.activeFd = C.bpf_map__fd(ebpf.obj.maps.active)
+	ebpf.overflowFd = C.bpf_map__fd(ebpf.obj.maps.overflow)
+	ebpf.percpu = make([]uint64, ncpus)
 	return nil
 }
 
-func loopMap(m *connMap, fl interface{}, k [][]byte, appEntry func(len uint64)) ([][]byte, error) {
+// grow enlarges the maps of generation gen that overflowed, they
+// will be used from the next switch to gen.
+func (ebpf *Ebpf2) grow(gen uint32, stats flow.Stats) {
+	if ebpf.noGrow || *maxBuckets <= *buckets {
+		return
+	}
+	for _, g := range []struct {
+		fm       *flowMaps
+		overflow flow.Overflow
+	}{
+		{ebpf.maps4, stats.Overflow4},
+		{ebpf.maps6, stats.Overflow6},
+	} {
+		if g.overflow.Packets == 0 {
+			continue
+		}
+		if err := g.fm.grow(gen, *maxBuckets); err != nil {
+			log.Printf("ebpf2: cannot grow maps, keeping their size: %v", err)
+			ebpf.noGrow = true
+			return
+		}
+	}
+}
+
+// lookupSum reads entry idx of the per-CPU array fd, summed over all
+// CPUs.
+func (ebpf *Ebpf2) lookupSum(fd C.int, idx uint32) (uint64, error) {
+	key := C.uint(idx)
+	if ret, errno := C.bpf_map_lookup_elem(fd,
+		unsafe.Pointer(&key), unsafe.Pointer(&ebpf.percpu[0])); ret != 0 {
+		return 0, fmt.Errorf("lookup failed: %d %v", ret, errno)
+	}
+	var sum uint64
+	for _, n := range ebpf.percpu {
+		sum += n
+	}
+	return sum, nil
+}
+
+// waitDrained waits for all the eBPF programs still accounting into
+// generation gen of the maps to finish. Programs run to completion
+// without sleeping, so this is usually immediate.
+func (ebpf *Ebpf2) waitDrained(gen uint32) error {
+	start := time.Now()
+	for spins := 0; ; spins++ {
+		busy, err := ebpf.lookupSum(ebpf.activeFd, gen)
+		if err != nil {
+			return fmt.Errorf("cannot read active counters: %w", err)
+		}
+		if busy == 0 {
+			return nil
+		}
+		if time.Since(start) > drainTimeout {
+			return fmt.Errorf("generation %d still busy after %v", gen, drainTimeout)
+		}
+		if spins < 100 {
+			runtime.Gosched()
+		} else {
+			time.Sleep(10 * time.Microsecond)
+		}
+	}
+}
+
+// Entries moved by a single batch syscall.
+const batchLen = 1024
+
+// drainBatch calls each for all the entries of m, deleting them with
+// BPF_MAP_LOOKUP_AND_DELETE_BATCH (Linux 5.6 or later). It returns
+// false, before touching the map, if the kernel does not support it.
+func drainBatch(m *connMap, each func(key []byte, tot uint64) error) (bool, error) {
+	if m.keys == nil {
+		m.keys = make([]byte, batchLen*m.ks)
+		m.values = make([]byte, batchLen*len(m.v))
+		// The position in the map is at most a key long.
+		m.in = make([]byte, m.ks)
+		m.out = make([]byte, m.ks)
+	}
+	vs := len(m.v)
+	for first := true; ; first = false {
+		var in unsafe.Pointer
+		if !first {
+			in = unsafe.Pointer(&m.in[0])
+		}
+		count := C.__u32(batchLen)
+		ret, errno := C.bpf_map_lookup_and_delete_batch(m.fd, in,
+			unsafe.Pointer(&m.out[0]), unsafe.Pointer(&m.keys[0]),
+			unsafe.Pointer(&m.values[0]), &count, nil)
+		// Older libbpf return -1 and set errno, newer ones
+		// return -errno.
+		if ret < 0 && errno == nil {
+			errno = syscall.Errno(-ret)
+		}
+		if first && ret != 0 && (errno == syscall.EINVAL || errno == errnoENOTSUPP || errno == syscall.ENOSYS) {
+			return false, nil
+		}
+		if ret != 0 && errno != syscall.ENOENT {
+			return true, fmt.Errorf("batch lookup and delete failed: %d %v", ret, errno)
+		}
+		// Entries are returned together with ENOENT for the
+		// last batch.
+		for i := 0; i < int(count); i++ {
+			if err := each(m.keys[i*m.ks:(i+1)*m.ks],
+				binary.LittleEndian.Uint64(m.values[i*vs:])); err != nil {
+				return true, err
+			}
+		}
+		if ret != 0 {
+			return true, nil
+		}
+		m.in, m.out = m.out, m.in
+	}
+}
+
+// drainLoop is the fallback of drainBatch for older kernels: it uses
+// three syscalls per entry. k is reused across calls to avoid
+// allocating keys.
+func drainLoop(m *connMap, k [][]byte, each func(key []byte, tot uint64) error) ([][]byte, error) {
 	n := 0
 	for {
 		if n == len(k) {
@@ -140,11 +429,9 @@ func loopMap(m *connMap, fl interface{}, k [][]byte, appEntry func(len uint64))
 			np, m.vp); ret != 0 {
 			return nil, fmt.Errorf("cannot lookup elem: %d %d", ret, errno)
 		}
-		if err := restruct.Unpack(k[n],
-			binary.BigEndian, fl); err != nil {
-			return nil, fmt.Errorf("unpacking of flow failed: %v", err)
+		if err := each(k[n], binary.LittleEndian.Uint64(m.v)); err != nil {
+			return nil, err
 		}
-		appEntry(binary.LittleEndian.Uint64(m.v))
 		n++
 	}
 	for _, ck := range k[:n] {
@@ -157,6 +444,20 @@ func loopMap(m *connMap, fl interface{}, k [][]byte, appEntry func(len uint64))
 	return k, nil
 }
 
+// drain calls each for all the entries of m, deleting them. It uses
+// batch operations unless the kernel turned out not to support them.
+func (ebpf *Ebpf2) drain(m *connMap, k [][]byte,
+	each func(key []byte, tot uint64) error) ([][]byte, error) {
+	if !ebpf.noBatch {
+		ok, err := drainBatch(m, each)
+		if ok {
+			return k, err
+		}
+		ebpf.noBatch = true
+	}
+	return drainLoop(m, k, each)
+}
+
 func (ebpf *Ebpf2) Run(ctx context.Context, flush <-chan (chan<- error)) {
 	go func() {
 		defer close(ebpf.finished)
@@ -165,7 +466,6 @@ func (ebpf *Ebpf2) Run(ctx context.Context, flush <-chan (chan<- error)) {
 			flows6 []flow.Sample6L
 			k4     [][]byte
 			k6     [][]byte
-			err    error
 			read4  *connMap
 			read6  *connMap
 		)
@@ -179,48 +479,63 @@ func (ebpf *Ebpf2) Run(ctx context.Context, flush <-chan (chan<- error)) {
 			}
 			// Select which map we are reading and
 			// redirect recording to the other
-			if ebpf.currentB {
-				read4 = ebpf.bconn4
-				read6 = ebpf.bconn6
-				ebpf.obj.bss.use_map = 0
-			} else {
-				read4 = ebpf.conn4
-				read6 = ebpf.conn6
-				ebpf.obj.bss.use_map = 1
-			}
+			prev := ebpf.seq & 1
+			read4 = ebpf.maps4.conn[prev]
+			read6 = ebpf.maps6.conn[prev]
+			ebpf.seq++
+			ebpf.obj.bss.use_map = C.int(ebpf.seq)
 			tick := time.Now()
-			// Give time for eBPF update to finish on the
-			// current map. This looks *plenty* of time.
-			time.Sleep(10 * time.Millisecond)
-			ebpf.currentB = !ebpf.currentB
+			if err := ebpf.waitDrained(prev); err != nil {
+				chErr <- err
+				return
+			}
+			stats, err := ebpf.overflowR.Read(prev, func(idx uint32) (uint64, error) {
+				return ebpf.lookupSum(ebpf.overflowFd, idx)
+			})
+			if err != nil {
+				chErr <- err
+				return
+			}
 			// IPv4
-			var fl4 flow.Sample4
-			k4, err = loopMap(read4, &fl4, k4,
-				func(n uint64) {
+			k4, err = ebpf.drain(read4, k4,
+				func(k []byte, n uint64) error {
+					var fl flow.Sample4
+					if err := fl.Unpack(k); err != nil {
+						return err
+					}
 					flows4 = append(flows4, flow.Sample4L{
-						Flow: fl4,
+						Flow: fl,
 						Tot:  n,
 					})
+					return nil
 				})
 			if err != nil {
 				chErr <- err
 				return
 			}
 			// IPv6
-			var fl6 flow.Sample6
-			k6, err = loopMap(read6, &fl6, k6,
-				func(n uint64) {
+			k6, err = ebpf.drain(read6, k6,
+				func(k []byte, n uint64) error {
+					var fl flow.Sample6
+					if err := fl.Unpack(k); err != nil {
+						return err
+					}
 					flows6 = append(flows6, flow.Sample6L{
-						Flow: fl6,
+						Flow: fl,
 						Tot:  n,
 					})
+					return nil
 				})
 			if err != nil {
 				chErr <- err
 				return
 			}
+			ebpf.grow(prev, stats)
+			ebpf.sampling.Scale(&stats, flows4, flows6)
+			flows4, flows6 = stats.AddOther(flows4, flows6)
+			stats.LogOverflow("ebpf2")
 			// Push to consumer
-			if err := ebpf.consumer.Push(tick, flows4, nil, flows6, nil); err != nil {
+			if err := ebpf.consumer.Push(tick, flows4, nil, flows6, nil, stats); err != nil {
 				chErr <- fmt.Errorf("error from consumer: %w\n", err)
 				return
 			}
@@ -232,8 +547,13 @@ func (ebpf *Ebpf2) Run(ctx context.Context, flush <-chan (chan<- error)) {
 }
 
 func (ebpf *Ebpf2) Finalize() error {
+	if ebpf.tracker != nil {
+		ebpf.tracker.Stop()
+	}
 	<-ebpf.finished
 	C.flowsnoop2__destroy(ebpf.obj)
+	ebpf.maps4.close()
+	ebpf.maps6.close()
 	return nil
 }
 
@@ -0,0 +1,95 @@
+package ebpf2
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"encoding/binary"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Flows in the map drained by the benchmarks.
+const benchFlows = 10000
+
+// fill adds benchFlows IPv4 flows to m. cgo is not available in tests:
+// the map is updated with the bpf syscall.
+func fill(b *testing.B, m *connMap) {
+	key := make([]byte, m.ks)
+	val := make([]byte, 8)
+	attr := struct {
+		mapFd uint32
+		_     uint32
+		key   uint64
+		value uint64
+		flags uint64
+	}{
+		mapFd: uint32(m.fd),
+		key:   uint64(uintptr(unsafe.Pointer(&key[0]))),
+		value: uint64(uintptr(unsafe.Pointer(&val[0]))),
+	}
+	for f := 0; f < benchFlows; f++ {
+		binary.LittleEndian.PutUint32(key, uint32(f))
+		binary.LittleEndian.PutUint64(val, uint64(f))
+		if _, _, errno := unix.Syscall(unix.SYS_BPF, unix.BPF_MAP_UPDATE_ELEM,
+			uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr)); errno != 0 {
+			b.Fatalf("cannot fill map: %v", errno)
+		}
+	}
+}
+
+// benchmarkDrain measures draining a map of benchFlows IPv4 flows with
+// drain. The map is filled again, untimed, before each drain. It needs
+// to be root.
+func benchmarkDrain(b *testing.B, drain func(m *connMap, each func(key []byte, tot uint64) error) error) {
+	m, err := newConnMap(key4Len, benchFlows)
+	if err != nil {
+		b.Skipf("cannot create map: %v", err)
+	}
+	defer m.close()
+	var tot uint64
+	each := func(key []byte, n uint64) error {
+		tot += n
+		return nil
+	}
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		fill(b, m)
+		b.StartTimer()
+		if err := drain(m, each); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDrainBatch(b *testing.B) {
+	benchmarkDrain(b, func(m *connMap, each func([]byte, uint64) error) error {
+		ok, err := drainBatch(m, each)
+		if !ok {
+			b.Skip("batch map operations not supported")
+		}
+		return err
+	})
+}
+
+func BenchmarkDrainLoop(b *testing.B) {
+	var k [][]byte
+	benchmarkDrain(b, func(m *connMap, each func([]byte, uint64) error) error {
+		var err error
+		k, err = drainLoop(m, k, each)
+		return err
+	})
+}
@@ -0,0 +1,142 @@
+package ebpf3
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"github.com/dropbox/goebpf"
+	"golang.org/x/sys/unix"
+)
+
+// Entries moved by a single batch syscall.
+const batchLen = 1024
+
+// Returned by the kernel for unknown commands, not in x/sys.
+const errnoENOTSUPP = unix.Errno(524)
+
+var errBatchUnsupported = errors.New("batch map operations not supported")
+
+// batchAttr is the batch member of union bpf_attr.
+type batchAttr struct {
+	inBatch   uint64
+	outBatch  uint64
+	keys      uint64
+	values    uint64
+	count     uint32
+	mapFd     uint32
+	elemFlags uint64
+	flags     uint64
+}
+
+// batch drains a hash map with BPF_MAP_LOOKUP_AND_DELETE_BATCH
+// (Linux 5.6 or later), moving up to batchLen entries per syscall.
+type batch struct {
+	m      *goebpf.EbpfMap
+	keys   []byte
+	values []byte
+	// Opaque position in the map, written by the kernel in out and
+	// passed back in in.
+	in  []byte
+	out []byte
+}
+
+func newBatch(m *goebpf.EbpfMap) *batch {
+	// Hash maps use a 32 bit bucket index as position, other maps
+	// a key.
+	tokLen := m.KeySize
+	if tokLen < 4 {
+		tokLen = 4
+	}
+	return &batch{
+		m:      m,
+		keys:   make([]byte, batchLen*m.KeySize),
+		values: make([]byte, batchLen*m.ValueSize),
+		in:     make([]byte, tokLen),
+		out:    make([]byte, tokLen),
+	}
+}
+
+// drain calls each for all the entries of the map, deleting them. It
+// returns errBatchUnsupported, before touching the map, if the kernel
+// does not know about batch operations.
+func (b *batch) drain(each func(key []byte, tot uint64) error) error {
+	ks, vs := b.m.KeySize, b.m.ValueSize
+	for first := true; ; first = false {
+		attr := batchAttr{
+			outBatch: uint64(uintptr(unsafe.Pointer(&b.out[0]))),
+			keys:     uint64(uintptr(unsafe.Pointer(&b.keys[0]))),
+			values:   uint64(uintptr(unsafe.Pointer(&b.values[0]))),
+			count:    batchLen,
+			mapFd:    uint32(b.m.GetFd()),
+		}
+		if !first {
+			attr.inBatch = uint64(uintptr(unsafe.Pointer(&b.in[0])))
+		}
+		_, _, errno := unix.Syscall(unix.SYS_BPF, unix.BPF_MAP_LOOKUP_AND_DELETE_BATCH,
+			uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr))
+		runtime.KeepAlive(b)
+		if first && (errno == unix.EINVAL || errno == errnoENOTSUPP || errno == unix.ENOSYS) {
+			return errBatchUnsupported
+		}
+		if errno != 0 && errno != unix.ENOENT {
+			return fmt.Errorf("batch lookup and delete failed: %w", errno)
+		}
+		// Entries are returned together with ENOENT for the
+		// last batch.
+		for i := 0; i < int(attr.count); i++ {
+			if err := each(b.keys[i*ks:(i+1)*ks],
+				binary.LittleEndian.Uint64(b.values[i*vs:])); err != nil {
+				return err
+			}
+		}
+		if errno == unix.ENOENT {
+			return nil
+		}
+		b.in, b.out = b.out, b.in
+	}
+}
+
+// drainLoop is the fallback of batch.drain for older kernels: it uses
+// three syscalls per entry.
+func drainLoop(m *goebpf.EbpfMap, each func(key []byte, tot uint64) error) error {
+	var keys [][]byte
+	k := make([]byte, m.KeySize)
+	for {
+		nk, err := m.GetNextKey(k)
+		if err != nil {
+			break
+		}
+		data, err := m.Lookup(nk)
+		if err != nil {
+			return fmt.Errorf("table lookup failed: %w", err)
+		}
+		if err := each(nk, binary.LittleEndian.Uint64(data)); err != nil {
+			return err
+		}
+		keys = append(keys, nk)
+		k = nk
+	}
+	for _, k := range keys {
+		if err := m.Delete(k); err != nil {
+			return fmt.Errorf("deleting of flow failed: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package ebpf3
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/dropbox/goebpf"
+)
+
+// Flows in the map drained by the benchmarks.
+const benchFlows = 10000
+
+// benchmarkDrain measures draining a map of benchFlows IPv4 flows with
+// the function returned by newDrain for it. The map is filled again,
+// untimed, before each drain. It needs to be root.
+func benchmarkDrain(b *testing.B,
+	newDrain func(*goebpf.EbpfMap) func(each func(key []byte, tot uint64) error) error) {
+	m := &goebpf.EbpfMap{
+		Name:       "flowsnoop_bench",
+		Type:       goebpf.MapTypeHash,
+		KeySize:    key4Len,
+		ValueSize:  8,
+		MaxEntries: benchFlows,
+	}
+	if err := m.Create(); err != nil {
+		b.Skipf("cannot create map: %v", err)
+	}
+	defer m.Close()
+	drain := newDrain(m)
+	key := make([]byte, key4Len)
+	val := make([]byte, 8)
+	var tot uint64
+	each := func(key []byte, n uint64) error {
+		tot += n
+		return nil
+	}
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for f := 0; f < benchFlows; f++ {
+			// Zero is the start key of drainLoop.
+			binary.LittleEndian.PutUint32(key, uint32(f+1))
+			binary.LittleEndian.PutUint64(val, uint64(f))
+			if err := m.Insert(key, val); err != nil {
+				b.Fatalf("cannot fill map: %v", err)
+			}
+		}
+		b.StartTimer()
+		if err := drain(each); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDrainBatch(b *testing.B) {
+	benchmarkDrain(b, func(m *goebpf.EbpfMap) func(func([]byte, uint64) error) error {
+		bt := newBatch(m)
+		return func(each func([]byte, uint64) error) error {
+			if err := bt.drain(each); err == errBatchUnsupported {
+				b.Skip(err)
+			} else if err != nil {
+				return err
+			}
+			return nil
+		}
+	})
+}
+
+func BenchmarkDrainLoop(b *testing.B) {
+	benchmarkDrain(b, func(m *goebpf.EbpfMap) func(func([]byte, uint64) error) error {
+		return func(each func([]byte, uint64) error) error {
+			return drainLoop(m, each)
+		}
+	})
+}
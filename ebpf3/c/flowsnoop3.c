@@ -15,6 +15,7 @@
 #include <linux/types.h>
 #include <linux/bpf.h>
 #include <linux/udp.h>
+#include <linux/tcp.h>
 #include <linux/ip.h>
 #include <linux/ipv6.h>
 #include <linux/in.h>
@@ -28,28 +29,159 @@
 
 #define BUCKETS 10240
 
+/* Ids linking the flow map templates to flowsnoop_4 and flowsnoop_6. */
+#define INNER4_ID 4
+#define INNER6_ID 6
+
+/* Keep in sync with flow.Accounting. */
+#define ACCOUNT_L3 0
+#define ACCOUNT_L2 1
+#define ACCOUNT_PAYLOAD 2
+
+/* Indexes of flowsnoop_config, written by the Go side after loading. */
+#define CONFIG_ACCOUNTING 0
+#define CONFIG_DECAP 1
+#define CONFIG_SAMPLE_RATE 2
+#define CONFIG_SAMPLE_MODE 3
+#define CONFIG_MAX 4
+
+/* Keep in sync with flow.SampleMode. */
+#define SAMPLE_RANDOM 0
+#define SAMPLE_DETERMINISTIC 1
+
+/* Keep in sync with flow.TunnelType. */
+#define TUNNEL_NONE 0
+#define TUNNEL_VXLAN 1
+#define TUNNEL_GENEVE 2
+#define TUNNEL_GRE 3
+#define TUNNEL_IPIP 4
+
+#define VXLAN_PORT 4789
+#define GENEVE_PORT 6081
+
+#ifndef ETH_P_TEB
+#define ETH_P_TEB 0x6558
+#endif
+
+/* GRE flags and version, in host byte order. */
+#define GRE_CSUM 0x8000
+#define GRE_KEY 0x2000
+#define GRE_SEQ 0x1000
+#define GRE_VERSION 0x0007
+
+/*
+ * Overflow counters, OVERFLOW_FIELDS for each generation and IP
+ * version. Keep in sync with flow.OverflowCounter.
+ */
+#define OVERFLOW_BYTES 0
+#define OVERFLOW_PACKETS 1
+#define OVERFLOW_FLOWS 2
+#define OVERFLOW_FIELDS 3
+#define OVERFLOW_COUNTERS (2 * 2 * OVERFLOW_FIELDS)
+
+/* Recently lost flows remembered to count distinct ones. */
+#define LOST_ENTRIES 1024
+
+/* IPv4 datagrams being fragmented whose ports are remembered. */
+#define FRAG_ENTRIES 1024
+
+/* IPv6 extension headers, walked up to MAX_EXT_HDRS of them. */
+#ifndef NEXTHDR_HOP
+#define NEXTHDR_HOP 0
+#define NEXTHDR_ROUTING 43
+#define NEXTHDR_FRAGMENT 44
+#define NEXTHDR_AUTH 51
+#define NEXTHDR_DEST 60
+#endif
+#define MAX_EXT_HDRS 6
+
+/* 802.1Q tag, after the MAC addresses. QinQ stacks two of them. */
+struct vlan_hdr {
+  __be16 h_vlan_TCI;
+  __be16 h_vlan_encapsulated_proto;
+};
+
+#define VLAN_VID_MASK 0x0fff
+#define MAX_VLAN_TAGS 2
+
+/* The start of ICMP and ICMPv6 headers. */
+struct icmp_s {
+  uint8_t type;
+  uint8_t code;
+};
+
+/* VXLAN and Geneve headers, the VNI is in the upper 24 bits of vni. */
+struct vxlan_hdr {
+  __be32 flags;
+  __be32 vni;
+};
+
+struct geneve_hdr {
+  /* Version and length of the options in 4 bytes units. */
+  uint8_t ver_opt_len;
+  uint8_t flags;
+  __be16 protocol;
+  __be32 vni;
+};
+
+struct gre_hdr {
+  __be16 flags;
+  __be16 protocol;
+};
+
+/*
+ * The tunnel a flow was decapsulated from, all zero if none. IPv4
+ * endpoints are IPv4-mapped IPv6 addresses. Keep in sync with
+ * flow.Tunnel and unpackTunnel.
+ */
+struct tunnel_s {
+  uint8_t type;
+  uint8_t pad[3];
+  uint32_t id;
+  uint8_t src[16];
+  uint8_t dst[16];
+};
+
 struct conn_s {
   uint32_t src_ip;
   uint32_t dst_ip;
   uint16_t src_port;
   uint16_t dst_port;
   uint8_t protocol;
+  /* VLAN ID, 0 if untagged. */
+  uint16_t vlan;
+  struct tunnel_s tun;
 };
 
-struct bpf_elf_map flowsnoop_4_0 SEC("maps") = {
+/* Keep in sync with key4Len, vlan4Off and tun4Off. */
+_Static_assert(sizeof(struct conn_s) == 56, "conn_s size changed");
+_Static_assert(__builtin_offsetof(struct conn_s, vlan) == 14,
+	       "conn_s vlan moved");
+_Static_assert(__builtin_offsetof(struct conn_s, tun) == 16,
+	       "conn_s tun moved");
+
+/*
+ * Template of the flow maps of both generations, only used to create
+ * flowsnoop_4. The Go side creates it with -ebpf3_buckets entries
+ * instead of max_elem and then installs the real maps,
+ * replacing them with bigger ones when they overflow.
+ */
+struct bpf_elf_map flowsnoop_4_inner SEC("maps") = {
     .type           = BPF_MAP_TYPE_HASH,
     .size_key       = sizeof(struct conn_s),
     .size_value     = sizeof(uint64_t),
-    .pinning        = PIN_GLOBAL_NS,
+    .id             = INNER4_ID,
+    .pinning        = PIN_NONE,
     .max_elem       = BUCKETS,
 };
 
-struct bpf_elf_map flowsnoop_4_1 SEC("maps") = {
-    .type           = BPF_MAP_TYPE_HASH,
-    .size_key       = sizeof(struct conn_s),
-    .size_value     = sizeof(uint64_t),
-    .pinning        = PIN_GLOBAL_NS,
-    .max_elem       = BUCKETS,
+struct bpf_elf_map flowsnoop_4 SEC("maps") = {
+    .type           = BPF_MAP_TYPE_ARRAY_OF_MAPS,
+    .size_key       = sizeof(uint32_t),
+    .size_value     = sizeof(uint32_t),
+    .inner_id       = INNER4_ID,
+    .pinning        = PIN_NONE,
+    .max_elem       = 2,
 };
 
 struct conn6_s {
@@ -58,33 +190,178 @@ struct conn6_s {
   uint16_t src_port;
   uint16_t dst_port;
   uint8_t protocol;
+  /* VLAN ID, 0 if untagged. */
+  uint16_t vlan;
+  struct tunnel_s tun;
 };
 
-struct bpf_elf_map flowsnoop_6_0 SEC("maps") = {
+/* Keep in sync with key6Len, vlan6Off and tun6Off. */
+_Static_assert(sizeof(struct conn6_s) == 80, "conn6_s size changed");
+_Static_assert(__builtin_offsetof(struct conn6_s, vlan) == 38,
+	       "conn6_s vlan moved");
+_Static_assert(__builtin_offsetof(struct conn6_s, tun) == 40,
+	       "conn6_s tun moved");
+
+/* Like flowsnoop_4_inner. */
+struct bpf_elf_map flowsnoop_6_inner SEC("maps") = {
     .type           = BPF_MAP_TYPE_HASH,
     .size_key       = sizeof(struct conn6_s),
     .size_value     = sizeof(uint64_t),
-    .pinning        = PIN_GLOBAL_NS,
+    .id             = INNER6_ID,
+    .pinning        = PIN_NONE,
     .max_elem       = BUCKETS,
 };
 
-struct bpf_elf_map flowsnoop_6_1 SEC("maps") = {
-    .type           = BPF_MAP_TYPE_HASH,
-    .size_key       = sizeof(struct conn6_s),
+struct bpf_elf_map flowsnoop_6 SEC("maps") = {
+    .type           = BPF_MAP_TYPE_ARRAY_OF_MAPS,
+    .size_key       = sizeof(uint32_t),
+    .size_value     = sizeof(uint32_t),
+    .inner_id       = INNER6_ID,
+    .pinning        = PIN_NONE,
+    .max_elem       = 2,
+};
+
+/* Flows lost in a generation, the value is unused. */
+struct lost4_s {
+  struct conn_s conn;
+  uint32_t seq;
+};
+
+struct bpf_elf_map flowsnoop_lost4 SEC("maps") = {
+    .type           = BPF_MAP_TYPE_LRU_HASH,
+    .size_key       = sizeof(struct lost4_s),
+    .size_value     = sizeof(uint8_t),
+    .pinning        = PIN_NONE,
+    .max_elem       = LOST_ENTRIES,
+};
+
+struct lost6_s {
+  struct conn6_s conn;
+  uint32_t seq;
+};
+
+struct bpf_elf_map flowsnoop_lost6 SEC("maps") = {
+    .type           = BPF_MAP_TYPE_LRU_HASH,
+    .size_key       = sizeof(struct lost6_s),
+    .size_value     = sizeof(uint8_t),
+    .pinning        = PIN_NONE,
+    .max_elem       = LOST_ENTRIES,
+};
+
+/*
+ * A fragmented IPv4 datagram of TCP, UDP or ICMP. Its first fragment
+ * stores the ports (type and code for ICMP) in flowsnoop_frag4 so
+ * that the other ones are accounted to the same flow.
+ */
+struct frag4_s {
+  uint32_t src_ip;
+  uint32_t dst_ip;
+  uint16_t id;
+  uint8_t protocol;
+  uint8_t pad;
+};
+
+struct ports_s {
+  uint16_t src_port;
+  uint16_t dst_port;
+};
+
+struct bpf_elf_map flowsnoop_frag4 SEC("maps") = {
+    .type           = BPF_MAP_TYPE_LRU_HASH,
+    .size_key       = sizeof(struct frag4_s),
+    .size_value     = sizeof(struct ports_s),
+    .pinning        = PIN_NONE,
+    .max_elem       = FRAG_ENTRIES,
+};
+
+/*
+ * Fragments whose first fragment was not seen, per generation and
+ * CPU. They are accounted without ports.
+ */
+struct bpf_elf_map flowsnoop_unmatched SEC("maps") = {
+    .type           = BPF_MAP_TYPE_PERCPU_ARRAY,
+    .size_key       = sizeof(uint32_t),
     .size_value     = sizeof(uint64_t),
-    .pinning        = PIN_GLOBAL_NS,
-    .max_elem       = BUCKETS,
+    .pinning        = PIN_NONE,
+    .max_elem       = 2,
+};
+
+struct bpf_elf_map flowsnoop_overflow SEC("maps") = {
+    .type           = BPF_MAP_TYPE_PERCPU_ARRAY,
+    .size_key       = sizeof(uint32_t),
+    .size_value     = sizeof(uint64_t),
+    .pinning        = PIN_NONE,
+    .max_elem       = OVERFLOW_COUNTERS,
 };
 
+/*
+ * Sequence number of the generation of maps in use, bit 0 selects the
+ * maps. Incremented by the Go side at every switch.
+ */
 struct bpf_elf_map flowsnoop_switch SEC("maps") = {
     .type           = BPF_MAP_TYPE_ARRAY,
     .size_key       = sizeof(uint32_t),
     .size_value     = sizeof(uint32_t),
-    .pinning        = PIN_GLOBAL_NS,
+    .pinning        = PIN_NONE,
+    .max_elem       = 1,
+};
+
+/*
+ * Number of programs running on each generation of the maps, per
+ * CPU. The Go side reads a generation only once its counters are all
+ * zero.
+ */
+struct bpf_elf_map flowsnoop_active SEC("maps") = {
+    .type           = BPF_MAP_TYPE_PERCPU_ARRAY,
+    .size_key       = sizeof(uint32_t),
+    .size_value     = sizeof(uint64_t),
+    .pinning        = PIN_NONE,
+    .max_elem       = 2,
+};
+
+struct bpf_elf_map flowsnoop_config SEC("maps") = {
+    .type           = BPF_MAP_TYPE_ARRAY,
+    .size_key       = sizeof(uint32_t),
+    .size_value     = sizeof(uint32_t),
+    .pinning        = PIN_NONE,
+    .max_elem       = CONFIG_MAX,
+};
+
+/* Packets seen by each CPU, for deterministic sampling. */
+struct bpf_elf_map flowsnoop_sample SEC("maps") = {
+    .type           = BPF_MAP_TYPE_PERCPU_ARRAY,
+    .size_key       = sizeof(uint32_t),
+    .size_value     = sizeof(uint64_t),
+    .pinning        = PIN_NONE,
     .max_elem       = 1,
 };
 
 
+/*
+ * Loads the packet pointer at offset off of the context ctx. The
+ * compiler may keep the address of the field in a register or zero
+ * extend the pointer, and the verifier refuses both: the load is
+ * written by hand.
+ */
+#define ctx_ptr(ctx, off) ({						\
+	void *__p;							\
+	asm volatile("%0 = *(u32 *)(%1 + %2)"				\
+		     : "=r"(__p) : "r"(ctx), "i"(off));			\
+	__p;								\
+})
+
+/*
+ * Start and end of the packet of ctx, a struct xdp_md if xdp is set,
+ * a struct __sk_buff otherwise. xdp is a constant once the callers
+ * are inlined, so the access to the wrong type is optimized away.
+ */
+#define ctx_data(ctx, xdp)						\
+	((xdp) ? ctx_ptr(ctx, offsetof(struct xdp_md, data)) :		\
+		 ctx_ptr(ctx, offsetof(struct __sk_buff, data)))
+#define ctx_data_end(ctx, xdp)						\
+	((xdp) ? ctx_ptr(ctx, offsetof(struct xdp_md, data_end)) :	\
+		 ctx_ptr(ctx, offsetof(struct __sk_buff, data_end)))
+
 /*
  * Kudos https://mechpen.github.io/posts/2019-08-29-bpf-verifier/
  * for working out the correct sequence for the verifier.
@@ -95,17 +372,17 @@ struct bpf_elf_map flowsnoop_switch SEC("maps") = {
  *   - split offset into var_off and const_off
  *   - perform the 2nd check regardless of the 1st check
  */
-#define ensure_header(skb, var_off, const_off, hdr)		\
+#define ensure_header(ctx, xdp, var_off, const_off, hdr)	\
 ({								\
 	uint32_t len = const_off + sizeof(*hdr);		\
-	void *data = (void *)(long)skb->data + var_off;		\
-	void *data_end = (void *)(long)skb->data_end;		\
+	void *data = ctx_data(ctx, xdp) + var_off;		\
+	void *data_end = ctx_data_end(ctx, xdp);		\
 								\
-	if (data + len > data_end)				\
-		bpf_skb_pull_data(skb, var_off + len);		\
+	if (data + len > data_end && !(xdp))			\
+		bpf_skb_pull_data(ctx, var_off + len);		\
 								\
-	data = (void *)(long)skb->data + var_off;		\
-	data_end = (void *)(long)skb->data_end;			\
+	data = ctx_data(ctx, xdp) + var_off;			\
+	data_end = ctx_data_end(ctx, xdp);			\
 	if (data + len > data_end)				\
 		return TC_ACT_OK;				\
 								\
@@ -113,12 +390,12 @@ struct bpf_elf_map flowsnoop_switch SEC("maps") = {
 })
 
 
+#define IP_MF 0x2000
+#define IP_OFFSET 0x1fff
+
 static __always_inline int ip_is_fragment(const struct iphdr *iph)
 {
-#define IP_MF (1 << 2)
-#define IP_OFFSET (0x1fff << 3)
   return (iph->frag_off & bpf_htons(IP_MF | IP_OFFSET)) != 0;
-  return 0;
 }
 
 static __always_inline int ipv4_hdrlen(struct iphdr *ip4)
@@ -126,98 +403,524 @@ static __always_inline int ipv4_hdrlen(struct iphdr *ip4)
 	return ip4->ihl * 4;
 }
 
-static __always_inline int account_data(struct __sk_buff *skb)
+static __always_inline uint32_t get_config(uint32_t idx)
+{
+  uint32_t *val = bpf_map_lookup_elem(&flowsnoop_config, &idx);
+  if (val)
+    return *val;
+  return 0;
+}
+
+/*
+ * Tells if the packet is counted: 1 in CONFIG_SAMPLE_RATE of them,
+ * picked at random or every Nth on this CPU. The Go side scales the
+ * counts back up.
+ */
+static __always_inline int sampled(void)
+{
+  uint32_t rate = get_config(CONFIG_SAMPLE_RATE);
+  uint32_t zero = 0;
+  uint64_t *seen;
+  if (rate <= 1)
+    return 1;
+  if (get_config(CONFIG_SAMPLE_MODE) == SAMPLE_RANDOM)
+    return bpf_get_prandom_u32() % rate == 0;
+  seen = bpf_map_lookup_elem(&flowsnoop_sample, &zero);
+  if (!seen)
+    return 1;
+  return (*seen)++ % rate == 0;
+}
+
+/*
+ * Marks this CPU as busy on the current generation of the maps and
+ * returns its sequence number, with the counter to decrement when
+ * done in active. The switch is read again after the increment: if it
+ * moved, the Go side may have already seen the counter at zero, so we
+ * move to the new generation. It won't switch again before draining
+ * it.
+ */
+static __always_inline uint32_t enter_gen(uint64_t **active)
+{
+  uint32_t zero = 0;
+  uint32_t seq, gen;
+  uint32_t *sw;
+  uint64_t *cnt;
+  *active = 0;
+  sw = bpf_map_lookup_elem(&flowsnoop_switch, &zero);
+  if (!sw)
+    return 0;
+  seq = *(volatile uint32_t *)sw;
+  gen = seq & 1;
+  cnt = bpf_map_lookup_elem(&flowsnoop_active, &gen);
+  if (!cnt)
+    return seq;
+  __sync_fetch_and_add(cnt, 1);
+  if ((*(volatile uint32_t *)sw & 1) != gen) {
+    __sync_fetch_and_add(cnt, -1);
+    seq = *(volatile uint32_t *)sw;
+    gen = seq & 1;
+    cnt = bpf_map_lookup_elem(&flowsnoop_active, &gen);
+    if (!cnt)
+      return seq;
+    __sync_fetch_and_add(cnt, 1);
+  }
+  *active = cnt;
+  return seq;
+}
+
+/* Adds len bytes to flow key of table, returns -1 if the table is full. */
+static __always_inline int add_flow(void *table, void *key, uint64_t len)
+{
+  uint64_t *oval = bpf_map_lookup_elem(table, key);
+  uint64_t nval = len;
+  if (oval) {
+    __sync_fetch_and_add(oval, len);
+    return 0;
+  }
+  if (bpf_map_update_elem(table, key, &nval, BPF_NOEXIST) == 0)
+    return 0;
+  /* Either another CPU added it or the table is full. */
+  oval = bpf_map_lookup_elem(table, key);
+  if (!oval)
+    return -1;
+  __sync_fetch_and_add(oval, len);
+  return 0;
+}
+
+static __always_inline void add_counter(uint32_t idx, uint64_t n)
+{
+  uint64_t *cnt = bpf_map_lookup_elem(&flowsnoop_overflow, &idx);
+  if (cnt)
+    __sync_fetch_and_add(cnt, n);
+}
+
+/*
+ * Accounts a packet whose flow did not fit in the maps of generation
+ * seq. new_flow tells if it is the first packet of the flow lost in
+ * this generation.
+ */
+static __always_inline void account_overflow(uint32_t seq, uint32_t v6,
+					     uint64_t len, int new_flow)
+{
+  uint32_t idx = (((seq & 1) << 1) | v6) * OVERFLOW_FIELDS;
+  add_counter(idx + OVERFLOW_BYTES, len);
+  add_counter(idx + OVERFLOW_PACKETS, 1);
+  if (new_flow)
+    add_counter(idx + OVERFLOW_FLOWS, 1);
+}
+
+/* Bytes to count for a packet ip_len long, following flow.Accounting. */
+static __always_inline uint64_t account_len(uint32_t mode, uint32_t ip_len,
+					    uint32_t ip_hdr_len, uint32_t l4_hdr_len)
+{
+  if (mode == ACCOUNT_L2)
+    return ip_len + ETH_HLEN;
+  if (mode == ACCOUNT_PAYLOAD) {
+    if (ip_len < ip_hdr_len + l4_hdr_len)
+      return 0;
+    return ip_len - ip_hdr_len - l4_hdr_len;
+  }
+  return ip_len;
+}
+
+/* Where the inner packet of a tunnel starts and the outer header. */
+struct decap_s {
+  uint32_t var_off;
+  uint32_t const_off;
+  /* Protocol of the inner packet, network byte order. */
+  uint16_t proto;
+  /* Length of the outer IP packet. */
+  uint32_t outer_len;
+  struct tunnel_s tun;
+};
+
+/*
+ * Looks for a tunnel in the packet whose IP header, of type proto, is
+ * at var_off + const_off. Returns 1 and fills d if the packet is
+ * VXLAN, Geneve, GRE or IPIP carrying IP, 0 otherwise.
+ */
+static __always_inline int decap(void *ctx, int xdp, uint32_t var_off,
+				 uint32_t const_off, uint16_t proto,
+				 struct decap_s *d)
+{
+  uint8_t next;
+  uint32_t outer_len;
+  if (proto == bpf_htons(ETH_P_IP)) {
+    struct iphdr *iph;
+    ensure_header(ctx, xdp, var_off, const_off, iph);
+    if (iph->version != 4 || ip_is_fragment(iph))
+      return 0;
+    next = iph->protocol;
+    outer_len = bpf_ntohs(iph->tot_len);
+    d->tun.src[10] = d->tun.src[11] = 0xff;
+    d->tun.dst[10] = d->tun.dst[11] = 0xff;
+    __builtin_memcpy(&d->tun.src[12], &iph->saddr, 4);
+    __builtin_memcpy(&d->tun.dst[12], &iph->daddr, 4);
+    var_off += ipv4_hdrlen(iph);
+  } else if (proto == bpf_htons(ETH_P_IPV6)) {
+    struct ipv6hdr *ip6h;
+    ensure_header(ctx, xdp, var_off, const_off, ip6h);
+    if (ip6h->version != 6)
+      return 0;
+    next = ip6h->nexthdr;
+    outer_len = bpf_ntohs(ip6h->payload_len) + sizeof(*ip6h);
+    __builtin_memcpy(d->tun.src, &ip6h->saddr, 16);
+    __builtin_memcpy(d->tun.dst, &ip6h->daddr, 16);
+    const_off += sizeof(*ip6h);
+  } else {
+    return 0;
+  }
+  switch (next) {
+  case IPPROTO_UDP: {
+    struct udphdr *udp;
+    ensure_header(ctx, xdp, var_off, const_off, udp);
+    const_off += sizeof(*udp);
+    if (udp->dest == bpf_htons(VXLAN_PORT)) {
+      struct vxlan_hdr *vx;
+      ensure_header(ctx, xdp, var_off, const_off, vx);
+      d->tun.type = TUNNEL_VXLAN;
+      d->tun.id = bpf_ntohl(vx->vni) >> 8;
+      proto = bpf_htons(ETH_P_TEB);
+      const_off += sizeof(*vx);
+    } else if (udp->dest == bpf_htons(GENEVE_PORT)) {
+      struct geneve_hdr *gn;
+      ensure_header(ctx, xdp, var_off, const_off, gn);
+      d->tun.type = TUNNEL_GENEVE;
+      d->tun.id = bpf_ntohl(gn->vni) >> 8;
+      proto = gn->protocol;
+      const_off += sizeof(*gn);
+      var_off += (gn->ver_opt_len & 0x3f) * 4;
+    } else {
+      return 0;
+    }
+    break;
+  }
+  case IPPROTO_GRE: {
+    struct gre_hdr *gre;
+    uint16_t flags;
+    ensure_header(ctx, xdp, var_off, const_off, gre);
+    flags = bpf_ntohs(gre->flags);
+    /* Version 1 is PPTP, not a tunnel of IP. */
+    if (flags & GRE_VERSION)
+      return 0;
+    d->tun.type = TUNNEL_GRE;
+    proto = gre->protocol;
+    const_off += sizeof(*gre);
+    if (flags & GRE_CSUM)
+      var_off += 4;
+    if (flags & GRE_KEY) {
+      __be32 *key;
+      ensure_header(ctx, xdp, var_off, const_off, key);
+      d->tun.id = bpf_ntohl(*key);
+      var_off += 4;
+    }
+    if (flags & GRE_SEQ)
+      var_off += 4;
+    break;
+  }
+  case IPPROTO_IPIP:
+    d->tun.type = TUNNEL_IPIP;
+    proto = bpf_htons(ETH_P_IP);
+    break;
+  case IPPROTO_IPV6:
+    d->tun.type = TUNNEL_IPIP;
+    proto = bpf_htons(ETH_P_IPV6);
+    break;
+  default:
+    return 0;
+  }
+  if (proto == bpf_htons(ETH_P_TEB)) {
+    struct ethhdr *eth;
+    ensure_header(ctx, xdp, var_off, const_off, eth);
+    proto = eth->h_proto;
+    const_off += ETH_HLEN;
+  }
+  if (proto != bpf_htons(ETH_P_IP) && proto != bpf_htons(ETH_P_IPV6))
+    return 0;
+  d->var_off = var_off;
+  d->const_off = const_off;
+  d->proto = proto;
+  d->outer_len = outer_len;
+  return 1;
+}
+
+/*
+ * Accounts the packet in ctx to generation seq. ctx is a struct
+ * xdp_md if xdp is set, a struct __sk_buff otherwise. If l2 is zero
+ * the interface has no Ethernet header, like tun, WireGuard or ppp
+ * ones, and the packet starts with the network header.
+ */
+static __always_inline int account_data(void *ctx, int xdp, uint32_t seq,
+					int l2)
 {
   struct ethhdr *eth;
   struct udphdr *udp;
+  struct tcphdr *tcp;
   uint32_t hdrlen, var_off, const_off;
-  uint32_t *sw;
-  uint32_t zero = 0;
-  uint64_t *oval = 0;
-  uint64_t len = skb->len - sizeof(struct ethhdr);
+  uint32_t mode;
+  uint32_t l4_hdr_len = 0;
+  uint32_t gen = seq & 1;
+  uint8_t one = 1;
+  uint64_t len;
+  uint16_t proto, vlan = 0;
+  struct decap_s d = {};
+  /*
+   * For tunneled packets, the outer IP packet is counted and the
+   * headers before the inner IP header are outer_hdr bytes long.
+   */
+  uint32_t outer_len = 0, outer_hdr = 0;
+  int tunneled = 0;
+  int i;
   var_off = 0;
   const_off = 0;
-  sw = bpf_map_lookup_elem(&flowsnoop_switch, &zero);
-  ensure_header(skb, var_off, const_off, eth);
-  if (eth->h_proto == bpf_htons(ETH_P_IP)) {
-    struct iphdr *iph;
+  mode = get_config(CONFIG_ACCOUNTING);
+  /*
+   * Flows are accounted to the innermost VLAN. An offloaded tag is
+   * not in the packet anymore, so it is the outermost one.
+   */
+  if (!xdp && ((struct __sk_buff *)ctx)->vlan_present)
+    vlan = ((struct __sk_buff *)ctx)->vlan_tci & VLAN_VID_MASK;
+  if (l2) {
+    ensure_header(ctx, xdp, var_off, const_off, eth);
+    proto = eth->h_proto;
     const_off += ETH_HLEN;
-    ensure_header(skb, var_off, const_off, iph);
+#pragma unroll
+    for (i = 0; i < MAX_VLAN_TAGS; i++) {
+      struct vlan_hdr *vh;
+      if (proto != bpf_htons(ETH_P_8021Q) && proto != bpf_htons(ETH_P_8021AD))
+	break;
+      ensure_header(ctx, xdp, var_off, const_off, vh);
+      vlan = bpf_ntohs(vh->h_vlan_TCI) & VLAN_VID_MASK;
+      proto = vh->h_vlan_encapsulated_proto;
+      const_off += sizeof(*vh);
+    }
+  } else {
+    proto = ((struct __sk_buff *)ctx)->protocol;
+  }
+  if (get_config(CONFIG_DECAP) && decap(ctx, xdp, var_off, const_off, proto, &d)) {
+    tunneled = 1;
+    outer_len = d.outer_len;
+    outer_hdr = d.var_off - var_off + d.const_off - const_off;
+    var_off = d.var_off;
+    const_off = d.const_off;
+    proto = d.proto;
+  }
+  if (proto == bpf_htons(ETH_P_IP)) {
+    struct iphdr *iph;
+    ensure_header(ctx, xdp, var_off, const_off, iph);
     if (iph->version == 4) {
       struct conn_s conn = {};
-      struct bpf_elf_map *conn_table = &flowsnoop_4_0;
+      struct frag4_s frag = {};
+      void *conn_table;
+      uint32_t ip_len = bpf_ntohs(iph->tot_len);
+      int fragment = ip_is_fragment(iph);
+      int first = (iph->frag_off & bpf_htons(IP_OFFSET)) == 0;
       conn.src_ip = iph->saddr;
       conn.dst_ip = iph->daddr;
       conn.protocol = iph->protocol;
-      if ((conn.protocol == 6 || conn.protocol == 17) && !ip_is_fragment(iph)) {
-	hdrlen = ipv4_hdrlen(iph);
+      conn.vlan = vlan;
+      if (tunneled) {
+	conn.tun = d.tun;
+	ip_len = outer_len;
+      }
+      frag.src_ip = iph->saddr;
+      frag.dst_ip = iph->daddr;
+      frag.id = iph->id;
+      frag.protocol = iph->protocol;
+      hdrlen = ipv4_hdrlen(iph);
+      if (conn.protocol == 6 && first) {
 	var_off += hdrlen;
-	ensure_header(skb, var_off, const_off, udp);
+	ensure_header(ctx, xdp, var_off, const_off, tcp);
+	conn.src_port = tcp->source;
+	conn.dst_port = tcp->dest;
+	l4_hdr_len = tcp->doff * 4;
+      } else if (conn.protocol == 17 && first) {
+	var_off += hdrlen;
+	ensure_header(ctx, xdp, var_off, const_off, udp);
 	conn.src_port = udp->source;
 	conn.dst_port = udp->dest;
+	l4_hdr_len = sizeof(*udp);
+      } else if (conn.protocol == 1 && first) {
+	struct icmp_s *icmp;
+	var_off += hdrlen;
+	ensure_header(ctx, xdp, var_off, const_off, icmp);
+	/* Like NetFlow, type and code as destination port. */
+	conn.dst_port = bpf_htons((icmp->type << 8) | icmp->code);
       }
-      if (sw && *sw == 1) {
-	conn_table = &flowsnoop_4_1;
+      /*
+       * Only the first fragment has the ports, the other ones take
+       * them from flowsnoop_frag4.
+       */
+      if (fragment &&
+	  (conn.protocol == 6 || conn.protocol == 17 || conn.protocol == 1)) {
+	if (first) {
+	  struct ports_s ports = {conn.src_port, conn.dst_port};
+	  bpf_map_update_elem(&flowsnoop_frag4, &frag, &ports, BPF_ANY);
+	} else {
+	  struct ports_s *ports = bpf_map_lookup_elem(&flowsnoop_frag4, &frag);
+	  if (ports) {
+	    conn.src_port = ports->src_port;
+	    conn.dst_port = ports->dst_port;
+	  } else {
+	    uint64_t *cnt = bpf_map_lookup_elem(&flowsnoop_unmatched, &gen);
+	    if (cnt)
+	      __sync_fetch_and_add(cnt, 1);
+	  }
+	}
       }
-      oval = bpf_map_lookup_elem(conn_table, &conn);
-      if (oval) {
-	__sync_fetch_and_add(oval, len);
-      } else {
-	uint64_t nval = len;
-        if (bpf_map_update_elem(conn_table, &conn, &nval, BPF_NOEXIST) == -1) {
-          oval = bpf_map_lookup_elem(conn_table, &conn);
-          if (oval)
-            __sync_fetch_and_add(oval, len);
-        }
+      len = account_len(mode, ip_len, outer_hdr + hdrlen, l4_hdr_len);
+      conn_table = bpf_map_lookup_elem(&flowsnoop_4, &gen);
+      if (!conn_table)
+	return TC_ACT_OK;
+      if (add_flow(conn_table, &conn, len) != 0) {
+	struct lost4_s lost = {};
+	lost.conn = conn;
+	lost.seq = seq;
+	account_overflow(seq, 0, len,
+			 bpf_map_update_elem(&flowsnoop_lost4, &lost, &one,
+					     BPF_NOEXIST) == 0);
       }
     }
-  } else if (eth->h_proto == bpf_htons(ETH_P_IPV6)) {
+  } else if (proto == bpf_htons(ETH_P_IPV6)) {
     struct ipv6hdr *iph;
-    const_off += ETH_HLEN;
-    ensure_header(skb, var_off, const_off, iph);
+    ensure_header(ctx, xdp, var_off, const_off, iph);
     if (iph->version == 6) {
       struct conn6_s conn = {};
-      struct bpf_elf_map *conn_table = &flowsnoop_6_0;
-      ensure_header(skb, var_off, const_off, iph);
-      /* TODO: check this, it is not correct in all cases. */
-      conn.protocol = iph->nexthdr;
+      void *conn_table;
+      uint32_t ip_len, ext_len = 0;
+      uint8_t nexthdr;
+      int frag = 0;
+      ip_len = bpf_ntohs(iph->payload_len) + sizeof(*iph);
+      nexthdr = iph->nexthdr;
       conn.src_ip = iph->saddr;
       conn.dst_ip = iph->daddr;
-      if (conn.protocol == 6 || conn.protocol == 17) {
-        const_off += sizeof(*iph);
-        ensure_header(skb, var_off, const_off, udp);
+      conn.vlan = vlan;
+      if (tunneled) {
+        conn.tun = d.tun;
+        ip_len = outer_len;
+      }
+      const_off += sizeof(*iph);
+      /*
+       * Skip the extension headers to find the upper layer protocol.
+       * Only IPv4 fragments get the ports of their first fragment,
+       * from flowsnoop_frag4: all the IPv6 ones are accounted
+       * without ports.
+       */
+#pragma unroll
+      for (i = 0; i < MAX_EXT_HDRS; i++) {
+        struct ipv6_opt_hdr *opt;
+        uint32_t opt_len;
+        if (nexthdr != NEXTHDR_HOP && nexthdr != NEXTHDR_ROUTING &&
+            nexthdr != NEXTHDR_DEST && nexthdr != NEXTHDR_AUTH &&
+            nexthdr != NEXTHDR_FRAGMENT)
+          break;
+        ensure_header(ctx, xdp, var_off, const_off, opt);
+        if (nexthdr == NEXTHDR_FRAGMENT) {
+          frag = 1;
+          opt_len = 8;
+        } else if (nexthdr == NEXTHDR_AUTH) {
+          opt_len = (opt->hdrlen + 2) * 4;
+        } else {
+          opt_len = (opt->hdrlen + 1) * 8;
+        }
+        nexthdr = opt->nexthdr;
+        var_off += opt_len;
+        ext_len += opt_len;
+        if (frag)
+          break;
+      }
+      conn.protocol = nexthdr;
+      if (conn.protocol == 6 && !frag) {
+        ensure_header(ctx, xdp, var_off, const_off, tcp);
+        conn.src_port = tcp->source;
+        conn.dst_port = tcp->dest;
+        l4_hdr_len = tcp->doff * 4;
+      } else if (conn.protocol == 17 && !frag) {
+        ensure_header(ctx, xdp, var_off, const_off, udp);
         conn.src_port = udp->source;
         conn.dst_port = udp->dest;
+        l4_hdr_len = sizeof(*udp);
+      } else if (conn.protocol == 58 && !frag) {
+        struct icmp_s *icmp;
+        ensure_header(ctx, xdp, var_off, const_off, icmp);
+        conn.dst_port = bpf_htons((icmp->type << 8) | icmp->code);
       }
-      if (sw && *sw == 1) {
-        conn_table = &flowsnoop_6_1;
-      }
-      oval = bpf_map_lookup_elem(conn_table, &conn);
-      if (oval) {
-        __sync_fetch_and_add(oval, len);
-      } else {
-        uint64_t nval = len;
-        if (bpf_map_update_elem(conn_table, &conn, &nval, BPF_NOEXIST) == -1) {
-          oval = bpf_map_lookup_elem(conn_table, &conn);
-          if (oval)
-            __sync_fetch_and_add(oval, len);
-        }
+      len = account_len(mode, ip_len, outer_hdr + sizeof(*iph) + ext_len,
+			l4_hdr_len);
+      conn_table = bpf_map_lookup_elem(&flowsnoop_6, &gen);
+      if (!conn_table)
+        return TC_ACT_OK;
+      if (add_flow(conn_table, &conn, len) != 0) {
+        struct lost6_s lost = {};
+        lost.conn = conn;
+        lost.seq = seq;
+        account_overflow(seq, 1, len,
+                         bpf_map_update_elem(&flowsnoop_lost6, &lost, &one,
+                                             BPF_NOEXIST) == 0);
       }
     }
   }
   return TC_ACT_OK;
 }
 
+/*
+ * Accounts the packet in ctx, if sampled, marking the generation of
+ * the maps in use as busy meanwhile.
+ */
+static __always_inline void account(void *ctx, int xdp, int l2)
+{
+  uint64_t *active;
+  uint32_t seq;
+  if (!sampled())
+    return;
+  seq = enter_gen(&active);
+  account_data(ctx, xdp, seq, l2);
+  if (active)
+    __sync_fetch_and_add(active, -1);
+}
+
+/*
+ * The tc programs return TC_ACT_UNSPEC so that the filters of other
+ * programs after ours still run.
+ */
 SEC("ingress")
 int tc_ingress(struct __sk_buff *skb)
 {
-    return account_data(skb);
+    account(skb, 0, 1);
+    return TC_ACT_UNSPEC;
 }
 
 SEC("egress")
 int tc_egress(struct __sk_buff *skb)
 {
-    return account_data(skb);
+    account(skb, 0, 1);
+    return TC_ACT_UNSPEC;
+}
+
+/* For interfaces without Ethernet header. */
+SEC("ingress_l3")
+int tc_ingress_l3(struct __sk_buff *skb)
+{
+    account(skb, 0, 0);
+    return TC_ACT_UNSPEC;
+}
+
+SEC("egress_l3")
+int tc_egress_l3(struct __sk_buff *skb)
+{
+    account(skb, 0, 0);
+    return TC_ACT_UNSPEC;
+}
+
+/*
+ * For the xdp producer, sees only received packets. VLAN tags
+ * stripped by the hardware are not visible here.
+ */
+SEC("xdp")
+int xdp_ingress(struct xdp_md *ctx)
+{
+    account(ctx, 1, 1);
+    return XDP_PASS;
 }
 
 char __license[] SEC("license") = "GPL";
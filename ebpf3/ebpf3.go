@@ -24,243 +24,376 @@ package ebpf3
 import (
 	"context"
 	"encoding/binary"
-	"errors"
 	"flag"
 	"fmt"
-	"io"
 	"io/ioutil"
+	"log"
 	"net/http"
-	"os"
-	"os/exec"
-	"path/filepath"
-	"strings"
+	"runtime"
 	"time"
 
 	"github.com/chripell/flowsnoop/flow"
+	"github.com/chripell/flowsnoop/ifaces"
 	"github.com/dropbox/goebpf"
-	"gopkg.in/restruct.v1"
 )
 
+// hook attaches the programs to interfaces: tc filters for ebpf3,
+// XDP for the xdp producer.
+type hook interface {
+	// programs returns the sections of the programs to load, with
+	// their type.
+	programs() map[string]goebpf.ProgramType
+	// attach attaches the programs of obj to l. It returns nil if
+	// l is skipped.
+	attach(l ifaces.Link, obj *object) (attachment, error)
+}
+
+// attachment is the programs attached to an interface.
+type attachment interface {
+	detach() error
+}
+
+// Ebpf3 is the ebpf3 producer, or the xdp one: they differ in the
+// hook the programs are attached to and share everything else.
 type Ebpf3 struct {
+	// name is the name of the producer, used in logs.
+	name     string
+	iface    *string
+	newHook  func() (hook, error)
+	hook     hook
 	consumer flow.Consumer
 	finished chan struct{}
-	ifaces   []string
+	tracker  *ifaces.Tracker
+	attached map[int]attachment
 
-	sw    *goebpf.EbpfMap
-	ipv4a *goebpf.EbpfMap
-	ipv4b *goebpf.EbpfMap
-	ipv6a *goebpf.EbpfMap
-	ipv6b *goebpf.EbpfMap
-	curr  bool
+	obj      *object
+	sw       *goebpf.EbpfMap
+	active   *goebpf.EbpfMap
+	config   *goebpf.EbpfMap
+	overflow *goebpf.EbpfMap
+	// Fragments not matched, per generation.
+	unmatched *goebpf.EbpfMap
+	maps4     *flowMaps
+	maps6     *flowMaps
+	// Sequence number of the generation in use, bit 0 selects
+	// the maps.
+	seq       uint32
+	overflowR flow.OverflowReader
+	// Last value read from unmatched, the counters never reset.
+	lastUnmatched [2]uint64
+	noBatch       bool
+	noGrow        bool
+	sampling      flow.Sampling
+	ingressOnly   bool
 }
 
+// Programs run to completion in microseconds, if a generation is busy
+// for longer something is wrong with the counters.
+const drainTimeout = time.Second
+
+// Indexes in flowsnoop_config, keep in sync with CONFIG_* in the eBPF
+// program.
+const (
+	configAccounting = 0
+	configDecap      = 1
+	configSampleRate = 2
+	configSampleMode = 3
+)
+
+// Keep the default in sync with BUCKETS in the eBPF program.
+const BUCKETS = 10240
+
 var (
+	buckets    = flag.Int("ebpf3_buckets", BUCKETS, "buckets for in-kernel tables.")
+	maxBuckets = flag.Int("ebpf3_max_buckets", 0,
+		"if larger than ebpf3_buckets, double the tables that overflow up to this size.")
 	iface = flag.String("ebpf3_iface", "",
-		"Interfaces on which should listed (comma separated).")
-	ebpfs = flag.String("ebpf3_ebpfs", "/sys/fs/bpf/tc/globals",
-		"Path to ebpfs, where pinned maps are available..")
+		"Interfaces on which should listen: comma separated names or glob patterns, ! excludes.")
+	prio = flag.Uint("ebpf3_prio", 1,
+		"tc priority of the filters, lower runs first. Other filters at the same priority are kept.")
 )
 
-func run(warn bool, prog string, args ...string) (err error) {
-	cmd := exec.Command(prog, args...)
-	if err = cmd.Run(); err != nil {
-		err = fmt.Errorf("%s: %s failed: %v", cmd.Path, strings.Join(cmd.Args, " "), err)
-		if warn {
+func (ebpf *Ebpf3) cleanup(warn bool) {
+	if ebpf.tracker != nil {
+		ebpf.tracker.Stop()
+	}
+	for idx, a := range ebpf.attached {
+		if err := a.detach(); warn && err != nil {
 			fmt.Println(err)
 		}
+		delete(ebpf.attached, idx)
 	}
-	return err
 }
 
-func openMap(name string) (*goebpf.EbpfMap, error) {
-	fpath := filepath.Join(*ebpfs, name)
-	m, err := goebpf.NewMapFromExistingMapByPath(fpath)
-	if err != nil {
-		return nil, fmt.Errorf("cannot open %s: %w", fpath, err)
+// addLink attaches the programs to l, called by the tracker.
+func (ebpf *Ebpf3) addLink(l ifaces.Link) error {
+	a, err := ebpf.hook.attach(l, ebpf.obj)
+	if err != nil || a == nil {
+		return err
 	}
-	return m, nil
+	ebpf.attached[l.Index] = a
+	return nil
 }
 
-func (ebpf *Ebpf3) cleanup(warn bool) {
-	for _, fname := range []string{
-		"flowsnoop_switch",
-		"flowsnoop_4_0",
-		"flowsnoop_4_1",
-		"flowsnoop_6_0",
-		"flowsnoop_6_1",
-	} {
-		if err := os.Remove(filepath.Join(*ebpfs, fname)); warn && err != nil {
-			fmt.Printf("failed to delete %s: %v", fname, err)
-		}
+// delLink detaches the programs from l, unless it is gone together
+// with them.
+func (ebpf *Ebpf3) delLink(l ifaces.Link, gone bool) {
+	a, ok := ebpf.attached[l.Index]
+	if !ok {
+		return
+	}
+	delete(ebpf.attached, l.Index)
+	if gone {
+		return
 	}
-	for _, iface := range ebpf.ifaces {
-		run(warn, "tc", "filter", "del", "dev", iface, "ingress")
-		run(warn, "tc", "filter", "del", "dev", iface, "egress")
-		run(warn, "tc", "qdisc", "del", "dev", iface, "clsact")
+	if err := a.detach(); err != nil {
+		log.Printf("%s: %v", ebpf.name, err)
 	}
 }
 
+// getMap returns the map name of the loaded object.
+func (ebpf *Ebpf3) getMap(name string) (*goebpf.EbpfMap, error) {
+	m, ok := ebpf.obj.maps[name]
+	if !ok {
+		return nil, fmt.Errorf("no map %s in ebpf object", name)
+	}
+	return m, nil
+}
+
 func (ebpf *Ebpf3) Init(consumer flow.Consumer) error {
-	if *iface == "" {
-		return errors.New("no interfaces specified")
+	matcher, err := ifaces.Parse(*ebpf.iface)
+	if err != nil {
+		return err
 	}
-	ebpf.ifaces = strings.Split(*iface, ",")
-	for i, s := range ebpf.ifaces {
-		ebpf.ifaces[i] = strings.TrimSpace(s)
+	if ebpf.hook, err = ebpf.newHook(); err != nil {
+		return err
 	}
-	ebpf.cleanup(false)
-	var err error
 	defer func() {
 		if err != nil {
 			ebpf.cleanup(false)
+			ebpf.close()
 		}
 	}()
 	ebpf.consumer = consumer
 	var (
-		fin     http.File
-		objFile *os.File
+		fin        http.File
+		obj        []byte
+		accounting flow.Accounting
+		outer4     *goebpf.EbpfMap
+		outer6     *goebpf.EbpfMap
 	)
+	accounting, err = flow.AccountingMode()
+	if err != nil {
+		return err
+	}
+	if ebpf.sampling, err = flow.SamplingMode(); err != nil {
+		return err
+	}
 	fin, err = _escStatic.Open("/c/flowsnoop3.o")
 	if err != nil {
 		return fmt.Errorf("cannot open ebpf object: %w", err)
 	}
 	defer fin.Close()
-	objFile, err = ioutil.TempFile("", "flowsnoop3.*.o")
+	obj, err = ioutil.ReadAll(fin)
 	if err != nil {
-		return fmt.Errorf("cannot create temp object file: %w", err)
+		return fmt.Errorf("cannot read ebpf object: %w", err)
 	}
-	objFname := objFile.Name()
-	defer os.Remove(objFname)
-	if _, err = io.Copy(objFile, fin); err != nil {
-		return err
-	}
-	if err = objFile.Close(); err != nil {
+	// The kernel checks that the maps installed in the outer maps
+	// match the templates, max_elem included before Linux 5.10.
+	ebpf.obj, err = loadObject(obj, ebpf.hook.programs(), map[string]int{
+		"flowsnoop_4_inner": *buckets,
+		"flowsnoop_6_inner": *buckets,
+	})
+	if err != nil {
 		return err
 	}
-	for _, iface := range ebpf.ifaces {
-		err = run(false, "tc", "qdisc", "add", "dev", iface, "clsact")
-		if err != nil {
+	for _, m := range []struct {
+		name string
+		m    **goebpf.EbpfMap
+	}{
+		{"flowsnoop_switch", &ebpf.sw},
+		{"flowsnoop_active", &ebpf.active},
+		{"flowsnoop_config", &ebpf.config},
+		{"flowsnoop_overflow", &ebpf.overflow},
+		{"flowsnoop_unmatched", &ebpf.unmatched},
+		{"flowsnoop_4", &outer4},
+		{"flowsnoop_6", &outer6},
+	} {
+		if *m.m, err = ebpf.getMap(m.name); err != nil {
 			return err
 		}
-		err = run(false, "tc", "filter", "add", "dev", iface, "ingress",
-			"bpf", "da", "obj", objFname, "sec", "ingress")
-		if err != nil {
-			return err
+	}
+	if err = ebpf.config.Upsert(configAccounting, uint32(accounting)); err != nil {
+		return fmt.Errorf("cannot set accounting mode: %w", err)
+	}
+	if flow.Decap() {
+		if err = ebpf.config.Upsert(configDecap, uint32(1)); err != nil {
+			return fmt.Errorf("cannot enable decapsulation: %w", err)
 		}
-		err = run(false, "tc", "filter", "add", "dev", iface, "egress",
-			"bpf", "da", "obj", objFname, "sec", "egress")
-		if err != nil {
-			return err
+	}
+	if ebpf.sampling.Enabled() {
+		if err = ebpf.config.Upsert(configSampleRate, ebpf.sampling.Rate); err != nil {
+			return fmt.Errorf("cannot set sampling rate: %w", err)
+		}
+		if err = ebpf.config.Upsert(configSampleMode, uint32(ebpf.sampling.Mode)); err != nil {
+			return fmt.Errorf("cannot set sampling mode: %w", err)
 		}
 	}
-	ebpf.sw, err = openMap("flowsnoop_switch")
+	ebpf.maps4, err = newFlowMaps(outer4, "flowsnoop_4", key4Len, *buckets)
 	if err != nil {
 		return err
 	}
-	ebpf.ipv4a, err = openMap("flowsnoop_4_0")
+	ebpf.maps6, err = newFlowMaps(outer6, "flowsnoop_6", key6Len, *buckets)
 	if err != nil {
 		return err
 	}
-	ebpf.ipv4b, err = openMap("flowsnoop_4_1")
-	if err != nil {
+	ebpf.tracker = ifaces.NewTracker(ebpf.name, matcher, ebpf.addLink, ebpf.delLink)
+	if err = ebpf.tracker.Start(); err != nil {
 		return err
 	}
-	ebpf.ipv6a, err = openMap("flowsnoop_6_0")
-	if err != nil {
-		return err
+	return nil
+}
+
+// close releases the maps and programs, the latter stay in the kernel
+// while attached.
+func (ebpf *Ebpf3) close() {
+	if ebpf.maps4 != nil {
+		ebpf.maps4.Close()
 	}
-	ebpf.ipv6b, err = openMap("flowsnoop_6_1")
-	if err != nil {
-		return err
+	if ebpf.maps6 != nil {
+		ebpf.maps6.Close()
+	}
+	if ebpf.obj != nil {
+		ebpf.obj.Close()
+	}
+}
+
+// waitDrained waits for all the eBPF programs still accounting into
+// generation gen of the maps to finish. Programs run to completion
+// without sleeping, so this is usually immediate.
+func (ebpf *Ebpf3) waitDrained(gen uint32) error {
+	start := time.Now()
+	for spins := 0; ; spins++ {
+		busy, err := ebpf.active.LookupUint64(gen)
+		if err != nil {
+			return fmt.Errorf("cannot read active counters: %w", err)
+		}
+		if busy == 0 {
+			return nil
+		}
+		if time.Since(start) > drainTimeout {
+			return fmt.Errorf("generation %d still busy after %v", gen, drainTimeout)
+		}
+		if spins < 100 {
+			runtime.Gosched()
+		} else {
+			time.Sleep(10 * time.Microsecond)
+		}
+	}
+}
+
+// drain calls each for all the entries of m, deleting them. It uses
+// batch operations unless the kernel turned out not to support them.
+func (ebpf *Ebpf3) drain(m *goebpf.EbpfMap, b *batch,
+	each func(key []byte, tot uint64) error) error {
+	if !ebpf.noBatch {
+		err := b.drain(each)
+		if err != errBatchUnsupported {
+			return err
+		}
+		ebpf.noBatch = true
+	}
+	return drainLoop(m, each)
+}
+
+// grow enlarges the maps of generation gen that overflowed, they
+// will be used from the next switch to gen.
+func (ebpf *Ebpf3) grow(gen uint32, stats flow.Stats) {
+	if ebpf.noGrow || *maxBuckets <= *buckets {
+		return
+	}
+	for _, g := range []struct {
+		fm       *flowMaps
+		overflow flow.Overflow
+	}{
+		{ebpf.maps4, stats.Overflow4},
+		{ebpf.maps6, stats.Overflow6},
+	} {
+		if g.overflow.Packets == 0 {
+			continue
+		}
+		if err := g.fm.grow(gen, *maxBuckets); err != nil {
+			log.Printf("%s: cannot grow maps, keeping their size: %v", ebpf.name, err)
+			ebpf.noGrow = true
+			return
+		}
 	}
-	return nil
 }
 
 func (ebpf *Ebpf3) updateMaps() error {
 	var (
-		next   uint32
 		rm4    *goebpf.EbpfMap
+		b4     *batch
 		flows4 []flow.Sample4L
-		keys4  [][]byte
 		rm6    *goebpf.EbpfMap
+		b6     *batch
 		flows6 []flow.Sample6L
-		keys6  [][]byte
 	)
-	if ebpf.curr {
-		rm4 = ebpf.ipv4b
-		rm6 = ebpf.ipv6b
-		next = 0
-	} else {
-		rm4 = ebpf.ipv4a
-		rm6 = ebpf.ipv6a
-		next = 1
-	}
-	if err := ebpf.sw.Upsert(0, next); err != nil {
+	prev := ebpf.seq & 1
+	rm4, b4 = ebpf.maps4.inner[prev], ebpf.maps4.batch[prev]
+	rm6, b6 = ebpf.maps6.inner[prev], ebpf.maps6.batch[prev]
+	if err := ebpf.sw.Upsert(0, ebpf.seq+1); err != nil {
 		return fmt.Errorf("map switch failed: %w", err)
 	}
 	tick := time.Now()
-	// Give time for eBPF update to finish on the
-	// current map. This looks *plenty* of time.
-	time.Sleep(10 * time.Millisecond)
-	ebpf.curr = !ebpf.curr
+	ebpf.seq++
+	if err := ebpf.waitDrained(prev); err != nil {
+		return err
+	}
+	stats, err := ebpf.overflowR.Read(prev, func(idx uint32) (uint64, error) {
+		return ebpf.overflow.LookupUint64(idx)
+	})
+	if err != nil {
+		return err
+	}
+	unmatched, err := ebpf.unmatched.LookupUint64(prev)
+	if err != nil {
+		return fmt.Errorf("cannot read unmatched fragments: %w", err)
+	}
+	stats.UnmatchedFrags = unmatched - ebpf.lastUnmatched[prev]
+	ebpf.lastUnmatched[prev] = unmatched
 	// Handle IPv4 maps.
-	k4 := make([]byte, 13)
-	for {
-		nk, err := rm4.GetNextKey(k4)
-		if err != nil {
-			break
-		}
-		data, err := rm4.Lookup(nk)
-		if err != nil {
-			return fmt.Errorf("ipv4 table lookup failed: %w", err)
-		}
+	if err := ebpf.drain(rm4, b4, func(k []byte, tot uint64) error {
 		var fl flow.Sample4
-		if err := restruct.Unpack(nk, binary.BigEndian, &fl); err != nil {
-			return fmt.Errorf("unpacking of ipv4 flow failed: %w", err)
-		}
-		flows4 = append(flows4, flow.Sample4L{
-			Flow: fl,
-			Tot:  binary.LittleEndian.Uint64(data),
-		})
-		keys4 = append(keys4, nk)
-		k4 = nk
-	}
-	for _, k := range keys4 {
-		if err := rm4.Delete(k); err != nil {
-			return fmt.Errorf("deleting of ipv4 flow failed: %w", err)
+		if err := fl.Unpack(k); err != nil {
+			return err
 		}
+		fl.VLAN = binary.LittleEndian.Uint16(k[vlan4Off:])
+		fl.Tunnel = unpackTunnel(k[tun4Off:])
+		flows4 = append(flows4, flow.Sample4L{Flow: fl, Tot: tot})
+		return nil
+	}); err != nil {
+		return fmt.Errorf("ipv4 map: %w", err)
 	}
-	keys4 = nil
 	// Handle IPv6 maps.
-	k6 := make([]byte, 37)
-	for {
-		nk, err := rm6.GetNextKey(k6)
-		if err != nil {
-			break
-		}
-		data, err := rm6.Lookup(nk)
-		if err != nil {
-			return fmt.Errorf("ipv6 table lookup failed: %w", err)
-		}
+	if err := ebpf.drain(rm6, b6, func(k []byte, tot uint64) error {
 		var fl flow.Sample6
-		if err := restruct.Unpack(nk, binary.BigEndian, &fl); err != nil {
-			return fmt.Errorf("unpacking of ipv6 flow failed: %w", err)
-		}
-		flows6 = append(flows6, flow.Sample6L{
-			Flow: fl,
-			Tot:  binary.LittleEndian.Uint64(data),
-		})
-		keys6 = append(keys6, nk)
-		k6 = nk
-	}
-	for _, k := range keys6 {
-		if err := rm6.Delete(k); err != nil {
-			return fmt.Errorf("deleting of ipv6 flow failed: %w", err)
+		if err := fl.Unpack(k); err != nil {
+			return err
 		}
+		fl.VLAN = binary.LittleEndian.Uint16(k[vlan6Off:])
+		fl.Tunnel = unpackTunnel(k[tun6Off:])
+		flows6 = append(flows6, flow.Sample6L{Flow: fl, Tot: tot})
+		return nil
+	}); err != nil {
+		return fmt.Errorf("ipv6 map: %w", err)
 	}
-	keys6 = nil
+	ebpf.grow(prev, stats)
+	ebpf.sampling.Scale(&stats, flows4, flows6)
+	flows4, flows6 = stats.AddOther(flows4, flows6)
+	stats.LogOverflow(ebpf.name)
 	// Push maps.
-	if err := ebpf.consumer.Push(tick, flows4, nil, flows6, nil); err != nil {
+	if err := ebpf.consumer.Push(tick, flows4, nil, flows6, nil, stats); err != nil {
 		return fmt.Errorf("error from consumer: %w\n", err)
 	}
 	return nil
@@ -283,16 +416,30 @@ func (ebpf *Ebpf3) Run(ctx context.Context, flush <-chan (chan<- error)) {
 }
 
 func (ebpf *Ebpf3) Finalize() error {
-	ebpf.sw.Close()
-	ebpf.ipv4a.Close()
-	ebpf.ipv4b.Close()
 	ebpf.cleanup(true)
 	<-ebpf.finished
+	ebpf.close()
 	return nil
 }
 
+// IngressOnly tells if the producer sees only the packets received by
+// the interfaces.
+func (ebpf *Ebpf3) IngressOnly() bool {
+	return ebpf.ingressOnly
+}
+
+// TracksFragments tells that IPv4 fragments are accounted to the
+// ports of their datagram.
+func (ebpf *Ebpf3) TracksFragments() bool {
+	return true
+}
+
 func New() *Ebpf3 {
 	return &Ebpf3{
+		name:     "ebpf3",
+		iface:    iface,
+		newHook:  newTCHook,
 		finished: make(chan struct{}),
+		attached: make(map[int]attachment),
 	}
 }
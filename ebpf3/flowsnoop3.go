@@ -212,30 +212,247 @@ var _escData = map[string]*_escFile{
 	"/c/flowsnoop3.o": {
 		name:    "flowsnoop3.o",
 		local:   "c/flowsnoop3.o",
-		size:    5816,
-		modtime: 1610194797,
+		size:    92280,
+		modtime: 1792382913,
 		compressed: `
-H4sIAAAAAAAC/+xYTWhcVRQ+My+ZJDXN32RiDBjKJG3ToMO8mTAdg4ZQqUYNpAtrtaDPMZlOBye/b0gj
-gdqNQVxI/QVBRCqiiEgQIS6EuHCRRRciXRRUyMJFkC6iCHYReHLv+abvvZN5MoXQ1TxIz/u+e37u/e55
-9w59/fTkE+FQiCpPiP4lF7nP7aj7Po5/76MQbd7P3AaCpvv2HGVzK4w3r7FtChPtOY7TK5K/oWsSbUYY
-55bOsF2c5HjUbTKI2ojosVQj84uoG2Ze5TleQ/zDA98wbib623Gcxe5WjRejh7UtGETNRDT1OMfFjHFa
-+53orPGF5r354gHzidc4n7iez8eo36btOYNol1S9j7TOGwb7r47+zLpG+1jn0S3gfuDrDufhDbFHbzg8
-/xB16ny9pCQuRrvv1HH2FB/j+g9w/UILUViNtxA96plv+3vuvHv0unnDNt9197fHo0PQ+tvfoX069Ggd
-VjUudvE8Xh3m9RY7Oxj3/aJxb5O/f2IRnkcux3jfeCis+0vy3r7cchzHO2/lH6NnNG6kwr75ZgL2PVPj
-vmf0el8M2Pfz9LJv33ccttuwNx1vP6yiDxaxr/boLe6Lbu6TQgPREYVjPRpPPYu8T6N/uuPCr1/4oe+6
-U8IvIfy24XeM1x0bZv9G9p86Db/zN0Vftuu+9OozFqDvWI36jml9TfRTBv20g37Kop/+vMf91IF+epB1
-eGvXqRr3mbuOXU+ejQbOo/Kp7/MsxchR4zXXbTugupEa6/JB/+sV5IFOH1T0qt8b9Xujfm/U7436vVG/
-N+7i3lCjambqTKUhV8ewR9NafYYOwEe9N+AvRNV9njwzSUFPTsf+5Uj+sk5m0Lq49xrAJ8N+/gYxvyD4
-D8F/L/go8nwq6s6Bzzb6+X+QZ0fk+Qn8kOHnv0KeiQY/nwL/puD/QJ4fBf8d+GYxnzXw64J/CfmPRPx8
-HPyQ4D9HnqTQeTnE3S/138NXIfW/Dl7q/zZ4qX8r8kv9L4CX+t9CHqn/D+Cl/teQR+o/DF7q/xvySP2/
-Bi/1vwxe6v8c8kv9+8BL/T+p6Cl0PkdEnfzlcd/Dvh9SvHuIDsJOgB8U/Br4CcGPgi8JPkvMXxX8CSLq
-InexWawjqnljH5/0nCHq3qn0hcIDRHTVg1WNL8V4ZR8UPkpE2x6sbsrbYvxe1zsxNDB49NjxOCXK+ZUy
-JZbypeJcYSlv21Setiqvis7fYfE2m1uw6UJp/pI9Nz+/YNmXiuXpi5QolZZnrdzMzJJdLJBllYrT+Tk7
-7zqmE9OUsMtL5dwrlLBfm1V28tQp00o/omwS1rRMYBP4JMOT8AZMA6cyjNmalglsAqdH4D8Cf+AUsAls
-VsYxzGgEcAQ4DZxOeRTIWKYHjVgmYk3EAqeAU8AmsGn6ciV9uZKom0TdJB3MM6dPzf3PeBfbb8X3LP9P
-MYS/iIwPqCeOJf3b///is2KgWfipE6SlSp3nO9kuAB/COivxHbAPBdRfidRWvz+g/laV+o1V6qdRX+7B
-eqv33gzW74WA+JnD1f3l/j2lYiedeSK64uWzXf71HgqIXxa/kSrPRdTfNdy4uCdfL+x/AQAA///XTNow
-uBYAAA==
+H4sIAAAAAAAC/+x9DXScR3nu8+2PdmXHXkm2bEUhieSfWHGiWLv6tXEaO2mII3KISRA2Lhd77RhbJMSW
+ZWIruhDnh8QYyhEBEuMClX+SKC0toqXXhtt71wFOqwK3R7fArThJqUqh6JwGEOUnTmpr79HO8377zbu7
+0sp2iOn5dE68O+83887M+86888x882weuvWOtwQcB/Ln4BVkU9m/tnuy0jX8txwOUgsAADgZBQIAth2q
+SQNA6hgAAJEAUJNOp6uU0g8DcAB0ZP4FwqgDACQjAACcdAAA2Fa9Pw0A9furXXkwIz/fegIAgKRJosMp
+dfNFAOwAUANgHz9TDgAAN3UBALCCn1eXAQBQiXLqbSvR7dlfVHtKAQA9JolURba8A6C3xpbftIft4OfV
+tdKPuhIAOGnUYduRmdknJXYPZv0JAJWRRQCA5H4AAFIPAwCwwQEcj3+K7bfor8RC158OgBfLAABIdtn6
+k8eon+WurC1zy6XT6bRb7qK3L5i3fdKO3lVV6fz1nUl7y3VXv5IGgHuP/CqNyfSR36QBoPfIGOXjTI8w
+PZo+H/9VZv4FkqUAAJwMwKST8wEAlQ7H/dYqAMCGAJCOAb3Ly0z9h5A2+e9gvvUAgIgDzAVwY0UQAJBK
+ZvXPZf3L8pRLzQMAIBI0+eoXD4cBoKviMmQ+F84BAOyIAFEAdy6T8RbEwQFjv8lyvdXRNAB0RBJ4uED7
+Kgq0r8Lbvp0sl2T7HsnatQJAfcVfhzPpqKm364mg1b6uhwMAgDsXSjsjbjsrPO3s9IyHWBp4yQHKMs/L
+2I/LC/ZjQYF+LPD2Y5vqRyLbjwWZfgRcu1cASLUByMj7wyiiXyfZjmL703tkfELa6XjGfW91/wQAbFs+
+0zj9V247kNEzNmGnR1X6jEoPqPRplR5U6VMqPTThjX9Z+bCRhwAA6Ok39tkQAdLpyfGw1nn8nybn0cgE
+APQ8b+zSEekKRwGkJv8BkAoDABAJA/MB9Bw1duqtPjzhnX87QlyH+BlbCwBA7O2wxs2NFTEAQOpmPr8L
+1vjpOW7a07uK7Tp+hmnzmbqd+Tdk/bAMQOoDlH8K8Pan5+gZfo5Y7Y2ttdcpaUdy23oAQH1ifRgAYg8D
+Jg7AjUPjADoCd4TXAOjdRHu095l6njsz4Y1nvcsH0uazn5+H+dnHz4P8PMBPE/+3LR8247kygMxnWQkA
+IMl4tI35kvPKTHrVf0+bdnJ9PmHG+73tj6cB4KUw58EmI+9e/lgaAFJLAADoecb4syNcSz+Ycdy9yrSv
+67hZB7pXfTRtnptxvW3Vx5g260PvKtOvzuNmnbh31WE+P838n2V6gPr/yOh98AjlI9TTTz2j1DPA54Ms
+9ydMn2L6T5keYj1fYHmuY6s+kgaAfUEgmkkfMv2aXwqvHXueMfNG7CQ4Y3LeXJ1Op5OVtP+qbxs/bRoy
+9cwLIVNP+z+wvcZ/XZUAAOwIAwEAG8LAagCpWsCsY1wvnLsCJQBS3awvCKTTQEfwzoDplxlfsbupL8j5
+xk93XMdB//dPeMe5zL+eATMPbqy4zIrHsZvteZhaCRg7cP7NGpvwzreefpPueebMhLe+1Cft+Zfcynax
+Hul3bK0933pOIA0A9fF/CZn5auwSqwAAoJPl7q0286SzNsD0Iya9yPRnX4B2Dhg79y434zSMRwL54nTS
+sfGH5M+mc+azSp9W6UGVPqXSKl678vzxutK5PQ0AKQ/OzMRvpyUTv7PlxyYuxX50OPF01Isf6O9UILu+
+zgcQq4WRXwsAwI3zwvZ4jKp4/yTlB+znyS2mnpQDwKNX6pV1v3f5Yaudve3Dqn8j6vmQSp9S6UGVPq3S
+A1b6ZNieX6lPU15K+ZHRCTs9Zq3T9XETn2Lsv+jtcttv4oWsVyfdfcCwwg/sp/t8SKVPqfSgSp9W6QGV
+PqPSoypt+tUR+M3EGk8/OjPR0LT/pvTvQvt/4I6ftGe+ecd5GYBk8DKr3q6grNd9E4KjMvErBKwG0OkE
+AHhx7Ijb/7TCj2mFF9MevOjOkzYb14VR7njTvZuiacF7dRbe67PGn8zn1G1w8XlmPeJnrCs//kvdbc/b
+ngFT340Vc21cmLDXo95Vh7kOTY0L3fhQCAf2S3kbv8a6bDyYarbbkcWFc0JT48LS0JaMHRlfXLueTpvP
+U/wc5Cfx4Sbiw03Eh5uIDzcRH24iPtxE3FcxHwCwI8D1n/jlTra3a14J7Wb813l5CF6cKTgyWz5qlRcc
+mqx4k8pXZeVLzqsxn+VLTD7H5LtTcMhx4tBVn09zHFm496VSoAxApBSoy8Q5k7/nqBlvHU4NTJp4tNrY
+qeso8Wj1ceYnHq0+wTTxaLWxb+dR4tHqQT4nHq3+MtPEo9V/mfaOl+7qv2CauLT6FPURl1af5nPi0uqv
+MU1cWv11polLq7/B8sSl1UcNLnWIS6uPMT9xqOxbiRNTHwFAey3P5OP4Pm78vDRYF1zt2dfuoh+qAoAD
+MBEt2+yew8m5Xm0w33yIfULv32YDnvU3BzdGZb2abl7Kvmzcik9y7hFT52O6HfW1nw2a8maexaqy+98A
+gJ5jxh6VgR85jZ55KPo6AqPO9QC6ygHjB7MvORnJ7nejGT3jE97xv4+flYHFwUw9R7lPWCh6rws6nnMo
+kYdRZ9nXxUGe/XWdJ17eOC9i2/Uu276pdym7ftqOq72zoum8eOjthfBQ30ReHBcFXlccpPFp0fhnRdra
+J5Tlx0Eu/tHnItKvi40b9DnPtLhhQXoLgK5oCQCgszRkPgNlAIB7l5u4lQyoeB3U8XqM+VS8Dtrxusup
+sNrXFZxntT8ZnG/1rzNYY9qxapRxeyTttbMX3yzJG89HWM9iy65dziLrfEv2O7JuynlKstY+55L5u935
+F6P/0Ji7zkbz7H8qnZtCIRSxT5pmH1PpOCgHEMbT55DnPLTn+b4Jb5zqOWH6EQkBUc9+t7f9IPffs6aM
+ozn4Ru1/ek4ctOKmrFdu/FbxUo87Oe+O3aLn92iBeW/bo37xA+e8OErOHTrLA7TXF4ILX7hwu3c4necq
+/irbL/ccJK7ONZ6nvTn+pP+uneWc48kZ2vl439R2jhdnZ4nPsU+er73XGXsfOzPhjZc9xw5OePe52XjO
+843ykLt/WEOcUec5l069Hdb4TR0GACCMLwS99u5w6lCe5xyiw1kKeM6l9LzQ51Rir0iU8+Jh8dNc20+P
+KD+d4Llw+8jE+ZxP6XOpnPPguI3/Y4/Y62R94uMBY+9o2mvvTua/l7j6ZACIesa3zIcwVgBTvBeQ8y85
+n+sIXA8HQGpPdh1Lp7P5OwJ152V32W9N2j/wO2X/9+S1f1eFYz7L4e4/Mu/nauGO+zLPOaG2c+pxAAA2
+ALgpnU5vgLFzJa4KAEDXMXNOXhkIogTAbdzHVwZkPbg8AACpjYD3/UpH5CDM/mAR8f4B7hdy3y8NpdPp
+1HGmg/nfN0kc+DCAAIDU5BfzPj5g4u2rzrUXaZ1bASCMr54tap1j/J9sd8jjx95VBda5xEzjb3HrXKrC
+1u/iWzeejk2cV9xNfOasGXeHc+Ks186dlYD3PUvqruy8jeTDgTn4U617gUNnofTggCdOvwMAx6GseyGP
+PnlvlFqbjdeomfTvDeznAb7n2G+dj/RW2/Z1/X7isLU+7IgyvvBT4ojGOzdWlEyJc3rbD9txg/X0Humz
+/Uz9yU69zmZxdXAqfDONnwV/uu8znqFdjhk71X9y49l86634Q+yfZL0vMe72nDDlN4QAlAGVIeWvABDM
+2P+g9V41jMFAvjhR+D7FQw4AdGb+9bwncez3JNm48YgDz/2krg87AIBdlfb+V96zxm4DAKDrUQAAUnsB
+c95Q7czkvCG1V587VDjecyrxd++DYxNTnivmnEvMgvHP2EQ+nCfnhu65RIH9c8/z9jlhzvngQsCKB8sK
+nU84AIDUfjD9uAMPfsqeT/z83PWec4vJ+dzo9ctj9Mt8e37p9+dyPhabr/xUx88Pi79+hBn568PaXy/R
+P6MT+fw27Xlwjt/mAN7+aL8dGrPWhfM9V+oZGMvvz3nKn4tt/K7bK++9xb/ynry+tt3x2kv8kfXzX2T8
+nHoCwFT+bqO/q2ycLHpjH+DnOgAAuiYLA0g9Jv4dmJl/H9P+7Udevz4BnM98TD1SwK9v0HyUuFJfO7/A
+fPzglPNR4kvXkgLzUt17idG+qSogU26xno97LnA+vs+aJ+K33lm2veQ8JbVu6vU7148h2qcvb1zV9+lE
+T2q+8suSaeLkbbIOy/sZnvPV/rORPzM24cVtqbXab285d71nPBWcX/unnl9uHK2UdJ91vtzVbc8bt71y
+Ds/xujTYeoHzcIU1D3s3jV3gfAxb81HwXqyigD/l3sYePq+18X4sbuP33PdkjrXPde10YnzC68/6xHHl
+xzlTzz/G366mqeefiwNq7XnoxsvKqf0n7wG6eV8pFZf90aewKvP5ZZR79tMdzmtnS/LdK51mPZwcf6ut
+fXjUjhtJWOujzOuC8bJQnCy4r+5Gvv1N9lzjcNqkiSOXHzHphbxvE+H76ojpRxjfPwsAMaMesd2A156T
+H3We9xHyvnAfP3uOlaXNvA8AAK42H0gtAzQePjPV/fASAAAqSzbktb++X51K2uV7H6wxfsUcIF9+tV+X
+8idDgOPZp1fitvzlkxq/fwMAEMYaFx9458/JMJC57+MAIc/9pTC2OTOyy+QXAJUIuPV474vvKKWd+FlZ
+WoK877lKY8j/nov3yrZqfDpqxZuTETuu5IwDvjeuj3McyLrZer7jYMEbPA5KihwHtwMAXtwtcayK508B
+m0dQAQBAFUwaAADg0wCsdWLG6/0sI//U1PHnvHHbwyoePanj0b+k88Wj4s/5THwKY91ZnEc89p6PNryh
+cfl0uqi4XC77+yPu++uoed9sziuO8n5J9Xq+L+U9k+p1TB9meg3TvJ9R3cY0759UNzDNeyjVdUzvZ9rM
+k57nQT7Ixrz36L3z5ZUC55zeeZMqycYlB8CGUiNfVgoEPedR246czanvbBH8E5ln8l7xNpcXM1N9+eNp
+R8kT1nn7tkMz1fsQx/NNjKO8R1S9U+6ZML1F7qEwvTH9hvh/gP4/tPvi+j9K/9OOtzG9LEp+3aGhvOfq
+M/V/xPX/TPUV8v9tyv8z1XtTOp/eMFZb/Cd9Lu/ydIJ8H+qeyx++SOfyh9/gc/lnX0We81i5j7fDcw/8
+rNc+1wIAcJLP5Zw8OS/kntuu4XuzOs85tJwzy3m/vDftcK7D9o255+dyL0m/V9XrsJz3yn4557xb/BgC
+5ua9RxC21qFC+3O5LzDduXrBc/Np7qnLOiXn5/JesP6TXa/a/iGfrpJ8Op4z37lUxpF9Dp56e/b9hbn3
+HjY4eNp7A1tfjXraJeegV0dh3beVc7Qs3u633k/KfSE5B0/VAAAQxjsz/fL5xD6fGD6f2OcT+3xin0/s
+84l9PrHPJwZ8PrHPJ/b5xIAnv88n9vnE8PnEPp/Y5xP7fGKfT+zziX0+MeDziX0+sc8nnho3+Hxin0/s
+84l9PrHPJwZ8PrHPJ/b5xD6f2OcT+3xin0/s84l9PrHPJ/b5xMBU/vb5xD6f2OcT+3xin0/s84l9PrHP
+J/b5xGYc+Hxim0fg84l9PrHPJ/b5xD6f2OcT+3xin0/s84nfED5xb3WVu+5m5veDF8gnLrHb4/KJd7zB
+fOIkAAArkoX4xM+H8dvgE29lO7ZqPvFnwt7xOlNedw6fuETxiR+w59Ulxye+6O2bjk9cls5fXwE+8SHe
+dz1EPvEh8okPkU98iHziQ6Pp8/GfyydW71Vl/5SsVbzixVVZnlUsey+t99Cg+Xwf78PVlblxxuaLnj5P
+vmi/ijfRtJ2GSms+6fA0/NKh/PwINz1u37/U9xXDlJdKfDf22xBl/I0uzvCOOqLLDE/0nQAAREqEH3p6
+whtHkw73X4H1ec8XBCfEkrLPKrVxyVaYtANr/5bamLXrMs+5Rmqv3W6pPwePUG8y6N7rD8Nzbp/nXj/5
+nvb9xIvG81wYQOazaqY8z1MTFs8zApTl5Xf2K35nlLzIQvxOpGfG7xxV/M5hi9+Z5ZMWy/McUjzPEcXz
+HL8wnid5unKvfkP0PPmd8wHk4e24/M528Hx4ieF3Pops3MnwO2sDABC7HXnnhx6/Mk9kPrn4r8B8yd7H
+4nwI3GHjxFqlN2jf96iP/0kInnsb0/Iwr+G5cAntUWLsIXgrjDsD+eJfLg8TaUx5n2l4Gr7jUH4+n5se
+n/oeeoE4WOm8J+d3NAwuXaz4mNH0pdyfDufWdNQzvmQ8RRxgvue9X8rjl/n5xpMqfzIIOJ73Vm69XB+8
+v7Mx39Oe7D7vjN2PCOUPjk/Y6RGVHlLpUZUeVukxlUbaThv/1S9elvb2o2shAHUvc9zbD80bDGn5uOIB
+TMdf1LyB4bw8gmwaaTvN91OBuek1nn50Vih+5SXf/t9MeHF5lmfJ/bhn31CWifOXWfVL/N+h3lfJ+WmW
+X9mn+JPDKj2k0iOMa44DhYfqisBDqafhtivve1wXF82ZGhetA8z+3tSX6gS87XFx0nbKnwLM+QTzP1YA
+N3VNh5v+LTg1bvphcEsGTx+0znV6D/Ec8xDPLw/x3PIQcdQh4qhDxFGHiKMOEUcdIo46NFM+JHktC94o
+PuSpiSn5kCXkz5TY/NsO52rAw3cqzINEemY8yFHFgxxWPMixGfIghxQPckTxIMdnxoN8ljjtfUjDwx+O
+BIHlnvjQc8z4dWkgbPMfawFMce9CymXvXZwL5Bv/Ls8joO43aV6JzMe35N+nCO529yv3qHlXwnrVfl7X
+X1+7j/xHnn8uyO7nAp5+VQb+l+E/Rm19HYGv5Oc/lgDw3IsRXqt+P1vpwPAfj9Evy6jXiRr+I39vK4xw
+EAVwRl0BnFF3ATjDiy/qisEX7G/v+8Yn7PSISg+p9KhKD6v0mJuuKwpnrLZxRsXvGr5YYniM88ljrCSP
+sULxGKeNs2NFxdmueRVW+7rmzbPan5w33+pf57wCPMYI4PWTF0cs8dznF35u76Y+1rfYsm9X+SLr/KX3
+fby39eBhxWMct+6vbXf+xuhtj7rzL5pn31DpVCkeY4H9RZH4P8tn/Ma5fPOr57nDE9741/Os6U+E7cvi
+krCFh6fdf6q46p3P3vHkvofYpuev6qf6HRXdz/rFA+cMzum33o9leYqfUDzFC7Nrh3PE8BWdO/La0bUv
+23NbDl9R2fPJi2vP1NOFeIgztesHz3nxaZZ3GE1PzTsE1/XDE95+hvGJoPU7ZE4tyvPsszucq+A9Lyn2
+fNGLy+3xW1Lc+QnbNdNzRTn3qE88HPDi5BzeINvVWW6fp4RxrbVOZfmC5GUGlsLx3OvM5QnWcp2R95JI
+A0Ds8hnabwMAABHun2S83VgRse23Zxr7negrzo579D7jXQF7nzFD3t9zwpeNprP73nRa7NXz/CDx0puK
+5P0tDMi5YWZcRh99Xfl+2fOzKPl+ZxTf78LXA8P7C+RfDzhfZd70PMf4FQFC1nhg/No6zXjQ/k/a/u99
+8OCEt7yMg2y8iqYvKH4lfkw+V38BXl+U5/KA91zde+4TyYeLQgVwml4nAt87q8+RcMCMN3jeW0cCQMj7
+e2UBwOxfA/DyF7L8vmV557Xw4wvNb/n9vlz+XsRqj8vfc8Tu/Yq/1z/l/M5dn7J4Mphv/Ve/TzD9uvQn
++Xl6BeyZrKB/iTNl3myIkK8XCcJ7nyPi3r897J4DBT3n2FKv3I8J44sz5PE9WhSPLxsPHrd5fDsL8Bue
+AAAgxvW/S9a5HjmXMO1fGrjSybd/lt8HnG4fLfqy++l5jnccyPOC7wEL7bMFDyX0+OvjfRPFIz0ufM4i
+153LAa//5J6Q3HPN3X878J5P1Nd+2IH3d8bc/fe8iSn5fXT0rsvh8kIz/hJ7yD3dHgCee9au//Zr//0Y
+F+S//dp/L1rxJPUUAAA7Sug/fsaShfx3mY0PtP94rl/ovW7OeYm8331uPL8/ZR1ZoPx5jb3Pz/VngHYc
+t+69ubw+sbPwRFz//uM0fDGjp+sa+nmBjUNTOwF4fu8q9hSQyb8IgOccN+vf5y/Mv53av39s+3cHgKnO
+owvNz635/Ss8zenmp8vfLHT+vFD5cxkw1byUcVVfW6F4fuK3/mn8Zsp3XVvk/Oy0x53rv53af10X5r+d
+2n87OK7PTFzYPJ015TxNrSvSj4XeI5z3vHTs+T4g8/IfC8zH7VPzqR+Yeh66vLgqNR/3FJqPrRd5PtZb
+dnZ/B+s852dqZQFc/mSR9wRy+H8HLT/FnrTxeZb3Nz5h73+PqvnX8LrMP3feVdnj0F3/BQ8cj1rn3d3L
+P5v29rPSeZI8v0GUZ/xz1bmSfO/vpplnEf7et/u7fry3E3uXml/iD1kHC/2+395p5pf+PXvXL13Ih4un
+5fktyH+fI4yzhufH+R7jewyX5xfieera7P7c5vnB5vnJvuK8eX4deefFb4/fdWv+8jn8rtMAgDB+zzrP
+ce/9lWienxmnYWycEc9PxnvxPL8wAp51sqN0bgF+32zGSaTh+b0J8X9qjz0OCvn/4vn9d4XXdxtg8frK
+zo/XJ+vA0zPFaVEjX1kg7txeAHdPh8s0v8ONN/+aRl5+xkx5fLvO4jzjbsMlEXe/li4q7moeX4nw+O58
+g3h8AxPweXweHtdHLpDH9zDH8xrGz0ucx+f6f/fr6v/bmM4dB5cqn2/dBfL51hTg891oxZVC7w0v9vtC
+9/fun86P5y/eefsrr3rjZUGe3nH7d5iEp+eex/Pce7r3i2IP976LszTD0xP+Y6ol//ohOKR3uX1fT9+7
+jkTIx3Pfe5RYfnB/l7yiSH8o/e75dzcwIzsv/iZ5kf1q3SXvbsEct9+ZdfcaG7eJ/aX/rr08PB9z37nE
+4NSi34uffDXqeQ+R+pTNS4i9AwoPn+b7yj6LVyn79DD+zOff+fw7n3/n8+98/p3Pv/P5dz7/zuff+fw7
+n3/n8+8An3/n8+98/p3Pv/P5dz7/zuff+fw7n3/n8+98/p3Pv/P5dz7/zuff+fw7n3/n8+98/l0OnvT5
+dz7/zuff+fw7+Pw7n3/n8+98/p3Pv/P5dz7/zuff+fw7n39X0O8+/87n3/n8O59/B8Dn3/n8O59/NzWu
+9/l3Pv/uvxD/Ts4TTrrz8QL5dxG7HS7/bv8bzL/rAgBgRVch/t2Vvx3+HcfJij2afzcn7PX3tiPnyb9T
+/K3KyBIAQHK/HdfPl9+WetSupxJVM+PhsdyVteU2bpdy+wCo93Ln1c5u3c4CfDw+711l4kkqXiQf7wjv
+XR4hH+8I+XhHyMc7Qj7ekdH0+fjT5eOVwnxKfEuG7PTWkBXvIkFgbiaOnQ5ZcWvhHHe/k4lby2R8BHFw
+ADjJ+4YybjoiS/DwDOutyNT7pZDghrmZeoNWvV0VAcCqP+zWX5G5ZwaA/J5Y2vz/XMsy7akw7dlq15+8
+R7Xnlmx7FmTaE3DPUyo8++n6xZ8NoYj2nQwYPYXa1buKfMflZe79AZvneOo8eY4fV/F8pjzHoWl4jsPF
+8RzVelKI77ghwnUtUm74jZHdoWhmnTZ2iUD4jae4Hg1MWOOJ46t306kJ+f/KZuI4P5Nb7f2hy7tywHEQ
+AgDUrzWKeo6Wpb34oas2O68z9+qdd4fWWP2R/YHsCw7b98urBf8L7if/sJr8w6UBZD5rS6iP+Kf2Mmsd
+TC4hD7GdPMRarh/HB3mvnTxE3pvs3TTI+9/kI8p7nOPGfh3BRUwrPuIJ3mtvL8BHPMF77e3kI57gvfb2
+QnzEoan5iCd4r72dfMQTvNfePqD4jEXyEU8wvraTjxjivfZ28hEnNzwee2b/f9eDPJ/un5D5muElLqUf
+2hUvcQl5iQ+Sl9hOXuKy7PmQl+8i63hH4OdOCYBUEwDyFjP3mEIvOwCQagYMjjxl38NR413u5+7jpzwX
+3Cn1xQIst43jPBEKwXMOlnsuckCdR5NfWMbzaLBfkPPo2ReJVzg0DQ9vuDgenps+PTEVPq50dqc1XjD4
+OnKBfMLfbj86nA3pqMf/7nq71Y5v2XVlwCqv46yOjzn3qdz0aZWe7v778DT334fO6/57/drfS+eL2+59
+fvf+9+EJbxwvdJ+/t/3S6FeHs9jmDXJ92OCQN3jJt392GhZfz5RLLc7irDLPOic4o2tJqbWP3+Ew3jjA
+ao8fhf+c5Q8OTMMXHM7LH5RxI+tuGD/mulCW9vJZpsMfqfUwn3ehuPgs72+4DiR3MD7vXR806yLH81oA
+Hr77hpAZvx2htwa3eHk4Wf9zPeW5ZjvPM9sHrHW2t/2wtY73thOntB9Q/pT34cQh88n3C7FfC8n326v5
+fqcmvHwU0Zucf5kqH7XKZ+t5k8pXZeVLLizA9+uCwkWK79d+0MJJwi/pOTrwW+b7DV0g32/44vL9niEO
+2hS14mnEAZZ7+JNLgw8EVnvOmXdx/FaF1X0Lnit1hOS8Y1fA2nfdY+/DYsaNSN0MeON4cjvnxV0/DcB7
+vrYkiy8CnvZVBh8xvLsA6w8+ZPh2axXfLpzdT0XzvpfdFwh4xoHg547SA4H872c/GChmv53lzw1M6PW3
+zhsXQsWtvymmT4LyWYz3bnpEpYdVelSlh1R6zD5XdOVI22mux0venc6HLwvy6xQ/0I1nqp+Cy7P5x1V6
+RKWHVXpUpYdUekyloc7dhW+35hLh2w3NjG/n4keeA3vOX/Lz7cz47KpYbOHULN+OfL+4ve/P8uv6bX4d
+8vPrxK+VznuDRfHsisb3whf55TkUiY+9+5ec9wjsZ4RxM1UDAECsTM9TjYfU+C3wHqF+zQ8MrysBAEDn
+IvLkAv8ZWPiCV180PaP6NP9W9g2BYcOX0+diW2H9Dk3sZmUnt/3RtBfHufZogMqHtHdfmxO/7tb5tV3+
+5zkL17v7GO4za6fhux0VPsBrAdPvKsNvS2TjUGb/HajExdx/C37LWc+2hax1pz5REfDivBx+GshPM9kQ
+xpusdp6Evc+WdbDDqYIDIPV2e3/rrovOfMDzniunf0H2L6j6Fy++f4FM/37jWDh2nmOtAztohzsTWT5I
+mefcQd5fy+8xmP1POp3dr3/FMfiMvDGHvDHuC7Jx4MtOpnyY/g530c6vD28sjGcdO749VRxvbAbxzfDG
+NuSPb1tg7aNiZfY81/HOG99Ck+nJfzz3zC44vi25+ZwVHzTfK2D7P7Uku9+JFFF/Id5XR6jxnHffuSFI
+vlcwyPlCflGQ9/643xF/diKAnPI1k+Wv5ni3x73oy5k/2wEAiDXb+XtX9RXcT3t5WcLv6t100HpvK7yx
+Yu3Rc5z3gpvKDD/7xMEJr58L2SP5+/QP92OTcRNl0/PmXBwVYH+W2zyzMG6eIU+rsSieVhgtjrferlsd
+AMCupawnDEQ9971jgk9vgbWfXxocwWrP+5ldcj+/FQCAqqDa98jzbltPR0D2QX9v44+toanPDTi+5F5G
+qkHWJ+6Damw8HqNdk1u4bwo6jNdIe/1UH7zFgee9l35eGXmX4U+FeN8ywnviIdqzgfZcAwBABMRFEVjj
+weWL0A5djYD39xmWBr+Q175VIWXXm+1yWXueoPzMhNcvOfYNwTpP2BdS74duBuCJOxLPYk1qfXP3o461
+T6i/63IHHt5RZSRu7BdQ9uN4lPcqXc35x6WMB+FPCw7pXa7Ga6Mer49c5PG6/xIZrz/F1OM1+Fsar++8
+wPH6tktkvH4d9nh98dwbM16vz2tPOT8sNF4F5+aO10UzG69bAO+4TAXVeF1s7/NEn2vHGsdat+X+YH3N
+00Zfk2ov7VIZ/LNz1ngN2uO15znae3eBcRuw1+tYQo3bbtv+sv53872stKsy9ITh24SOoNwzDzpCnztX
+4hlfOfsDdV/K3R840q4ye37TbpEgsDpzj6LbtnOxvBj+Tuy+MN8LkOcj+5AwHje45vhp23+yz4xkcXyd
+B/fm8mSiNk9G4uR/eZ7M88BUPBkU4sncMCOeTPH8mBIEPL+HllpYJE/m2GkrDsW22v5P1RbwO98b1Mfp
+98X2+vlflyezAvDc466MKn5MFfVTr8uLUef5OXFBzvdDsNbv2Dw7nrjr/LzxtHe/L/uSrrWOhc93BHlu
+QD1yr+reVcJfCZ+72HGrwfv7zItfTBcVvzS/RNZLN16Nv0E8kyreL7w4PJPeWWVpmb95eSZPAbgYPJMk
+gNeBZyLvuzqc/rTwkzP3mt83U/2HOf7WUu+lzjeRcbD7dR0HOXwTdzxcIN9EeGcXmW+SHQ/vUeNhpvrf
+WYB3cnc6H0699M7fv/Ua8tyH9N7LOOttL+N1b3v2d3oCHpzQWRNAMef2KfeefnWGP+K9D+JdrwvZL1Zh
+2817vjk3z/ub87fPc6958XfXYt6brpnjrjeZdaqB9cjvAToA8vE6ir73dfi1qOc9hozXq9lv4dvH7lL6
+XDx3ivdfBmyeR8T+/8yE8dFM/xwAbewL6gAACMH+u4yyyf8C6pnkdQCsV3pKZqBH8pYCWA3AydcQT553
+FJFH6iuUp4SPonnaky/PZQXyBDz9cnD+dXn1hIrQU6iu29bfgUJ/vwQQwi/SWu7MAYAg1iv5gXIj71OV
+fTVg5GuU/PaokZ+yxbilxMiHlHxXyMjLlJ5Tlxn5cMKWX+8Y+TqV/6lSIz+jjDsWNvLDQVu+he0/reSf
+jxl5VDngFOWDqj1nZxn5bpX/KrZHT4JHI0Y+oPLPY3vawrb8Pta7W8m30D4DSv4V+lEP1kdoB0SUX6i/
+qtyWD5ZxPKj8L4r+qC0Psl8Ns2z5XfR7w5W2/HHq3z3bln+adiirseUP0e8HFtjyJvZrZI4tfwf90n+5
+LX+A7V+n+vsZ5i+rsOVrgxzP8235duavu8GW38x+1VTa8rey3n4l/xj9WLXUlt86l+1R8s9xfpUpO3xU
+7KzkP6GenSts+Zdmc/wrOTgexpSeW5h/RPnlMdpn/M0qPnBer6u25f+NfhxV8vUcP8Nttrycdl73Jlv+
+Mu3Zt8qWf4/9PXCVLf8h41iZkreznWeutuVLaOc+Jf8O7VMTV/ZkvRuVfS5nvYNLbPmPOM4HGmz5z2Qe
+1dry+WznkJL/IesdXWTLU5wX+1W972dcGlhsy29ie/pU/hc4nhuUne+gH0dU+9up/7Aat59lexquseWv
+Uc9+Jb+T82JQyedQ/7pltryL+lGn4j/1b1TyG6m/X8n/nX6vu9aWv436Tyv5/6H+6HJbvoj61yj5MPWP
+KPkV1L/xOlv+p9Tfr+Sgflxvi/+c+geV/N84furqbXmf2F/JfyDxQcnjlI+pdXBc4kCjLb+f+sdUnHye
+7elX42eJrMsttvw459GAqncH27NTzccK2mFIyTs5X8abbPk3Oe/2q/bfynoPqPxXs94q1c5nqWe3kq/g
+uD2l4tsHZf1V8i76d7eSD9DOW1aq+MB6B1Uc/hbjJ5T8tYz+cA7e219u5Brv/WXAyDXeuzlq5BrvrS4x
+co33doaMXOO9L7I9Gu8tdYxc472Plxq5xnujYSPXeG8j26/x3lMxI9d474uUa7z3q1lGrvHeArZH473e
+iJFrvDeb7dF47x7Wq/HeRtpH470vzTFyjfcepB003nsz9Wu8N1DG8aDyf0f0K7z3n/SvxnvtIld470PU
+r/Hex2gHjff20e8a713Pfmm8dwf9ovHe/Wy/xnufYH6N91YGOZ4V3ns382u8t4r90njvFtar8d6j9KPG
+e6vnsj1K/mnOL433HhU7K/kPqEfjvYHZHP9Kfob5Nd5bxfwa7/XSPhrv/QXntcZ7HfSjxnu3cV5ovFdK
+O2u896+0p8Z732b7Nd77PuOYxntr2U6N966gnTXe+ybni8Z7A6xX470y1qvx3osc5xrv/Zh20HhvNtup
+8d5jrFfjvf/BeaHx3j2MSxrvtbA9Gu+d4njWeO8W+lHjvbXUr/HeJ9kejfd+QT0a772F80LjvRD1a7y3
+k/o13vtn6td4r4n6Nd77If2u8d6t1K/x3jeoX+O9y6lf472/pX6N9yqoX+O9Y9Sv8d4rnI8a7z1D/Rrv
+/RPHj8Z7T4j9lfx7Eh+UvI5yjfd+InFA4aXt1K/xXj/bo/HeFbIuK7x0hPNI4733sD0a75XSDhrvbeF8
+0XjvBc47jfdWs16N9xawXo33Pkc9Gu9dw3Gr8d5eWX+VfCfHj8Z7f0w7a7w3m/VqvPc1xk+N936Z0R/J
+wXu7y41c470vBIxc4703R41c473mEiPXeM+8no3k4L2nKd+oxslVjpFrvPd4qZFrvDcSNnKN99az/UNK
+flPEyNtKbfnHY0Y+pnDaWfa3bK4t76K8TbX/5VlGXqNwWjfr7VfyH5UZ+WmFuxKUr1d45iXqH1R4rGoO
+5VfY8nL6vW6eLb+b/YXCY/GgkVcpPPBL+rdB2aGb+UeU/Hm2Z6fCXaX0l46rX2K/RlT+/8t61yuc9hLt
+2afW369wnBxU8r/muDqt9PwB7Tyq5AfYHqg4/F0Zt0r+jLRTyVMcJzuV/R+nnv0K59TOpZ1bbfl19NeQ
+8svPZzP/QlveyHm9XsWxKvrrQJUt/xTtOdKs/Mt+7VT47fdpnzNqfKbZzjVqHH5M4oZap7ZQDmWH78i4
+VX5McFytUXreTP+OKD0/k/ao/ch3OU52K3z4V7TbsMKBbfRXjZL/Mf0F1c459Eu/sue3Kd+t2h+g/jqF
+9zawPaeUvJf1RhUOPEU/rlHyH9DvY0oeof6DCh82Uv+okm+l/hqFG79K/UNK/h3Or40KH0aov1/Jr5Fx
+qORPUf9BhQ+fpv4ahQ+/yXGyW8l/yPE8qOSbqF/jxg9Q/4iS91F/jYpjp6l/o5L/A9ej3Wq9+DrtoPdr
+26n/gMKN1RzPbWr8PMv50qbw0nWcpw2q3gj7e0bpuVzWHYUDIXFM4be/5fgZV/o7OM7PqH5dw3Hep3Da
+J6hnTMm7aLc2Fcee4/w9rPDbq1xfNC7dQDsPKj2/Fv8q/PZt2meNkr98GQCU5uAos86V5uCoZwJGrnFU
+U9TINY66ocTINY7aFDJyjaM+TrnGUQsdI9c46kOlRq5x1HDYyDWOWsf2axzVGjFyjaMejxm5xlG/Yn81
+jtpJucZRP5pl5BpH3ct6NY56qczINY5aTrnGUd+lfo2jyuZQrtavUvpd46i3sr8aR10bNHKNo/6d/tU4
+6l7m1zjqKNujcRToL42jnme/NI76O9arcdR3aE+NowY5TjSO+jLHlcZRd9POGkftY3s0jvqWjFsl/6y0
+U8lPcpxoHPUh6tE4qmou7axw1GL6S+Oon8xmfoWjlnNeaxxVRn9pHPVR2lPjqHvZL42jVtM+Gke9wnZq
+HPWoxA0VtzdSrnHUN2XcKj9ey3GlcVQj/atx1I+lPQpHfYvjROOoL9BuGketoL80jnqK/tI4KkS/aBz1
+dco1jnqVftE46m1sj8ZRe1ivxlFfpB81jvoe9WscNcHxr3HUcurXOOpd1K9x1JeoX+Oob1K/xlET9KPG
+UVfKOFTyP6R+jaM+Tv0aR71A/RpHfZ/jWeOot1O/xlH3Ub/GUU9Qv8ZRJ6lf46i/43qkcdRXaWeNo95N
+/RpHlXM8axz1Oc4XjaMWcZ5qHDXB9mgcVSbrjsJRr9AvGkf9b45PjaPu4DjXOOpKziONow5Sj8ZRO9lO
+jaM+z/mrcdQ41xeNo95GO2sc9bL4V+Glr3M8aBz148sAYHYOjtpSbuQaR30+YOQaR9VHjVzjqGUlRq5x
+1N0hI9c46jOOkZ9R462Mco2jHig18qjCRUNhIx9Q8ldY76jSv4j9rVF46RnqWaNwzqdpt0GV/26254yS
+95exv+o9XZD2XKfeV25gvaMKhxjcNhsHVP4VESMfUTjqzXPoF7U+jlOPXgcbY0Zepdb3j8yindV6vS1o
+5IgpP7JejX9+TfmgiodHqb9Nny+xPYNqXRumvwaUfAntNqj03M52Div5ZubvV3H4/3G8QcXVbRzPdQoP
+bKL8oJrvP+d4G1R2+7X4XcWTGtrnoIpLvdR/QOGTRRxXWzSeYb9y3r/QzqdU/hfYnvXKnl9h/jFlh58y
+f5+yQ5TtH1W4ZT3jA5T+B2ifOiWPSL+UHb5PPVWqXy9xPq5ReOZrnI+nlPxRtl/jnBc43jQOf4H6yxRu
+CdAva5T8QeofV/JnqX+nwi1/wHYOKvmfSfxRuKWe+k8r+YNs57iSt1F/v8Izh6hfvwf/A7Zzi5K30y/6
+vdvd9PuAkl/H9oyq8ROlfv1e7M9pzxEVn7exXv3e8EOs94yS389xq/cvn6T+/Qon1Eh8UPKvcXw2qHn6
+1dmcpwonPMjxOarkl7M9a1R8SACoQDA7T1YDAHAMQDnm4GMOAADitr+nfFDJH6X895X8LynfqeQ/LTXy
+EdjyK0qM/D4l/2fqOavk56hniZL/E+V9Su5QT41qz2zW+2WVfx2ACoRz7PPQ7El5BPJ3oBUAgD/KyEtz
+5Ndk9MzOrn9xAAA62R6oejdQvk7Jv5GRZxflEAAAqPP076cO0KfS0l8HwM8cYNCT/rkDVAWy6XEHOKXS
+u4O2vrYSOz1Qk03/wgFOL7HTwyuy6f9wgLGGbPqXDjASz6Z/Ndn+5mz61w4w7kn/xoGLIyV9WKX7WrPp
+VybLt6rybaq8Svv2/N22Z1XITq97UzY9ab82Zd+d19n2PHC9bc8t9bY9o3Hbnjvjdn9PqXRZwk5HG217
+7m60n59W6aom356/S/Y8rdIHlD0PhO00rrDtt+UqO122zLZnXZ1tz+i1tj3Hr7Ptuf56u7/9Ko16O32m
+3rbnlhvs54MqXbbCTr8cPBN8Nfha8D+DZ4O/Cp4L/kfw18FXgr8I/jL4m+DPg+PBnwZ/FpwI4r337drX
+ff+uXbs373pg+57JFG7Yu33/XtywZ/t9nffv2LO9uxv779m9Wb7v3eZ+ncyy3ZXy2/uTu7s9Wls2d95/
+//Y9HkkTJZOl99+z2/Oke1/n3m07ccN99z3w/s3Je+7Z0925w/N4267732sJktv2dj6wHZs339e5bfv9
+3du9qpLv332fV/CB+9+f3Ltt5/Z7srLGG7bhhu69e/Ymt+KG7p73T37ecfPNTZtXTn40mo+E+Yibjwb3
+mTzk0/hKPo+LpI2CNskhgrhIWilolRwi4JemzfEWedQij1rYgGY2oFlyNEuOZincJI+a5JEraRRJIzMn
++CTBB27aFSRErTyKyyN+adwcZ5virlHEJuZ5QjLwS9PmuPulQco0SKEGPmozhdtM0TZal8alw9qkAW3S
+gjZpQpu0gXnb+Blvk0JtUkgkrRS0StZWPmjhgxZqb6HyFmZsFpXNorKZT5r4pIkPmqgh0UQVCckRlyxx
+N0+j5GmUPI2SRySJBCX80rg5LhJ+iW9OxEVPXPLEJU9c7CV54pIn0SCaG6SUSPglLl8a5EvT5lZakobk
+gOd4p9OYZyUzSTouAn6Jy5eGrKSNgrZWcaYUbpXCrVK4VQq3MnOLFGqRQi1SqEUKtUghydzMJ8180CxK
+5EFcnsTlUZNkaZIsTZJFJI0UNErWRskqT+LyKCFZEpIlIVncR64RxAZiArGAzMNWmYdSMt7qiZX37ere
+2+KN4bQbJwHnAKcAZwCDJJMrWSQuX9pYuI2l2yhvZcFWFmyVgq0t0pcW6Yw8apFHLfJIJM0UNEsOEcRF
+0kRBE+ttYr2SToiAXybno+hoYgMa+aSRDxqlbKOUlRxxyRIXSYKChJSRIvLczeDmcLNInrjkibt54pIn
+LnnikifeIjO7RWa21NUgdTWIngbRI5K4SPilaXMzTU1Lc1ZwUnBmM89KZpJ0XAT8Mjlv5VGbPGqjylbq
+bG2WQdwso5hlWlikRXK0SI4WUd8sj5rlUTMLN7Fwk+RokhwiaaSgsVnc2yzuFfXyKC6P4vIoIVkSkiUh
+WdxH0nXpudtWaarM1maZrVLCLRIXXQ3yqEEeNTSrGd3kSb93T3KHN93E6dvE6dsk07dJPNcknuOjNj5p
+44M2qmijCknHRcAvk1NblLaK0lY+amGhFpZpobyZJZpZoFlUNTfJtG2SacusjczayHSC6YRbQgrIxJMM
+bg43iyuJiyQuvRMJv0xOKtHTIKUapFRDkxdVb76v0QOmJ1NZPM1n2QTXwUZZBxtl/jTK/GmU+SOZ2ySz
+5GllllbJ0So5WpmjhTlaJEeL5GiRCpvlUbM8ciVNImmipJGCxkaZQY0yg0SdPIrLo7g8SjTK8G+U4S9q
+4qImLmriYgbJHHcfNYg+qqMyqqIixi3mWclMKxMyDRJi5YRYmY9aqaiVmlqlSKsUaZUikrWFWVska4tk
+bZGs8qiZT5r5oJkqmqlCnsclQ1xyNDFHk3RUBPwyiUqkcJMUlkeNYqtGsZZI4iLhl7h8aZAvkzNJSiWk
+8oRUnhA9kicueeKSJ+7miUueuOSJS5641NUgdTVIngbJ40pWxmXWxBm74oxVfNAWl9kUFz/Hxc+UtFLQ
+yqwtVNFCFS3M18R8TaKyiRkbmbExLnMhLnMhLgNdxrkMcxnlspS4D9wn8igujxrkUYM8apA+NUifRLKy
+gcO8QYZ5g0T7Bon2fNTGJ218YD7jm1sbOOxFRSsztjBjizxoEd3yhF/im5upo1lyNEuOZhZuYs4m5pR0
+QgT8MjmWpaxIGilobJAx3SBjWsqIhF/i8qVBvjRtTkjphJROSBsS0gbJE5c8/DLpA3GBeEDGqgxVGQGi
+XXIkJEvC1eEqcUs1SKkGtz63ArcG9Rb1Av4cIJgrxVv4+8f/Xp6T3fqbT5m6voA1BapT1yjQPE15eT8k
+f+o6BKoBlOapr++tjilvPlAGIOgpP5kGgLcWqL9vdXH131ig/tE7cusP56n/kdn56x/db7/vKlT/7tn5
+6294W279kTz1f75A/VsGi6v/DwvUf+DO3PpL89T/UAH7j/7Efq9XqP7eAvYfXp9b/+w89d8FwEHuHBi+
+3AEAfNeZevz+TYHyJ65w8uZX6vApAME70rsAHPDKa+5y3Pec3vbq8lEn/++eDbD+Mc858stOtv9VAADg
+/w8AstTKhnhoAQA=
 `,
 	},
 }
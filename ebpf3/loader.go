@@ -0,0 +1,329 @@
+package ebpf3
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"github.com/dropbox/goebpf"
+	"golang.org/x/sys/unix"
+)
+
+// elfMap is struct bpf_elf_map of iproute2, the map definition in the
+// "maps" section of tc objects.
+type elfMap struct {
+	Type      uint32
+	KeySize   uint32
+	ValueSize uint32
+	MaxElem   uint32
+	Flags     uint32
+	ID        uint32
+	Pinning   uint32
+	InnerID   uint32
+	InnerIdx  uint32
+}
+
+const (
+	// BPF_LD | BPF_IMM | BPF_DW, the instruction loading map fds.
+	opLdImm64 = 0x18
+	// BPF_PSEUDO_MAP_FD, the source register marking a map fd.
+	pseudoMapFd = 1
+	// R_BPF_64_64, the relocation of map references.
+	relBpf6464 = 1
+	// Size of an eBPF instruction.
+	insnLen = 8
+	// Size of the buffer for the verifier log.
+	logLen = 1 << 20
+)
+
+// progLoadAttr is the prog load member of union bpf_attr.
+type progLoadAttr struct {
+	progType    uint32
+	insnCnt     uint32
+	insns       uint64
+	license     uint64
+	logLevel    uint32
+	logSize     uint32
+	logBuf      uint64
+	kernVersion uint32
+	progFlags   uint32
+	progName    [unix.BPF_OBJ_NAME_LEN]byte
+}
+
+// objInfoAttr is the info member of union bpf_attr.
+type objInfoAttr struct {
+	fd      uint32
+	infoLen uint32
+	info    uint64
+}
+
+// progInfo is the start of struct bpf_prog_info, the kernel fills
+// only what fits.
+type progInfo struct {
+	progType uint32
+	id       uint32
+}
+
+// progID returns the ID of the program with file descriptor fd.
+func progID(fd int) (uint32, error) {
+	var info progInfo
+	attr := objInfoAttr{
+		fd:      uint32(fd),
+		infoLen: uint32(unsafe.Sizeof(info)),
+		info:    uint64(uintptr(unsafe.Pointer(&info))),
+	}
+	_, _, errno := unix.Syscall(unix.SYS_BPF, unix.BPF_OBJ_GET_INFO_BY_FD,
+		uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr))
+	runtime.KeepAlive(&info)
+	if errno != 0 {
+		return 0, fmt.Errorf("cannot get program info: %w", errno)
+	}
+	return info.id, nil
+}
+
+// LoadError reports a program refused by the kernel.
+type LoadError struct {
+	Section string
+	// Log is the output of the verifier, if any.
+	Log string
+	Err error
+}
+
+func (e *LoadError) Error() string {
+	if e.Log == "" {
+		return fmt.Sprintf("cannot load program %s: %v", e.Section, e.Err)
+	}
+	return fmt.Sprintf("cannot load program %s: %v\n%s", e.Section, e.Err, e.Log)
+}
+
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
+// object is a tc eBPF object loaded in the kernel. It does what tc
+// does, without iproute2 and without pinning: maps are created
+// unpinned and only reachable from here.
+type object struct {
+	maps  map[string]*goebpf.EbpfMap
+	progs map[string]int
+}
+
+// loadObject loads the programs of the ELF object obj in the sections
+// named in progs, as programs of the given type, creating the maps
+// they use. maxElem overrides the size of the maps it names.
+func loadObject(obj []byte, progs map[string]goebpf.ProgramType,
+	maxElem map[string]int) (*object, error) {
+	f, err := elf.NewFile(bytes.NewReader(obj))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse ebpf object: %w", err)
+	}
+	if f.ByteOrder != binary.LittleEndian {
+		return nil, fmt.Errorf("big endian ebpf objects are not supported")
+	}
+	syms, err := f.Symbols()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read ebpf object symbols: %w", err)
+	}
+	o := &object{
+		maps:  make(map[string]*goebpf.EbpfMap),
+		progs: make(map[string]int),
+	}
+	if err := o.createMaps(f, syms, maxElem); err != nil {
+		o.Close()
+		return nil, err
+	}
+	license := "GPL"
+	if sec := f.Section("license"); sec != nil {
+		data, err := sec.Data()
+		if err != nil {
+			o.Close()
+			return nil, fmt.Errorf("cannot read license: %w", err)
+		}
+		license = string(bytes.TrimRight(data, "\x00"))
+	}
+	for name, typ := range progs {
+		if err := o.loadProg(f, syms, name, typ, license); err != nil {
+			o.Close()
+			return nil, err
+		}
+	}
+	return o, nil
+}
+
+// createMaps creates the maps defined in the "maps" section, the
+// maps of maps last since they need their template.
+func (o *object) createMaps(f *elf.File, syms []elf.Symbol, maxElem map[string]int) error {
+	sec := f.Section("maps")
+	if sec == nil {
+		return nil
+	}
+	data, err := sec.Data()
+	if err != nil {
+		return fmt.Errorf("cannot read maps: %w", err)
+	}
+	defs := make(map[string]elfMap)
+	byID := make(map[uint32]string)
+	var names []string
+	for _, s := range syms {
+		if int(s.Section) >= len(f.Sections) || f.Sections[s.Section] != sec {
+			continue
+		}
+		var def elfMap
+		if s.Value+uint64(unsafe.Sizeof(def)) > uint64(len(data)) {
+			return fmt.Errorf("map %s out of the maps section", s.Name)
+		}
+		if err := binary.Read(bytes.NewReader(data[s.Value:]), f.ByteOrder, &def); err != nil {
+			return fmt.Errorf("cannot read map %s: %w", s.Name, err)
+		}
+		if n, ok := maxElem[s.Name]; ok {
+			def.MaxElem = uint32(n)
+		}
+		defs[s.Name] = def
+		if def.ID != 0 {
+			byID[def.ID] = s.Name
+		}
+		names = append(names, s.Name)
+	}
+	isOuter := func(def elfMap) bool {
+		t := goebpf.MapType(def.Type)
+		return t == goebpf.MapTypeArrayOfMaps || t == goebpf.MapTypeHashOfMaps
+	}
+	for _, outer := range []bool{false, true} {
+		for _, name := range names {
+			def := defs[name]
+			if isOuter(def) != outer {
+				continue
+			}
+			// The kernel limits names to 15 characters.
+			kname := name
+			if len(kname) >= unix.BPF_OBJ_NAME_LEN {
+				kname = kname[:unix.BPF_OBJ_NAME_LEN-1]
+			}
+			m := &goebpf.EbpfMap{
+				Name:       kname,
+				Type:       goebpf.MapType(def.Type),
+				KeySize:    int(def.KeySize),
+				ValueSize:  int(def.ValueSize),
+				MaxEntries: int(def.MaxElem),
+				Flags:      int(def.Flags),
+			}
+			if outer {
+				inner, ok := o.maps[byID[def.InnerID]]
+				if !ok {
+					return fmt.Errorf("no template with id %d for map %s", def.InnerID, name)
+				}
+				m.InnerMapFd = inner.GetFd()
+			}
+			if err := m.Create(); err != nil {
+				return fmt.Errorf("cannot create map %s: %w", name, err)
+			}
+			o.maps[name] = m
+		}
+	}
+	return nil
+}
+
+// loadProg relocates the map references of the program in section
+// name and loads it as a program of type typ.
+func (o *object) loadProg(f *elf.File, syms []elf.Symbol, name string,
+	typ goebpf.ProgramType, license string) error {
+	sec := f.Section(name)
+	if sec == nil {
+		return fmt.Errorf("no section %s in ebpf object", name)
+	}
+	insns, err := sec.Data()
+	if err != nil {
+		return fmt.Errorf("cannot read section %s: %w", name, err)
+	}
+	if len(insns) == 0 || len(insns)%insnLen != 0 {
+		return fmt.Errorf("section %s is not a program", name)
+	}
+	for _, rs := range f.Sections {
+		if rs.Type != elf.SHT_REL || int(rs.Info) >= len(f.Sections) ||
+			f.Sections[rs.Info] != sec {
+			continue
+		}
+		rels, err := rs.Data()
+		if err != nil {
+			return fmt.Errorf("cannot read section %s: %w", rs.Name, err)
+		}
+		for ; len(rels) >= 16; rels = rels[16:] {
+			off := f.ByteOrder.Uint64(rels)
+			info := f.ByteOrder.Uint64(rels[8:])
+			// Index 0 is the null symbol, skipped by Symbols.
+			idx := int(info >> 32)
+			if idx < 1 || idx > len(syms) {
+				return fmt.Errorf("%s: bad relocation symbol %d", name, idx)
+			}
+			sym := syms[idx-1]
+			m, ok := o.maps[sym.Name]
+			if !ok || uint32(info) != relBpf6464 {
+				return fmt.Errorf("%s: unsupported relocation to %s", name, sym.Name)
+			}
+			if off%insnLen != 0 || off+2*insnLen > uint64(len(insns)) ||
+				insns[off] != opLdImm64 {
+				return fmt.Errorf("%s: bad relocation at %d", name, off)
+			}
+			insns[off+1] = insns[off+1]&0x0f | pseudoMapFd<<4
+			f.ByteOrder.PutUint32(insns[off+4:], uint32(m.GetFd()))
+		}
+	}
+	lic := append([]byte(license), 0)
+	attr := progLoadAttr{
+		progType: uint32(typ),
+		insnCnt:  uint32(len(insns) / insnLen),
+		insns:    uint64(uintptr(unsafe.Pointer(&insns[0]))),
+		license:  uint64(uintptr(unsafe.Pointer(&lic[0]))),
+	}
+	copy(attr.progName[:unix.BPF_OBJ_NAME_LEN-1], name)
+	fd, _, errno := unix.Syscall(unix.SYS_BPF, unix.BPF_PROG_LOAD,
+		uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr))
+	if errno != 0 {
+		// Load it again to get the reason from the verifier.
+		log := make([]byte, logLen)
+		attr.logLevel = 1
+		attr.logSize = logLen
+		attr.logBuf = uint64(uintptr(unsafe.Pointer(&log[0])))
+		fd, _, errno = unix.Syscall(unix.SYS_BPF, unix.BPF_PROG_LOAD,
+			uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr))
+		if errno != 0 {
+			runtime.KeepAlive(insns)
+			runtime.KeepAlive(lic)
+			return &LoadError{
+				Section: name,
+				Log:     string(bytes.TrimRight(log, "\x00")),
+				Err:     errno,
+			}
+		}
+	}
+	runtime.KeepAlive(insns)
+	runtime.KeepAlive(lic)
+	o.progs[name] = int(fd)
+	return nil
+}
+
+func (o *object) Close() {
+	for _, m := range o.maps {
+		m.Close()
+	}
+	for _, fd := range o.progs {
+		unix.Close(fd)
+	}
+}
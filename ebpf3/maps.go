@@ -0,0 +1,131 @@
+package ebpf3
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/chripell/flowsnoop/flow"
+	"github.com/dropbox/goebpf"
+)
+
+// Sizes of struct conn_s and struct conn6_s in the eBPF program,
+// padding included.
+const (
+	key4Len = 56
+	key6Len = 80
+)
+
+// Offsets of the VLAN ID in struct conn_s and struct conn6_s, it is
+// in host byte order.
+const (
+	vlan4Off = 14
+	vlan6Off = 38
+)
+
+// Offsets of struct tunnel_s in struct conn_s and struct conn6_s.
+const (
+	tun4Off = 16
+	tun6Off = 40
+)
+
+// unpackTunnel decodes struct tunnel_s, the id is in host byte order.
+func unpackTunnel(b []byte) flow.Tunnel {
+	t := flow.Tunnel{
+		Type: flow.TunnelType(b[0]),
+		ID:   binary.LittleEndian.Uint32(b[4:8]),
+	}
+	copy(t.Src[:], b[8:24])
+	copy(t.Dst[:], b[24:40])
+	return t
+}
+
+// flowMaps are the flow maps of both generations of an IP version.
+// They are created here and installed in outer, the map the eBPF
+// program looks them up from, so that they can be replaced. outer
+// belongs to the loaded object.
+type flowMaps struct {
+	name    string
+	keyLen  int
+	outer   *goebpf.EbpfMap
+	inner   [2]*goebpf.EbpfMap
+	batch   [2]*batch
+	buckets [2]int
+}
+
+func newFlowMaps(outer *goebpf.EbpfMap, name string, keyLen, buckets int) (*flowMaps, error) {
+	fm := &flowMaps{
+		name:   name,
+		keyLen: keyLen,
+		outer:  outer,
+	}
+	for gen := uint32(0); gen < 2; gen++ {
+		if err := fm.install(gen, buckets); err != nil {
+			fm.Close()
+			return nil, err
+		}
+	}
+	return fm, nil
+}
+
+// install creates the map of generation gen with the given capacity,
+// replacing the previous one. The eBPF programs must not be using
+// generation gen.
+func (fm *flowMaps) install(gen uint32, buckets int) error {
+	m := &goebpf.EbpfMap{
+		Name:       fmt.Sprintf("%s_%d", fm.name, gen),
+		Type:       goebpf.MapTypeHash,
+		KeySize:    fm.keyLen,
+		ValueSize:  8,
+		MaxEntries: buckets,
+	}
+	if err := m.Create(); err != nil {
+		return fmt.Errorf("cannot create %s: %w", m.Name, err)
+	}
+	if err := fm.outer.Upsert(gen, uint32(m.GetFd())); err != nil {
+		m.Close()
+		return fmt.Errorf("cannot install %s: %w", m.Name, err)
+	}
+	if old := fm.inner[gen]; old != nil {
+		old.Close()
+	}
+	fm.inner[gen] = m
+	fm.batch[gen] = newBatch(m)
+	fm.buckets[gen] = buckets
+	return nil
+}
+
+// grow doubles the capacity of the map of generation gen, up to max
+// buckets. Before Linux 5.10 the kernel refuses maps of a size
+// different from the template.
+func (fm *flowMaps) grow(gen uint32, max int) error {
+	n := 2 * fm.buckets[gen]
+	if n > max {
+		n = max
+	}
+	if n <= fm.buckets[gen] {
+		return nil
+	}
+	return fm.install(gen, n)
+}
+
+func (fm *flowMaps) Close() {
+	for _, m := range fm.inner {
+		if m != nil {
+			m.Close()
+		}
+	}
+}
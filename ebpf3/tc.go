@@ -0,0 +1,283 @@
+package ebpf3
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/chripell/flowsnoop/ifaces"
+	"github.com/dropbox/goebpf"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// AttachError reports a failure to set up or tear down tc or XDP on
+// an interface.
+type AttachError struct {
+	Iface string
+	// Op is what failed, e.g. "add clsact qdisc".
+	Op  string
+	Err error
+}
+
+func (e *AttachError) Error() string {
+	return fmt.Sprintf("%s: cannot %s: %v", e.Iface, e.Op, e.Err)
+}
+
+func (e *AttachError) Unwrap() error {
+	return e.Err
+}
+
+// The hooks programs are attached to, by section name.
+var hooks = []struct {
+	sec    string
+	parent uint32
+}{
+	{"ingress", netlink.HANDLE_MIN_INGRESS},
+	{"egress", netlink.HANDLE_MIN_EGRESS},
+}
+
+// Suffix of the sections of the programs for interfaces without
+// Ethernet header, which parse packets from the network header.
+const l3Suffix = "_l3"
+
+// sections returns the sections of all the programs.
+func sections() []string {
+	var secs []string
+	for _, h := range hooks {
+		secs = append(secs, h.sec, h.sec+l3Suffix)
+	}
+	return secs
+}
+
+// tcHook attaches the programs as tc filters at priority prio.
+type tcHook struct {
+	prio uint16
+}
+
+func newTCHook() (hook, error) {
+	if *prio < 1 || *prio > 0xffff {
+		return nil, fmt.Errorf("invalid tc priority %d", *prio)
+	}
+	return &tcHook{prio: uint16(*prio)}, nil
+}
+
+func (h *tcHook) programs() map[string]goebpf.ProgramType {
+	progs := make(map[string]goebpf.ProgramType)
+	for _, sec := range sections() {
+		progs[sec] = goebpf.ProgramTypeSchedCls
+	}
+	return progs
+}
+
+func (h *tcHook) attach(l ifaces.Link, obj *object) (attachment, error) {
+	a, err := attach(l, obj, h.prio)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Prefix of the names of our filters. Their handle is the ID of their
+// program, unique to the instance that loaded it, so that instances
+// sharing an interface and a priority do not collide.
+const filterName = "flowsnoop3"
+
+// heldProgs returns the IDs of the eBPF programs a process has a file
+// descriptor of. A running instance keeps its programs open, the
+// programs of the filters left by dead ones are only held by the
+// filters.
+func heldProgs() (map[int]bool, error) {
+	fds, err := filepath.Glob("/proc/[0-9]*/fd/*")
+	if err != nil {
+		return nil, err
+	}
+	held := make(map[int]bool)
+	for _, fd := range fds {
+		if target, err := os.Readlink(fd); err != nil || target != "anon_inode:bpf-prog" {
+			continue
+		}
+		// Processes and descriptors can go away meanwhile.
+		info, err := ioutil.ReadFile(strings.Replace(fd, "/fd/", "/fdinfo/", 1))
+		if err != nil {
+			continue
+		}
+		for _, line := range strings.Split(string(info), "\n") {
+			if v := strings.TrimPrefix(line, "prog_id:"); v != line {
+				if id, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+					held[id] = true
+				}
+			}
+		}
+	}
+	return held, nil
+}
+
+func clsact(link netlink.Link) *netlink.GenericQdisc {
+	return &netlink.GenericQdisc{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_CLSACT,
+		},
+		QdiscType: "clsact",
+	}
+}
+
+func hasClsact(link netlink.Link) (bool, error) {
+	qdiscs, err := netlink.QdiscList(link)
+	if err != nil {
+		return false, err
+	}
+	for _, q := range qdiscs {
+		if q.Type() == "clsact" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// tcAttachment is our filters on an interface.
+type tcAttachment struct {
+	iface   string
+	link    netlink.Link
+	filters []*netlink.BpfFilter
+	// qdisc tells if we created the clsact qdisc.
+	qdisc bool
+}
+
+// attach attaches the programs of obj to the hooks of iface in direct
+// action mode, like "tc filter add dev l ingress prio prio handle
+// id bpf da". It reuses the clsact qdisc of iface, if any,
+// and leaves alone the filters of other programs. The programs
+// parsing from the network header are used if l has no Ethernet
+// header.
+func attach(l ifaces.Link, obj *object, prio uint16) (*tcAttachment, error) {
+	iface := l.Name
+	link := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: l.Index, Name: l.Name}}
+	a := &tcAttachment{iface: iface, link: link}
+	has, err := hasClsact(link)
+	if err != nil {
+		return nil, &AttachError{iface, "list qdiscs", err}
+	}
+	if !has {
+		if err := netlink.QdiscAdd(clsact(link)); err != nil {
+			return nil, &AttachError{iface, "add clsact qdisc", err}
+		}
+		a.qdisc = true
+	}
+	for _, h := range hooks {
+		sec := h.sec
+		if !l.Ethernet() {
+			sec += l3Suffix
+		}
+		id, err := progID(obj.progs[sec])
+		if err != nil {
+			a.detach()
+			return nil, &AttachError{iface, "add " + sec + " filter", err}
+		}
+		filter := &netlink.BpfFilter{
+			FilterAttrs: netlink.FilterAttrs{
+				LinkIndex: link.Attrs().Index,
+				Parent:    h.parent,
+				Handle:    id,
+				Priority:  prio,
+				Protocol:  unix.ETH_P_ALL,
+			},
+			Fd:           obj.progs[sec],
+			Name:         filterName + ":" + sec,
+			DirectAction: true,
+		}
+		err = removeStale(filter)
+		if err == nil {
+			err = netlink.FilterAdd(filter)
+		}
+		if err != nil {
+			a.detach()
+			return nil, &AttachError{iface, "add " + sec + " filter", err}
+		}
+		a.filters = append(a.filters, filter)
+	}
+	return a, nil
+}
+
+// removeStale deletes the filters left at the priority of filter by
+// instances no longer running: those whose program nobody holds.
+func removeStale(filter *netlink.BpfFilter) error {
+	link := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: filter.LinkIndex}}
+	filters, err := netlink.FilterList(link, filter.Parent)
+	if err != nil {
+		return err
+	}
+	var held map[int]bool
+	for _, f := range filters {
+		bf, ok := f.(*netlink.BpfFilter)
+		if !ok || bf.Priority != filter.Priority ||
+			!strings.HasPrefix(bf.Name, filterName) {
+			continue
+		}
+		if held == nil {
+			if held, err = heldProgs(); err != nil {
+				return err
+			}
+		}
+		if held[bf.Id] {
+			continue
+		}
+		log.Printf("ebpf3: removing stale filter %s of program %d", bf.Name, bf.Id)
+		if err := netlink.FilterDel(bf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// detach deletes our filters and the clsact qdisc, if we created it
+// and no other filters were added meanwhile.
+func (a *tcAttachment) detach() error {
+	var first error
+	for _, f := range a.filters {
+		if err := netlink.FilterDel(f); err != nil && first == nil {
+			first = &AttachError{a.iface, "delete filter " + f.Name, err}
+		}
+	}
+	a.filters = nil
+	if !a.qdisc {
+		return first
+	}
+	for _, h := range hooks {
+		filters, err := netlink.FilterList(a.link, h.parent)
+		if err != nil {
+			if first == nil {
+				first = &AttachError{a.iface, "list filters", err}
+			}
+			return first
+		}
+		if len(filters) > 0 {
+			return first
+		}
+	}
+	if err := netlink.QdiscDel(clsact(a.link)); err != nil && first == nil {
+		first = &AttachError{a.iface, "delete clsact qdisc", err}
+	}
+	a.qdisc = false
+	return first
+}
@@ -0,0 +1,125 @@
+package ebpf3
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/chripell/flowsnoop/ifaces"
+	"github.com/dropbox/goebpf"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// The section of the XDP program.
+const xdpSection = "xdp"
+
+var (
+	xdpIface = flag.String("xdp_iface", "",
+		"Interfaces on which should listen: comma separated names or glob patterns, ! excludes.")
+	xdpMode = flag.String("xdp_mode", "auto",
+		"XDP mode: native, generic or auto (native, generic if the driver does not support it).")
+)
+
+// xdpHook attaches the XDP program trying the modes in order.
+type xdpHook struct {
+	modes []int
+}
+
+func newXDPHook() (hook, error) {
+	switch *xdpMode {
+	case "native":
+		return &xdpHook{modes: []int{unix.XDP_FLAGS_DRV_MODE}}, nil
+	case "generic":
+		return &xdpHook{modes: []int{unix.XDP_FLAGS_SKB_MODE}}, nil
+	case "auto":
+		return &xdpHook{modes: []int{unix.XDP_FLAGS_DRV_MODE, unix.XDP_FLAGS_SKB_MODE}}, nil
+	}
+	return nil, fmt.Errorf("unknown XDP mode: %s", *xdpMode)
+}
+
+func (h *xdpHook) programs() map[string]goebpf.ProgramType {
+	return map[string]goebpf.ProgramType{xdpSection: goebpf.ProgramTypeXdp}
+}
+
+// xdpAttachment is our XDP program on an interface.
+type xdpAttachment struct {
+	iface string
+	link  netlink.Link
+	mode  int
+	// id of the program attached, to tell if it was replaced.
+	id uint32
+}
+
+// attach attaches the XDP program to l, unless another one is already
+// there. Interfaces without Ethernet header are skipped.
+func (h *xdpHook) attach(l ifaces.Link, obj *object) (attachment, error) {
+	if !l.Ethernet() {
+		log.Printf("xdp: skipping %s, it has no Ethernet header", l.Name)
+		return nil, nil
+	}
+	link := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: l.Index, Name: l.Name}}
+	var err error
+	for i, mode := range h.modes {
+		err = netlink.LinkSetXdpFdWithFlags(link, obj.progs[xdpSection],
+			unix.XDP_FLAGS_UPDATE_IF_NOEXIST|mode)
+		if err == nil {
+			if i > 0 {
+				log.Printf("xdp: %s does not support native XDP, using generic", l.Name)
+			}
+			a := &xdpAttachment{iface: l.Name, link: link, mode: mode}
+			if nl, err := netlink.LinkByIndex(l.Index); err == nil && nl.Attrs().Xdp != nil {
+				a.id = nl.Attrs().Xdp.ProgId
+			}
+			return a, nil
+		}
+		// Another program is attached, in this mode or another.
+		if errors.Is(err, unix.EBUSY) || errors.Is(err, unix.EEXIST) {
+			return nil, &AttachError{l.Name, "attach XDP program, another one is attached", err}
+		}
+	}
+	return nil, &AttachError{l.Name, "attach XDP program", err}
+}
+
+// detach removes our program, unless it was replaced meanwhile.
+func (a *xdpAttachment) detach() error {
+	l, err := netlink.LinkByIndex(a.link.Attrs().Index)
+	if err != nil {
+		return &AttachError{a.iface, "get link", err}
+	}
+	x := l.Attrs().Xdp
+	if x == nil || !x.Attached || (a.id != 0 && x.ProgId != a.id) {
+		return nil
+	}
+	if err := netlink.LinkSetXdpFdWithFlags(a.link, -1, a.mode); err != nil {
+		return &AttachError{a.iface, "detach XDP program", err}
+	}
+	return nil
+}
+
+// NewXDP returns the xdp producer: the ebpf3 program attached at the
+// XDP hook, which sees only the packets received by the interfaces.
+// It shares the -ebpf3_buckets flags.
+func NewXDP() *Ebpf3 {
+	ebpf := New()
+	ebpf.name = "xdp"
+	ebpf.iface = xdpIface
+	ebpf.newHook = newXDPHook
+	ebpf.ingressOnly = true
+	return ebpf
+}
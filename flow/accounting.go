@@ -0,0 +1,92 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"flag"
+	"fmt"
+)
+
+// Accounting selects which part of a packet is counted. All the
+// producers derive the figures from the IP header (total length for
+// IPv4, payload length plus the fixed header for IPv6), not from the
+// length of the captured buffer, so that they agree regardless of
+// where they hook into the stack. The values are shared with the
+// eBPF programs: keep them in sync with the ACCOUNT_* defines.
+type Accounting uint32
+
+const (
+	// AccountL3 counts the IP packet, headers included.
+	AccountL3 Accounting = 0
+	// AccountL2 counts the IP packet plus an Ethernet header. The
+	// preamble, the FCS and the padding of short frames are not
+	// counted.
+	AccountL2 Accounting = 1
+	// AccountPayload counts what follows the TCP or UDP header. For
+	// other protocols it counts what follows the IP header.
+	AccountPayload Accounting = 2
+)
+
+// EthHdrLen is the length of the Ethernet header added by AccountL2.
+const EthHdrLen = 14
+
+var accounting = flag.String("accounting", "l3",
+	"Bytes to count: l2 (Ethernet frames), l3 (IP packets) or l4 (TCP/UDP payload).")
+
+// ParseAccounting converts the name of an accounting mode.
+func ParseAccounting(s string) (Accounting, error) {
+	switch s {
+	case "l2":
+		return AccountL2, nil
+	case "l3":
+		return AccountL3, nil
+	case "l4":
+		return AccountPayload, nil
+	}
+	return AccountL3, fmt.Errorf("unknown accounting mode: %s", s)
+}
+
+// AccountingMode returns the mode selected on the command line.
+func AccountingMode() (Accounting, error) {
+	return ParseAccounting(*accounting)
+}
+
+func (a Accounting) String() string {
+	switch a {
+	case AccountL2:
+		return "l2"
+	case AccountL3:
+		return "l3"
+	case AccountPayload:
+		return "l4"
+	}
+	return "unknown"
+}
+
+// Bytes returns the bytes to count for a packet ipLen bytes long, of
+// which ipHdrLen are IP headers and l4HdrLen are the TCP or UDP
+// header (0 for other protocols and non-first fragments).
+func (a Accounting) Bytes(ipLen, ipHdrLen, l4HdrLen int) uint64 {
+	switch a {
+	case AccountL2:
+		return uint64(ipLen + EthHdrLen)
+	case AccountPayload:
+		if ipLen < ipHdrLen+l4HdrLen {
+			return 0
+		}
+		return uint64(ipLen - ipHdrLen - l4HdrLen)
+	}
+	return uint64(ipLen)
+}
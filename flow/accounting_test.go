@@ -0,0 +1,69 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import "testing"
+
+func TestAccountingBytes(t *testing.T) {
+	tests := []struct {
+		name                      string
+		a                         Accounting
+		ipLen, ipHdrLen, l4HdrLen int
+		want                      uint64
+	}{
+		{"l3 tcp", AccountL3, 1500, 20, 32, 1500},
+		{"l3 ipv6", AccountL3, 1280, 40, 8, 1280},
+		{"l2 tcp", AccountL2, 1500, 20, 32, 1514},
+		{"l2 empty", AccountL2, 0, 0, 0, EthHdrLen},
+		{"l4 tcp", AccountPayload, 1500, 20, 32, 1448},
+		{"l4 udp ipv6", AccountPayload, 1280, 40, 8, 1232},
+		{"l4 ack", AccountPayload, 52, 20, 32, 0},
+		{"l4 no l4 header", AccountPayload, 84, 20, 0, 64},
+		{"l4 ipv6 extension headers", AccountPayload, 120, 56, 8, 56},
+		{"l4 truncated", AccountPayload, 40, 20, 32, 0},
+		{"unknown counts l3", Accounting(42), 1500, 20, 32, 1500},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Bytes(tt.ipLen, tt.ipHdrLen, tt.l4HdrLen); got != tt.want {
+			t.Errorf("%s: Bytes(%d, %d, %d) = %d, want %d", tt.name,
+				tt.ipLen, tt.ipHdrLen, tt.l4HdrLen, got, tt.want)
+		}
+	}
+}
+
+func TestParseAccounting(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Accounting
+		wantErr bool
+	}{
+		{"l2", AccountL2, false},
+		{"l3", AccountL3, false},
+		{"l4", AccountPayload, false},
+		{"", AccountL3, true},
+		{"L3", AccountL3, true},
+		{"payload", AccountL3, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseAccounting(tt.s)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseAccounting(%q) = %v, %v, want %v, error %t", tt.s,
+				got, err, tt.want, tt.wantErr)
+		}
+		if err == nil && got.String() != tt.s {
+			t.Errorf("ParseAccounting(%q).String() = %q", tt.s, got.String())
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"time"
+)
+
+// ConnEvent is what happened to a connection.
+type ConnEvent uint8
+
+const (
+	// ConnOpen is a connection established.
+	ConnOpen ConnEvent = iota
+	// ConnClose is a connection closed, established or not.
+	ConnClose
+)
+
+func (e ConnEvent) String() string {
+	switch e {
+	case ConnOpen:
+		return "open"
+	case ConnClose:
+		return "close"
+	}
+	return fmt.Sprintf("event(%d)", uint8(e))
+}
+
+// CloseReason tells how a connection was closed.
+type CloseReason uint8
+
+const (
+	// CloseNone is for open events.
+	CloseNone CloseReason = iota
+	// CloseLocal is a connection closed by the local end first.
+	CloseLocal
+	// CloseRemote is a connection closed by the remote end first.
+	CloseRemote
+	// CloseReset is a connection aborted by a reset of either end.
+	CloseReset
+	// CloseFailed is a connection that was never established.
+	CloseFailed
+)
+
+func (r CloseReason) String() string {
+	switch r {
+	case CloseNone:
+		return "none"
+	case CloseLocal:
+		return "local"
+	case CloseRemote:
+		return "remote"
+	case CloseReset:
+		return "reset"
+	case CloseFailed:
+		return "failed"
+	}
+	return fmt.Sprintf("reason(%d)", uint8(r))
+}
+
+// Connection is a TCP connection that was opened or closed. Src is
+// the local end and Dst the remote one, IPv4 addresses are
+// IPv4-mapped.
+type Connection struct {
+	Event   ConnEvent
+	Time    time.Time
+	Src     [16]byte
+	Dst     [16]byte
+	SrcPort uint16
+	DstPort uint16
+	// Duration is the life of a closed connection, 0 if it was
+	// opened before the producer started.
+	Duration time.Duration
+	// BytesAcked are the bytes sent and acknowledged by the remote
+	// end, BytesReceived the ones received, both payload. They are
+	// set for closed connections.
+	BytesAcked    uint64
+	BytesReceived uint64
+	Reason        CloseReason
+	// Process is the process that opened, accepted or closed the
+	// connection, if known. The open event of an accepted
+	// connection comes before accept() and has none.
+	Process Process
+}
+
+// ConnectionConsumer is implemented by the consumers that want the
+// connections seen by producers of connection events. They get them
+// after every Push.
+type ConnectionConsumer interface {
+	PushConnections(time.Time, []Connection) error
+}
@@ -20,16 +20,25 @@ import (
 )
 
 type Sample4 struct {
-	SrcIP   [4]byte `struct:"[4]byte"`
-	DstIP   [4]byte `struct:"[4]byte"`
-	SrcPort uint16  `struct:"uint16"`
-	DstPort uint16  `struct:"uint16"`
-	Proto   uint8   `struct:"uint8"`
+	SrcIP   [4]byte
+	DstIP   [4]byte
+	SrcPort uint16
+	DstPort uint16
+	Proto   uint8
+	// VLAN is the 802.1Q VLAN ID, 0 if untagged or unknown.
+	VLAN uint16
+	// Tunnel is the tunnel the flow was decapsulated from, if any.
+	Tunnel Tunnel
+	// Process is the process that sent or received the flow, if
+	// known.
+	Process Process
 }
 
 type Sample4L struct {
 	Flow Sample4
 	Tot  uint64
+	// Quality is the TCP quality of the flow, if known.
+	Quality Quality
 }
 
 type Map4 map[Sample4]uint64
@@ -37,16 +46,25 @@ type Map4 map[Sample4]uint64
 type List4 []Sample4L
 
 type Sample6 struct {
-	SrcIP   [16]byte `struct:"[16]byte"`
-	DstIP   [16]byte `struct:"[16]byte"`
-	SrcPort uint16   `struct:"uint16"`
-	DstPort uint16   `struct:"uint16"`
-	Proto   uint8    `struct:"uint8"`
+	SrcIP   [16]byte
+	DstIP   [16]byte
+	SrcPort uint16
+	DstPort uint16
+	Proto   uint8
+	// VLAN is the 802.1Q VLAN ID, 0 if untagged or unknown.
+	VLAN uint16
+	// Tunnel is the tunnel the flow was decapsulated from, if any.
+	Tunnel Tunnel
+	// Process is the process that sent or received the flow, if
+	// known.
+	Process Process
 }
 
 type Sample6L struct {
 	Flow Sample6
 	Tot  uint64
+	// Quality is the TCP quality of the flow, if known.
+	Quality Quality
 }
 
 type Map6 map[Sample6]uint64
@@ -57,7 +75,8 @@ type Consumer interface {
 	Init() error
 	Push(time.Time,
 		List4, Map4,
-		List6, Map6) error
+		List6, Map6,
+		Stats) error
 	Finalize() error
 }
 
@@ -0,0 +1,105 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import "fmt"
+
+// ICMP and ICMPv6 flows have no ports. Like NetFlow, producers put
+// the type and code of the messages in DstPort, as type*256+code, and
+// leave SrcPort zero.
+
+// IsICMP tells if proto is ICMP or ICMPv6, whose flows carry the type
+// and code in DstPort.
+func IsICMP(proto uint8) bool {
+	return proto == 1 || proto == 58
+}
+
+// ICMPTypeCode splits the DstPort of an ICMP flow.
+func ICMPTypeCode(port uint16) (typ, code uint8) {
+	return uint8(port >> 8), uint8(port)
+}
+
+var icmp4Types = map[uint8]string{
+	0:  "echo-reply",
+	3:  "destination-unreachable",
+	4:  "source-quench",
+	5:  "redirect",
+	8:  "echo-request",
+	9:  "router-advertisement",
+	10: "router-solicitation",
+	11: "time-exceeded",
+	12: "parameter-problem",
+	13: "timestamp-request",
+	14: "timestamp-reply",
+}
+
+var icmp4Unreachable = map[uint8]string{
+	0:  "network-unreachable",
+	1:  "host-unreachable",
+	2:  "protocol-unreachable",
+	3:  "port-unreachable",
+	4:  "fragmentation-needed",
+	9:  "network-prohibited",
+	10: "host-prohibited",
+	13: "communication-prohibited",
+}
+
+var icmp6Types = map[uint8]string{
+	1:   "destination-unreachable",
+	2:   "packet-too-big",
+	3:   "time-exceeded",
+	4:   "parameter-problem",
+	128: "echo-request",
+	129: "echo-reply",
+	130: "mld-query",
+	131: "mld-report",
+	132: "mld-done",
+	133: "router-solicitation",
+	134: "router-advertisement",
+	135: "neighbor-solicitation",
+	136: "neighbor-advertisement",
+	137: "redirect",
+	143: "mldv2-report",
+}
+
+var icmp6Unreachable = map[uint8]string{
+	0: "no-route",
+	1: "communication-prohibited",
+	3: "address-unreachable",
+	4: "port-unreachable",
+}
+
+// ICMPName returns the name of the message of an ICMP or ICMPv6 flow,
+// like "echo-request" or "port-unreachable", given its DstPort.
+func ICMPName(proto uint8, port uint16) string {
+	typ, code := ICMPTypeCode(port)
+	types, unreachable := icmp4Types, icmp4Unreachable
+	if proto == 58 {
+		types, unreachable = icmp6Types, icmp6Unreachable
+	}
+	name, ok := types[typ]
+	if !ok {
+		return fmt.Sprintf("type %d code %d", typ, code)
+	}
+	if name == "destination-unreachable" {
+		if n, ok := unreachable[code]; ok {
+			return n
+		}
+	}
+	if code != 0 {
+		name += fmt.Sprintf(" code %d", code)
+	}
+	return name
+}
@@ -0,0 +1,56 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Bytes used by Sample4 and Sample6 in the keys of the eBPF maps. The
+// C structs are not packed, so keys can be longer: the padding is
+// ignored.
+const (
+	Sample4Len = 13
+	Sample6Len = 37
+)
+
+// Unpack decodes the key of an IPv4 eBPF map. Addresses and ports
+// are in network byte order.
+func (s *Sample4) Unpack(b []byte) error {
+	if len(b) < Sample4Len {
+		return fmt.Errorf("ipv4 key too short: %d bytes", len(b))
+	}
+	copy(s.SrcIP[:], b[0:4])
+	copy(s.DstIP[:], b[4:8])
+	s.SrcPort = binary.BigEndian.Uint16(b[8:10])
+	s.DstPort = binary.BigEndian.Uint16(b[10:12])
+	s.Proto = b[12]
+	return nil
+}
+
+// Unpack decodes the key of an IPv6 eBPF map. Addresses and ports
+// are in network byte order.
+func (s *Sample6) Unpack(b []byte) error {
+	if len(b) < Sample6Len {
+		return fmt.Errorf("ipv6 key too short: %d bytes", len(b))
+	}
+	copy(s.SrcIP[:], b[0:16])
+	copy(s.DstIP[:], b[16:32])
+	s.SrcPort = binary.BigEndian.Uint16(b[32:34])
+	s.DstPort = binary.BigEndian.Uint16(b[34:36])
+	s.Proto = b[36]
+	return nil
+}
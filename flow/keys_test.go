@@ -0,0 +1,40 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import "testing"
+
+func BenchmarkSample4Unpack(b *testing.B) {
+	key := []byte{10, 0, 0, 1, 10, 0, 0, 2, 0x1f, 0x90, 0xc3, 0x50, 6, 0, 0, 0}
+	var s Sample4
+	for i := 0; i < b.N; i++ {
+		if err := s.Unpack(key); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSample6Unpack(b *testing.B) {
+	key := make([]byte, 40)
+	key[0], key[1], key[15] = 0x20, 0x01, 1
+	key[16], key[17], key[31] = 0x20, 0x01, 2
+	key[32], key[33], key[34], key[35], key[36] = 0x1f, 0x90, 0xc3, 0x50, 6
+	var s Sample6
+	for i := 0; i < b.N; i++ {
+		if err := s.Unpack(key); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
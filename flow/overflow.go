@@ -0,0 +1,149 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"log"
+)
+
+// Overflow is the traffic that could not be accounted to its own
+// flow because the in-kernel maps were full. Producers account it to
+// the "other" flow, the one with all fields zero, so that totals stay
+// exact.
+type Overflow struct {
+	Bytes   uint64
+	Packets uint64
+	// Flows is the number of distinct flows lost. It is a lower
+	// bound when many flows are lost, since eBPF programs remember
+	// only the most recent ones.
+	Flows uint64
+}
+
+func (o Overflow) add(p Overflow) Overflow {
+	return Overflow{
+		Bytes:   o.Bytes + p.Bytes,
+		Packets: o.Packets + p.Packets,
+		Flows:   o.Flows + p.Flows,
+	}
+}
+
+// Stats describes an interval pushed to consumers.
+type Stats struct {
+	Overflow4 Overflow
+	Overflow6 Overflow
+	// UnmatchedFrags is the number of IPv4 fragments accounted
+	// without ports because the first fragment of their datagram
+	// was not seen.
+	UnmatchedFrags uint64
+	// SampleRate is N when the producer counted 1 in N packets: the
+	// figures are then estimates, scaled up by N. It is 0 when all
+	// the packets were counted.
+	SampleRate uint32
+}
+
+// Overflow returns the overflow for both IP versions.
+func (s Stats) Overflow() Overflow {
+	return s.Overflow4.add(s.Overflow6)
+}
+
+// IsOther tells if a flow is the one collecting overflow.
+func (s Sample4) IsOther() bool {
+	return s == Sample4{}
+}
+
+// IsOther tells if a flow is the one collecting overflow.
+func (s Sample6) IsOther() bool {
+	return s == Sample6{}
+}
+
+// The eBPF programs keep monotonic overflow counters in an array,
+// OverflowFields per generation and IP version. Keep in sync with
+// the OVERFLOW_* defines.
+const (
+	overflowBytes = iota
+	overflowPackets
+	overflowFlows
+	// OverflowFields is the number of counters in a slot.
+	OverflowFields
+	// OverflowCounters is the size of the array.
+	OverflowCounters = 2 * 2 * OverflowFields
+)
+
+// OverflowCounter returns the index of counter field of generation
+// gen and IP version v6 in the overflow array.
+func OverflowCounter(gen uint32, v6 bool, field int) uint32 {
+	slot := gen << 1
+	if v6 {
+		slot |= 1
+	}
+	return slot*OverflowFields + uint32(field)
+}
+
+// OverflowReader turns the overflow counters of an eBPF program into
+// figures per interval.
+type OverflowReader struct {
+	last [OverflowCounters]uint64
+}
+
+// Read returns the overflow of generation gen since it was last read.
+// The eBPF programs must not be writing to generation gen. get reads
+// counter idx, summed over all CPUs.
+func (r *OverflowReader) Read(gen uint32,
+	get func(idx uint32) (uint64, error)) (Stats, error) {
+	var st Stats
+	for _, v6 := range []bool{false, true} {
+		var cur [OverflowFields]uint64
+		for f := range cur {
+			idx := OverflowCounter(gen, v6, f)
+			n, err := get(idx)
+			if err != nil {
+				return st, fmt.Errorf("cannot read overflow counter %d: %w", idx, err)
+			}
+			cur[f] = n - r.last[idx]
+			r.last[idx] = n
+		}
+		o := Overflow{
+			Bytes:   cur[overflowBytes],
+			Packets: cur[overflowPackets],
+			Flows:   cur[overflowFlows],
+		}
+		if v6 {
+			st.Overflow6 = o
+		} else {
+			st.Overflow4 = o
+		}
+	}
+	return st, nil
+}
+
+// AddOther accounts the overflow to the "other" flows of l4 and l6.
+func (s Stats) AddOther(l4 List4, l6 List6) (List4, List6) {
+	if s.Overflow4.Bytes > 0 {
+		l4 = append(l4, Sample4L{Tot: s.Overflow4.Bytes})
+	}
+	if s.Overflow6.Bytes > 0 {
+		l6 = append(l6, Sample6L{Tot: s.Overflow6.Bytes})
+	}
+	return l4, l6
+}
+
+// LogOverflow logs the overflow, if any, of producer name.
+func (s Stats) LogOverflow(name string) {
+	if o := s.Overflow(); o.Packets > 0 {
+		log.Printf("%s: maps full, %d flows (%d packets, %d bytes) accounted as other",
+			name, o.Flows, o.Packets, o.Bytes)
+	}
+}
@@ -0,0 +1,73 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// Process is the process that sent or received the bytes of a flow.
+// It is the zero value for producers that see packets rather than
+// sockets.
+type Process struct {
+	// PID is the thread group ID, what user space calls the pid.
+	PID uint32
+	// Comm is the name of the thread, NUL padded.
+	Comm [16]byte
+	// Cgroup is the ID of the cgroup v2 of the process, the inode
+	// number of its directory under /sys/fs/cgroup.
+	Cgroup uint64
+}
+
+// ProcessLen is the number of bytes of a Process in the keys of the
+// eBPF maps, keep in sync with struct proc_s.
+const ProcessLen = 32
+
+// IsZero tells if no process is known.
+func (p Process) IsZero() bool {
+	return p == Process{}
+}
+
+// Name returns Comm as a string.
+func (p Process) Name() string {
+	if i := bytes.IndexByte(p.Comm[:], 0); i >= 0 {
+		return string(p.Comm[:i])
+	}
+	return string(p.Comm[:])
+}
+
+// String returns something like "curl[1234] cgroup 5678", without
+// the cgroup if it is unknown.
+func (p Process) String() string {
+	s := fmt.Sprintf("%s[%d]", p.Name(), p.PID)
+	if p.Cgroup != 0 {
+		s += fmt.Sprintf(" cgroup %d", p.Cgroup)
+	}
+	return s
+}
+
+// Unpack decodes a Process from the key of an eBPF map. The numbers
+// are little endian, like the values of the maps.
+func (p *Process) Unpack(b []byte) error {
+	if len(b) < ProcessLen {
+		return fmt.Errorf("process too short: %d bytes", len(b))
+	}
+	p.PID = binary.LittleEndian.Uint32(b[0:4])
+	p.Cgroup = binary.LittleEndian.Uint64(b[8:16])
+	copy(p.Comm[:], b[16:32])
+	return nil
+}
@@ -0,0 +1,72 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// Quality is how well the TCP connection of a flow is doing, as seen
+// by its socket. It is the zero value for UDP flows and for producers
+// that see packets rather than sockets.
+type Quality struct {
+	// Retrans are the segments of the flow retransmitted in the
+	// interval.
+	Retrans uint32
+	// SRTT is the smoothed round trip time of the socket at the
+	// last call of the interval, 0 if unknown.
+	SRTT time.Duration
+}
+
+// QualityLen is the number of bytes of a Quality in the values of the
+// eBPF maps, after the byte count. Keep in sync with struct val_s.
+const QualityLen = 8
+
+// IsZero tells if nothing is known about the quality.
+func (q Quality) IsZero() bool {
+	return q == Quality{}
+}
+
+// Add merges the quality of another flow, o, into q: retransmits sum
+// up and the RTT is the highest of the two, so that a site is as slow
+// as its slowest connection whatever the order of its flows.
+func (q *Quality) Add(o Quality) {
+	q.Retrans += o.Retrans
+	if o.SRTT > q.SRTT {
+		q.SRTT = o.SRTT
+	}
+}
+
+// String returns something like "3 retransmits, rtt 12.3ms".
+func (q Quality) String() string {
+	s := fmt.Sprintf("%d retransmits", q.Retrans)
+	if q.SRTT != 0 {
+		s += fmt.Sprintf(", rtt %s", q.SRTT)
+	}
+	return s
+}
+
+// Unpack decodes a Quality from the value of an eBPF map: the
+// retransmits and the smoothed RTT in microseconds, little endian.
+func (q *Quality) Unpack(b []byte) error {
+	if len(b) < QualityLen {
+		return fmt.Errorf("quality too short: %d bytes", len(b))
+	}
+	q.Retrans = binary.LittleEndian.Uint32(b[0:4])
+	q.SRTT = time.Duration(binary.LittleEndian.Uint32(b[4:8])) * time.Microsecond
+	return nil
+}
@@ -0,0 +1,107 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"flag"
+	"fmt"
+	"math"
+)
+
+// SampleMode selects which packets the eBPF producers count when
+// sampling. The values are shared with the eBPF programs: keep them in
+// sync with the SAMPLE_* defines.
+type SampleMode uint32
+
+const (
+	// SampleRandom counts each packet with probability 1/N.
+	SampleRandom SampleMode = 0
+	// SampleDeterministic counts every Nth packet seen by each CPU.
+	SampleDeterministic SampleMode = 1
+)
+
+var (
+	sampleRate = flag.Uint("sample", 1,
+		"Count 1 in N packets in the eBPF producers and scale the counts up by N, 1 counts all of them.")
+	sampleMode = flag.String("sample_mode", "random",
+		"Packets counted when sampling: random or deterministic (every Nth on each CPU).")
+)
+
+// ParseSampleMode converts the name of a sampling mode.
+func ParseSampleMode(s string) (SampleMode, error) {
+	switch s {
+	case "random":
+		return SampleRandom, nil
+	case "deterministic":
+		return SampleDeterministic, nil
+	}
+	return SampleRandom, fmt.Errorf("unknown sampling mode: %s", s)
+}
+
+func (m SampleMode) String() string {
+	switch m {
+	case SampleRandom:
+		return "random"
+	case SampleDeterministic:
+		return "deterministic"
+	}
+	return "unknown"
+}
+
+// Sampling is the in-kernel packet sampling: 1 in Rate packets is
+// counted. A Rate of 1 counts all of them.
+type Sampling struct {
+	Rate uint32
+	Mode SampleMode
+}
+
+// SamplingMode returns the sampling selected on the command line.
+func SamplingMode() (Sampling, error) {
+	mode, err := ParseSampleMode(*sampleMode)
+	if err != nil {
+		return Sampling{}, err
+	}
+	if *sampleRate < 1 || *sampleRate > math.MaxUint32 {
+		return Sampling{}, fmt.Errorf("invalid sampling rate: %d", *sampleRate)
+	}
+	return Sampling{Rate: uint32(*sampleRate), Mode: mode}, nil
+}
+
+// Enabled tells if some packets are skipped.
+func (s Sampling) Enabled() bool {
+	return s.Rate > 1
+}
+
+// Scale estimates the totals from the packets counted, multiplying the
+// flows in l4 and l6 and the figures in st by the rate, and records
+// the rate in st. The number of overflowing flows is not scaled.
+func (s Sampling) Scale(st *Stats, l4 List4, l6 List6) {
+	if !s.Enabled() {
+		return
+	}
+	n := uint64(s.Rate)
+	for i := range l4 {
+		l4[i].Tot *= n
+	}
+	for i := range l6 {
+		l6[i].Tot *= n
+	}
+	for _, o := range []*Overflow{&st.Overflow4, &st.Overflow6} {
+		o.Bytes *= n
+		o.Packets *= n
+	}
+	st.UnmatchedFrags *= n
+	st.SampleRate = s.Rate
+}
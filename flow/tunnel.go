@@ -0,0 +1,99 @@
+package flow
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"flag"
+	"fmt"
+	"net"
+)
+
+// TunnelType is the encapsulation a flow was found in. The values are
+// shared with the eBPF programs: keep them in sync with the TUNNEL_*
+// defines.
+type TunnelType uint8
+
+const (
+	// TunnelNone is for flows not decapsulated.
+	TunnelNone TunnelType = iota
+	// TunnelVXLAN is VXLAN, on UDP port 4789.
+	TunnelVXLAN
+	// TunnelGeneve is Geneve, on UDP port 6081.
+	TunnelGeneve
+	// TunnelGRE is GRE, carrying IP or Ethernet.
+	TunnelGRE
+	// TunnelIPIP is IPv4 or IPv6 in IPv4 or IPv6.
+	TunnelIPIP
+)
+
+// Well known ports of the UDP tunnels.
+const (
+	VXLANPort  = 4789
+	GenevePort = 6081
+)
+
+func (t TunnelType) String() string {
+	switch t {
+	case TunnelNone:
+		return "none"
+	case TunnelVXLAN:
+		return "vxlan"
+	case TunnelGeneve:
+		return "geneve"
+	case TunnelGRE:
+		return "gre"
+	case TunnelIPIP:
+		return "ipip"
+	}
+	return fmt.Sprintf("tunnel(%d)", uint8(t))
+}
+
+// Tunnel is the outer header of a decapsulated flow. It is the zero
+// value for flows that were not in a tunnel.
+type Tunnel struct {
+	Type TunnelType
+	// Src and Dst are the tunnel endpoints, IPv4 ones as
+	// IPv4-mapped IPv6 addresses.
+	Src [16]byte
+	Dst [16]byte
+	// ID is the VNI of VXLAN and Geneve or the GRE key, 0 if
+	// absent.
+	ID uint32
+}
+
+// String returns something like "vxlan 10.0.0.1 -> 10.0.0.2 id 42".
+func (t Tunnel) String() string {
+	s := fmt.Sprintf("%s %s -> %s", t.Type, net.IP(t.Src[:]), net.IP(t.Dst[:]))
+	if t.ID != 0 {
+		s += fmt.Sprintf(" id %d", t.ID)
+	}
+	return s
+}
+
+// MapIPv4 returns ip, 4 bytes long, as an IPv4-mapped IPv6 address.
+func MapIPv4(ip []byte) [16]byte {
+	var m [16]byte
+	m[10], m[11] = 0xff, 0xff
+	copy(m[12:], ip)
+	return m
+}
+
+var decap = flag.Bool("decap", false,
+	"Account the traffic of VXLAN, Geneve, GRE and IPIP tunnels to the inner flows (ebpf3 and afp only).")
+
+// Decap tells if tunnels should be decapsulated.
+func Decap() bool {
+	return *decap
+}
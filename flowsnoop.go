@@ -29,18 +29,26 @@ import (
 	"github.com/chripell/flowsnoop/ebpf2"
 	"github.com/chripell/flowsnoop/ebpf3"
 	"github.com/chripell/flowsnoop/flow"
+	"github.com/chripell/flowsnoop/procnet"
 	"github.com/chripell/flowsnoop/showflows"
 	"github.com/chripell/flowsnoop/sqlflows"
 	"github.com/chripell/flowsnoop/topsites"
 )
 
-func main() {
-	producers := map[string]flow.Producer{
-		"ebpf1": ebpf1.New(),
-		"ebpf2": ebpf2.New(),
-		"ebpf3": ebpf3.New(),
-		"afp":   afp.New(),
+func newProducers() map[string]flow.Producer {
+	return map[string]flow.Producer{
+		"ebpf1":   ebpf1.New(),
+		"ebpf2":   ebpf2.New(),
+		"ebpf3":   ebpf3.New(),
+		"xdp":     ebpf3.NewXDP(),
+		"sock":    ebpf1.NewSock(),
+		"tcplife": ebpf1.NewTCPLife(),
+		"afp":     afp.New(),
 	}
+}
+
+func main() {
+	producers := newProducers()
 	consumers := map[string]flow.Consumer{
 		"topsites":  topsites.New(),
 		"showflows": showflows.New(),
@@ -59,6 +67,7 @@ func main() {
 	}
 	consumerS := flag.String("consumer", "topsites", "consumer module: "+strings.Join(consumersL, ","))
 	producerS := flag.String("producer", "ebpf3", "producer module: "+strings.Join(producersL, ","))
+	procs := flag.Bool("procs", false, "attribute TCP and UDP flows to processes from /proc, for producers that don't.")
 	flag.Parse()
 
 	sig := make(chan os.Signal, 1)
@@ -70,6 +79,9 @@ func main() {
 	if !ok {
 		log.Fatalf("No such consumer: %s", *consumerS)
 	}
+	if *procs {
+		consumer = procnet.New(consumer)
+	}
 	producer, ok := producers[*producerS]
 	if !ok {
 		log.Fatalf("No such producer: %s", *producerS)
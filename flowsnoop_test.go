@@ -0,0 +1,60 @@
+//go:build linux
+// +build linux
+
+package main
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/chripell/flowsnoop/nstest"
+)
+
+var selftestProducers = flag.String("selftest_producers", "afp,ebpf1,ebpf2,ebpf3,xdp",
+	"producers checked by TestSelftest, comma separated.")
+
+// TestSelftest checks each producer against known traffic in a
+// scratch network namespace, see nstest. Flags of the producers and
+// -accounting go after -args.
+func TestSelftest(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("the self test needs root")
+	}
+	producers := newProducers()
+	for _, name := range strings.Split(*selftestProducers, ",") {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			p, ok := producers[name]
+			if !ok {
+				t.Fatalf("no such producer: %s", name)
+			}
+			err := nstest.Run(p, func(iface string) error {
+				// Producers like sock and tcplife see all the
+				// interfaces.
+				if flag.Lookup(name+"_iface") == nil {
+					return nil
+				}
+				return flag.Set(name+"_iface", iface)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Package ifaces selects network interfaces by name patterns and
+// follows them as they appear and vanish.
+package ifaces
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// Matcher tells if an interface is selected by a list of patterns.
+type Matcher struct {
+	include []string
+	exclude []string
+}
+
+// Parse parses a comma separated list of interface names or glob
+// patterns, like "veth*,eth0,!lo". Patterns starting with ! exclude
+// interfaces. If there are only exclusions, all the other interfaces
+// are selected. "all" is the same as "*".
+func Parse(s string) (*Matcher, error) {
+	m := &Matcher{}
+	for _, p := range strings.Split(s, ",") {
+		p = strings.TrimSpace(p)
+		exclude := strings.HasPrefix(p, "!")
+		if exclude {
+			p = strings.TrimSpace(p[1:])
+		}
+		if p == "" {
+			continue
+		}
+		if p == "all" {
+			p = "*"
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("bad interface pattern %q: %w", p, err)
+		}
+		if exclude {
+			m.exclude = append(m.exclude, p)
+		} else {
+			m.include = append(m.include, p)
+		}
+	}
+	if len(m.include) == 0 && len(m.exclude) == 0 {
+		return nil, fmt.Errorf("no interfaces specified")
+	}
+	if len(m.include) == 0 {
+		m.include = []string{"*"}
+	}
+	return m, nil
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Match tells if interface name is selected.
+func (m *Matcher) Match(name string) bool {
+	return matchAny(m.include, name) && !matchAny(m.exclude, name)
+}
+
+// All tells if all the interfaces are selected.
+func (m *Matcher) All() bool {
+	if len(m.exclude) > 0 {
+		return false
+	}
+	for _, p := range m.include {
+		if p == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// Link is a network interface.
+type Link struct {
+	Name  string
+	Index int
+	// Encap is the link type, like "ether", or "none" for tun and
+	// WireGuard interfaces.
+	Encap string
+}
+
+// Ethernet tells if the packets of l start with an Ethernet header.
+func (l Link) Ethernet() bool {
+	return l.Encap == "ether" || l.Encap == "loopback"
+}
+
+// Tracker follows the interfaces selected by a Matcher, calling add
+// when one appears or is renamed to a selected name, and del when it
+// vanishes or is renamed to a name not selected. gone tells del that
+// the interface does not exist anymore.
+type Tracker struct {
+	name    string
+	m       *Matcher
+	add     func(l Link) error
+	del     func(l Link, gone bool)
+	mu      sync.Mutex
+	links   map[int]Link
+	done    chan struct{}
+	stopped bool
+}
+
+// NewTracker returns a tracker calling add and del, name is used in
+// logs.
+func NewTracker(name string, m *Matcher, add func(l Link) error,
+	del func(l Link, gone bool)) *Tracker {
+	return &Tracker{
+		name:  name,
+		m:     m,
+		add:   add,
+		del:   del,
+		links: make(map[int]Link),
+		done:  make(chan struct{}),
+	}
+}
+
+// Start calls add for the selected interfaces present, failing if it
+// fails for any of them, and then follows link events in the
+// background. Later failures of add are only logged.
+func (t *Tracker) Start() error {
+	ch := make(chan netlink.LinkUpdate, 64)
+	// Subscribe before listing the interfaces not to miss any,
+	// events for the ones listed are ignored.
+	if err := netlink.LinkSubscribeWithOptions(ch, t.done, netlink.LinkSubscribeOptions{
+		ErrorCallback: func(err error) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			if !t.stopped {
+				log.Printf("%s: link events: %v", t.name, err)
+			}
+		},
+	}); err != nil {
+		return fmt.Errorf("cannot subscribe to link events: %w", err)
+	}
+	links, err := netlink.LinkList()
+	if err != nil {
+		t.Stop()
+		return fmt.Errorf("cannot list interfaces: %w", err)
+	}
+	t.mu.Lock()
+	for _, l := range links {
+		if err := t.update(l); err != nil {
+			t.mu.Unlock()
+			t.Stop()
+			return err
+		}
+	}
+	t.mu.Unlock()
+	go func() {
+		for u := range ch {
+			t.mu.Lock()
+			if t.stopped {
+				// Drain until the subscription ends.
+				t.mu.Unlock()
+				continue
+			}
+			switch u.Header.Type {
+			case unix.RTM_NEWLINK:
+				if err := t.update(u.Link); err != nil {
+					log.Printf("%s: %v", t.name, err)
+				}
+			case unix.RTM_DELLINK:
+				if l, ok := t.links[u.Attrs().Index]; ok {
+					delete(t.links, l.Index)
+					t.del(l, true)
+				}
+			}
+			t.mu.Unlock()
+		}
+	}()
+	return nil
+}
+
+// update handles the appearance or a change of interface nl, t.mu
+// must be held.
+func (t *Tracker) update(nl netlink.Link) error {
+	a := nl.Attrs()
+	old, known := t.links[a.Index]
+	if known {
+		if old.Name == a.Name {
+			return nil
+		}
+		delete(t.links, old.Index)
+		t.del(old, false)
+	}
+	if !t.m.Match(a.Name) {
+		return nil
+	}
+	l := Link{Name: a.Name, Index: a.Index, Encap: a.EncapType}
+	if err := t.add(l); err != nil {
+		return err
+	}
+	t.links[l.Index] = l
+	return nil
+}
+
+// Stop stops following link events. When it returns add and del are
+// not running and will not be called anymore.
+func (t *Tracker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.stopped {
+		t.stopped = true
+		close(t.done)
+	}
+}
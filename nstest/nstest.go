@@ -0,0 +1,307 @@
+// Package nstest is a Linux integration test harness for producers. It
+// creates a veth pair between the host and a scratch network
+// namespace, sends traffic with known sizes across it and checks
+// that a producer attached to the host side of the pair reports the
+// expected flows.
+package nstest
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/chripell/flowsnoop/flow"
+	"github.com/vishvananda/netns"
+)
+
+const (
+	hostIP4 = "10.213.0.1"
+	peerIP4 = "10.213.0.2"
+	hostIP6 = "fd00:213::1"
+	peerIP6 = "fd00:213::2"
+)
+
+// Harness is a veth pair with one end, HostIf, in the current
+// network namespace and the other end, PeerIf, in namespace NS.
+type Harness struct {
+	NS     string
+	HostIf string
+	PeerIf string
+
+	HostIP4 net.IP
+	PeerIP4 net.IP
+	HostIP6 net.IP
+	PeerIP6 net.IP
+
+	// Reverse makes the traffic flow from the namespace to the
+	// host, so that HostIf receives it.
+	Reverse bool
+	// NoFragPorts tells that the producer accounts IPv4 fragments
+	// other than the first without ports.
+	NoFragPorts bool
+
+	ns netns.NsHandle
+}
+
+func run(prog string, args ...string) error {
+	cmd := exec.Command(prog, args...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s failed: %v: %s", strings.Join(cmd.Args, " "),
+			err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func macOf(iface string) (string, error) {
+	ifi, err := net.InterfaceByName(iface)
+	if err != nil {
+		return "", err
+	}
+	return ifi.HardwareAddr.String(), nil
+}
+
+// waitUp waits for the carrier of the veth, which is only there when
+// both ends are up.
+func waitUp(iface string) error {
+	fname := filepath.Join("/sys/class/net", iface, "operstate")
+	for start := time.Now(); time.Since(start) < 5*time.Second; {
+		state, err := ioutil.ReadFile(fname)
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(string(state)) == "up" {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return fmt.Errorf("%s is not coming up", iface)
+}
+
+// Setup creates the namespace and the veth pair and configures
+// addresses and static neighbours on both ends, so that no ARP or
+// neighbour discovery traffic is mixed with the generated one.
+func Setup() (*Harness, error) {
+	id := os.Getpid() % 10000
+	h := &Harness{
+		NS:      fmt.Sprintf("flowsnoop%d", id),
+		HostIf:  fmt.Sprintf("fsnh%d", id),
+		PeerIf:  fmt.Sprintf("fsnp%d", id),
+		HostIP4: net.ParseIP(hostIP4).To4(),
+		PeerIP4: net.ParseIP(peerIP4).To4(),
+		HostIP6: net.ParseIP(hostIP6),
+		PeerIP6: net.ParseIP(peerIP6),
+		ns:      netns.None(),
+	}
+	if err := run("ip", "netns", "add", h.NS); err != nil {
+		return nil, err
+	}
+	if err := h.configure(); err != nil {
+		h.Close()
+		return nil, err
+	}
+	return h, nil
+}
+
+func (h *Harness) configure() error {
+	if err := run("ip", "link", "add", h.HostIf, "type", "veth",
+		"peer", "name", h.PeerIf, "netns", h.NS); err != nil {
+		return err
+	}
+	nsx := []string{"ip", "netns", "exec", h.NS, "ip"}
+	cmds := [][]string{
+		{"ip", "addr", "add", hostIP4 + "/24", "dev", h.HostIf},
+		{"ip", "addr", "add", hostIP6 + "/64", "dev", h.HostIf, "nodad"},
+		{"ip", "link", "set", h.HostIf, "up"},
+		append(nsx, "addr", "add", peerIP4+"/24", "dev", h.PeerIf),
+		append(nsx, "addr", "add", peerIP6+"/64", "dev", h.PeerIf, "nodad"),
+		append(nsx, "link", "set", h.PeerIf, "up"),
+		append(nsx, "link", "set", "lo", "up"),
+	}
+	for _, c := range cmds {
+		if err := run(c[0], c[1:]...); err != nil {
+			return err
+		}
+	}
+	if err := waitUp(h.HostIf); err != nil {
+		return err
+	}
+	hostMAC, err := macOf(h.HostIf)
+	if err != nil {
+		return err
+	}
+	var peerMAC string
+	if err := h.InNS(func() (err error) {
+		peerMAC, err = macOf(h.PeerIf)
+		return err
+	}); err != nil {
+		return err
+	}
+	cmds = [][]string{
+		{"ip", "neigh", "replace", peerIP4, "lladdr", peerMAC, "nud", "permanent", "dev", h.HostIf},
+		{"ip", "neigh", "replace", peerIP6, "lladdr", peerMAC, "nud", "permanent", "dev", h.HostIf},
+		append(nsx, "neigh", "replace", hostIP4, "lladdr", hostMAC, "nud", "permanent", "dev", h.PeerIf),
+		append(nsx, "neigh", "replace", hostIP6, "lladdr", hostMAC, "nud", "permanent", "dev", h.PeerIf),
+	}
+	for _, c := range cmds {
+		if err := run(c[0], c[1:]...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InNS runs f with the calling thread switched to the test
+// namespace. Sockets created by f stay in the test namespace and can
+// be used after InNS returns.
+func (h *Harness) InNS(f func() error) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	orig, err := netns.Get()
+	if err != nil {
+		return fmt.Errorf("cannot get current namespace: %w", err)
+	}
+	defer orig.Close()
+	if !h.ns.IsOpen() {
+		if h.ns, err = netns.GetFromName(h.NS); err != nil {
+			return fmt.Errorf("cannot open namespace %s: %w", h.NS, err)
+		}
+	}
+	if err := netns.Set(h.ns); err != nil {
+		return fmt.Errorf("cannot enter namespace %s: %w", h.NS, err)
+	}
+	defer netns.Set(orig)
+	return f()
+}
+
+// atSender runs f on the side sending the traffic.
+func (h *Harness) atSender(f func() error) error {
+	if h.Reverse {
+		return h.InNS(f)
+	}
+	return f()
+}
+
+// atReceiver runs f on the side receiving the traffic.
+func (h *Harness) atReceiver(f func() error) error {
+	if h.Reverse {
+		return f()
+	}
+	return h.InNS(f)
+}
+
+// Close removes the namespace. This destroys the veth pair as well.
+func (h *Harness) Close() error {
+	if h.ns.IsOpen() {
+		h.ns.Close()
+	}
+	return run("ip", "netns", "del", h.NS)
+}
+
+// IngressOnly is implemented by producers that see only the packets
+// received by the interfaces, like XDP ones. Run sends them the
+// traffic from the namespace and checks only the flows received.
+type IngressOnly interface {
+	IngressOnly() bool
+}
+
+// FragmentTracker is implemented by producers that account IPv4
+// fragments to the ports of the first fragment of their datagram.
+// Run expects the other producers to account the fragments but the
+// first without ports.
+type FragmentTracker interface {
+	TracksFragments() bool
+}
+
+// Run sets up the harness, calls configure with the name of the host
+// side interface so that the producer can be pointed to it, runs p
+// while generating the reference traffic and finally checks the
+// flows p reported, according to the accounting mode in use.
+func Run(p flow.Producer, configure func(iface string) error) (err error) {
+	sampling, err := flow.SamplingMode()
+	if err != nil {
+		return err
+	}
+	if sampling.Enabled() {
+		return errors.New("self test needs all the packets counted, run it with -sample 1")
+	}
+	h, err := Setup()
+	if err != nil {
+		return fmt.Errorf("harness setup failed: %w", err)
+	}
+	defer func() {
+		if cerr := h.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	if ing, ok := p.(IngressOnly); ok && ing.IngressOnly() {
+		h.Reverse = true
+	}
+	if ft, ok := p.(FragmentTracker); !ok || !ft.TracksFragments() {
+		h.NoFragPorts = true
+	}
+	if err := configure(h.HostIf); err != nil {
+		return fmt.Errorf("producer configuration failed: %w", err)
+	}
+	accounting, err := flow.AccountingMode()
+	if err != nil {
+		return err
+	}
+	rec := NewRecorder()
+	if err := p.Init(rec); err != nil {
+		return fmt.Errorf("producer init failed: %w", err)
+	}
+	dump := make(chan (chan<- error))
+	ctx, cancel := context.WithCancel(context.Background())
+	p.Run(ctx, dump)
+	flush := func() error {
+		errCh := make(chan error)
+		dump <- errCh
+		return <-errCh
+	}
+	// Let the producer settle and throw away whatever it saw
+	// before the reference traffic starts.
+	time.Sleep(200 * time.Millisecond)
+	err = flush()
+	if err == nil {
+		rec.Reset()
+		var exp []Expect
+		exp, err = h.Generate()
+		if err == nil {
+			time.Sleep(200 * time.Millisecond)
+			err = flush()
+		}
+		if err == nil {
+			if h.Reverse {
+				exp = h.received(exp)
+			}
+			err = rec.Check(exp, accounting)
+		}
+	}
+	cancel()
+	if ferr := p.Finalize(); err == nil && ferr != nil {
+		err = fmt.Errorf("producer finalization failed: %w", ferr)
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package nstest
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/chripell/flowsnoop/flow"
+)
+
+// Recorder is a consumer summing up all the flows it is pushed.
+type Recorder struct {
+	flows4    flow.Map4
+	flows6    flow.Map6
+	overflow  flow.Overflow
+	unmatched uint64
+}
+
+func (r *Recorder) Init() error {
+	return nil
+}
+
+func (r *Recorder) Push(tick time.Time,
+	flowsL4 flow.List4, flowsM4 flow.Map4,
+	flowsL6 flow.List6, flowsM6 flow.Map6,
+	stats flow.Stats) error {
+	for _, fl := range flowsL4 {
+		r.flows4[fl.Flow] += fl.Tot
+	}
+	for fl, tot := range flowsM4 {
+		r.flows4[fl] += tot
+	}
+	for _, fl := range flowsL6 {
+		r.flows6[fl.Flow] += fl.Tot
+	}
+	for fl, tot := range flowsM6 {
+		r.flows6[fl] += tot
+	}
+	o := stats.Overflow()
+	r.overflow.Bytes += o.Bytes
+	r.overflow.Packets += o.Packets
+	r.overflow.Flows += o.Flows
+	r.unmatched += stats.UnmatchedFrags
+	return nil
+}
+
+func (r *Recorder) Finalize() error {
+	return nil
+}
+
+// Reset forgets all the flows recorded so far.
+func (r *Recorder) Reset() {
+	r.flows4 = make(flow.Map4)
+	r.flows6 = make(flow.Map6)
+	r.overflow = flow.Overflow{}
+	r.unmatched = 0
+}
+
+func describe(srcIP, dstIP []byte, srcPort, dstPort uint16, proto uint8,
+	tun flow.Tunnel) string {
+	src := net.TCPAddr{IP: net.IP(srcIP), Port: int(srcPort)}
+	dst := net.TCPAddr{IP: net.IP(dstIP), Port: int(dstPort)}
+	s := fmt.Sprintf("%s -> %s, %s", src.String(), dst.String(),
+		flow.NewProto(proto).String())
+	if tun.Type != flow.TunnelNone {
+		s += " in " + tun.String()
+	}
+	return s
+}
+
+// Check compares the recorded flows with the expected ones, given the
+// accounting mode of the producer, and returns an error listing all
+// the mismatches. Overflowing the producer maps and fragments not
+// matched to their datagram are mismatches too.
+func (r *Recorder) Check(exp []Expect, a flow.Accounting) error {
+	var errs []string
+	if o := r.overflow; o.Packets > 0 {
+		errs = append(errs, fmt.Sprintf("maps overflowed: %d flows, %d packets, %d bytes",
+			o.Flows, o.Packets, o.Bytes))
+	}
+	if r.unmatched > 0 {
+		errs = append(errs, fmt.Sprintf("%d fragments not matched to their datagram", r.unmatched))
+	}
+	for _, e := range exp {
+		want, atLeast := e.Bytes(a)
+		var (
+			got  uint64
+			desc string
+		)
+		if e.Flow4 != nil {
+			got = r.flows4[*e.Flow4]
+			desc = describe(e.Flow4.SrcIP[:], e.Flow4.DstIP[:],
+				e.Flow4.SrcPort, e.Flow4.DstPort, e.Flow4.Proto, e.Flow4.Tunnel)
+		} else {
+			got = r.flows6[*e.Flow6]
+			desc = describe(e.Flow6.SrcIP[:], e.Flow6.DstIP[:],
+				e.Flow6.SrcPort, e.Flow6.DstPort, e.Flow6.Proto, e.Flow6.Tunnel)
+		}
+		switch {
+		case atLeast && got < want:
+			errs = append(errs, fmt.Sprintf("%s (%s): got %d bytes, want at least %d",
+				e.Name, desc, got, want))
+		case !atLeast && got != want:
+			errs = append(errs, fmt.Sprintf("%s (%s): got %d bytes, want %d",
+				e.Name, desc, got, want))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%d mismatches:\n%s", len(errs), strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func NewRecorder() *Recorder {
+	r := &Recorder{}
+	r.Reset()
+	return r
+}
@@ -0,0 +1,446 @@
+package nstest
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"time"
+
+	"github.com/chripell/flowsnoop/flow"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ip4HdrLen  = 20
+	ip6HdrLen  = 40
+	udpHdrLen  = 8
+	fragHdrLen = 8
+	// A hop-by-hop or destination options header with a PadN option.
+	optHdrLen  = 8
+	icmpHdrLen = 8
+	tcpHdrLen  = 20
+
+	udpPort = 5001
+	tcpPort = 5002
+
+	count   = 10
+	udpSize = 300
+	// Larger than the MTU, so that datagrams are fragmented.
+	fragSize = 3000
+	pingLen  = 56
+	tcpSize  = 64 * 1024
+
+	timeout = 2 * time.Second
+)
+
+// Expect is a flow the producer should have reported. Exactly one of
+// Flow4 and Flow6 is set. The flow is made of Packets packets, each
+// with a L4Hdr bytes TCP or UDP header, carrying Payload bytes
+// overall. If AtLeast is set, Packets and L4Hdr are lower bounds
+// because they depend on the kernel (e.g. TCP acknowledgements and
+// options), otherwise they are exact. IPv6 packets also carry IPExt
+// bytes of extension headers each. Frags of the packets are fragments
+// other than the first of their datagram, without L4 header. Tunneled
+// packets have Outer bytes of headers before the inner IP header.
+type Expect struct {
+	Name    string
+	Flow4   *flow.Sample4
+	Flow6   *flow.Sample6
+	Packets uint64
+	Frags   uint64
+	L4Hdr   uint64
+	IPExt   uint64
+	Outer   uint64
+	Payload uint64
+	AtLeast bool
+}
+
+// Bytes returns the bytes the producer should report with the given
+// accounting mode and whether they are just a lower bound.
+func (e *Expect) Bytes(a flow.Accounting) (uint64, bool) {
+	ipHdr := ip6HdrLen + e.IPExt + e.Outer
+	if e.Flow4 != nil {
+		ipHdr = ip4HdrLen + e.Outer
+	}
+	l4Hdr := (e.Packets - e.Frags) * e.L4Hdr
+	switch a {
+	case flow.AccountL2:
+		return e.Packets*(flow.EthHdrLen+ipHdr) + l4Hdr + e.Payload, e.AtLeast
+	case flow.AccountPayload:
+		return e.Payload, false
+	}
+	return e.Packets*ipHdr + l4Hdr + e.Payload, e.AtLeast
+}
+
+func newExpect(name string, src, dst net.IP, sport, dport int, proto uint8,
+	packets, l4Hdr, payload uint64, atLeast bool) Expect {
+	e := Expect{
+		Name:    name,
+		Packets: packets,
+		L4Hdr:   l4Hdr,
+		Payload: payload,
+		AtLeast: atLeast,
+	}
+	if src.To4() != nil {
+		s := &flow.Sample4{
+			SrcPort: uint16(sport),
+			DstPort: uint16(dport),
+			Proto:   proto,
+		}
+		copy(s.SrcIP[:], src.To4())
+		copy(s.DstIP[:], dst.To4())
+		e.Flow4 = s
+	} else {
+		s := &flow.Sample6{
+			SrcPort: uint16(sport),
+			DstPort: uint16(dport),
+			Proto:   proto,
+		}
+		copy(s.SrcIP[:], src.To16())
+		copy(s.DstIP[:], dst.To16())
+		e.Flow6 = s
+	}
+	return e
+}
+
+// endpoints returns the source and destination addresses of the
+// traffic of an IP version.
+func (h *Harness) endpoints(v6 bool) (src, dst net.IP) {
+	src, dst = h.HostIP4, h.PeerIP4
+	if v6 {
+		src, dst = h.HostIP6, h.PeerIP6
+	}
+	if h.Reverse {
+		src, dst = dst, src
+	}
+	return src, dst
+}
+
+// received returns the flows of exp whose packets HostIf receives,
+// those sent by the namespace.
+func (h *Harness) received(exp []Expect) []Expect {
+	var r []Expect
+	for _, e := range exp {
+		var from bool
+		switch {
+		case e.Flow4 != nil && e.Flow4.Tunnel.Type != flow.TunnelNone:
+			from = net.IP(e.Flow4.Tunnel.Src[:]).Equal(h.PeerIP4)
+		case e.Flow4 != nil:
+			from = net.IP(e.Flow4.SrcIP[:]).Equal(h.PeerIP4)
+		default:
+			from = net.IP(e.Flow6.SrcIP[:]).Equal(h.PeerIP6)
+		}
+		if from {
+			r = append(r, e)
+		}
+	}
+	return r
+}
+
+// Generate sends the reference traffic from the host to the
+// namespace, or the other way around if h.Reverse is set, and returns
+// the flows it is expected to produce.
+func (h *Harness) Generate() ([]Expect, error) {
+	var exp []Expect
+	src4, dst4 := h.endpoints(false)
+	src6, dst6 := h.endpoints(true)
+	gens := []func() ([]Expect, error){
+		func() ([]Expect, error) { return h.udp(src4, dst4) },
+		func() ([]Expect, error) { return h.udpFrag(src4, dst4) },
+		func() ([]Expect, error) { return h.udp(src6, dst6) },
+		func() ([]Expect, error) { return h.udpOpts(src6, dst6) },
+		func() ([]Expect, error) { return h.udpFrag(src6, dst6) },
+		func() ([]Expect, error) { return h.ping(src4, dst4) },
+		func() ([]Expect, error) { return h.ping(src6, dst6) },
+		func() ([]Expect, error) { return h.tcp(src4, dst4) },
+		func() ([]Expect, error) { return h.tcp(src6, dst6) },
+	}
+	if flow.Decap() {
+		gens = append(gens, h.tunnels)
+	}
+	for _, g := range gens {
+		e, err := g()
+		if err != nil {
+			return nil, err
+		}
+		exp = append(exp, e...)
+	}
+	return exp, nil
+}
+
+// udp sends count datagrams of udpSize bytes and waits for all of
+// them to be received.
+func (h *Harness) udp(src, dst net.IP) ([]Expect, error) {
+	sport, err := h.sendUDP(src, dst, udpSize, nil)
+	if err != nil {
+		return nil, err
+	}
+	return []Expect{
+		newExpect("udp", src, dst, sport, udpPort, 17,
+			count, udpHdrLen, count*udpSize, false),
+	}, nil
+}
+
+// udpOpts is udp with hop-by-hop and destination options headers in
+// front of the UDP header.
+func (h *Harness) udpOpts(src, dst net.IP) ([]Expect, error) {
+	// Next header (filled by the kernel), length in 8 bytes units
+	// after the first 8 and a PadN option filling the rest.
+	opt := []byte{0, 0, 1, 4, 0, 0, 0, 0}
+	sport, err := h.sendUDP(src, dst, udpSize, func(c *net.UDPConn) error {
+		raw, err := c.SyscallConn()
+		if err != nil {
+			return err
+		}
+		var serr error
+		if err := raw.Control(func(fd uintptr) {
+			for _, o := range []int{unix.IPV6_HOPOPTS, unix.IPV6_DSTOPTS} {
+				if serr == nil {
+					serr = unix.SetsockoptString(int(fd), unix.IPPROTO_IPV6, o, string(opt))
+				}
+			}
+		}); err != nil {
+			return err
+		}
+		return serr
+	})
+	if err != nil {
+		return nil, err
+	}
+	e := newExpect("udp with options", src, dst, sport, udpPort, 17,
+		count, udpHdrLen, count*udpSize, false)
+	e.IPExt = 2 * optHdrLen
+	return []Expect{e}, nil
+}
+
+// udpFrag sends count datagrams of fragSize bytes, fragmented by the
+// sender. IPv4 fragments are accounted to the ports of the first one,
+// unless h.NoFragPorts is set and only the first one has them. IPv6
+// fragments have no ports, the UDP header of the first one is
+// accounted as payload.
+func (h *Harness) udpFrag(src, dst net.IP) ([]Expect, error) {
+	ifc, err := net.InterfaceByName(h.HostIf)
+	if err != nil {
+		return nil, err
+	}
+	sport, err := h.sendUDP(src, dst, fragSize, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := uint64(udpHdrLen + fragSize)
+	if src.To4() != nil {
+		// Fragments but the last carry a multiple of 8 bytes.
+		per := uint64(ifc.MTU-ip4HdrLen) &^ 7
+		frags := count * ((n+per-1)/per - 1)
+		if h.NoFragPorts {
+			first := newExpect("udp first fragments", src, dst, sport, udpPort, 17,
+				count, udpHdrLen, count*(per-udpHdrLen), false)
+			rest := newExpect("udp fragments", src, dst, 0, 0, 17,
+				frags, 0, count*(n-per), false)
+			rest.Frags = frags
+			return []Expect{first, rest}, nil
+		}
+		e := newExpect("udp fragments", src, dst, sport, udpPort, 17,
+			count+frags, udpHdrLen, count*fragSize, false)
+		e.Frags = frags
+		return []Expect{e}, nil
+	}
+	per := uint64(ifc.MTU-ip6HdrLen-fragHdrLen) &^ 7
+	e := newExpect("udp fragments", src, dst, 0, 0, 17,
+		count*((n+per-1)/per), 0, count*n, false)
+	e.IPExt = fragHdrLen
+	return []Expect{e}, nil
+}
+
+// sendUDP sends count datagrams of size bytes from a socket set up by
+// setup, if not nil, and waits for all of them to be received. It
+// returns the source port.
+func (h *Harness) sendUDP(src, dst net.IP, size int,
+	setup func(c *net.UDPConn) error) (int, error) {
+	var srv, cl *net.UDPConn
+	if err := h.atReceiver(func() (err error) {
+		srv, err = net.ListenUDP("udp", &net.UDPAddr{IP: dst, Port: udpPort})
+		return err
+	}); err != nil {
+		return 0, fmt.Errorf("udp listen failed: %w", err)
+	}
+	defer srv.Close()
+	if err := h.atSender(func() (err error) {
+		cl, err = net.DialUDP("udp", &net.UDPAddr{IP: src},
+			&net.UDPAddr{IP: dst, Port: udpPort})
+		return err
+	}); err != nil {
+		return 0, fmt.Errorf("udp dial failed: %w", err)
+	}
+	defer cl.Close()
+	if setup != nil {
+		if err := setup(cl); err != nil {
+			return 0, fmt.Errorf("udp setup failed: %w", err)
+		}
+	}
+	buf := make([]byte, size)
+	for i := 0; i < count; i++ {
+		if _, err := cl.Write(buf); err != nil {
+			return 0, fmt.Errorf("udp write failed: %w", err)
+		}
+	}
+	srv.SetReadDeadline(time.Now().Add(timeout))
+	for i := 0; i < count; i++ {
+		if _, _, err := srv.ReadFromUDP(buf); err != nil {
+			return 0, fmt.Errorf("udp read failed: %w", err)
+		}
+	}
+	return cl.LocalAddr().(*net.UDPAddr).Port, nil
+}
+
+// icmpPort is the destination port of flows of ICMP messages of type
+// t and code 0.
+func icmpPort(t icmp.Type) int {
+	switch t := t.(type) {
+	case ipv4.ICMPType:
+		return int(t) << 8
+	case ipv6.ICMPType:
+		return int(t) << 8
+	}
+	return 0
+}
+
+// ping sends count echo requests with pingLen bytes of payload and
+// waits for the replies.
+func (h *Harness) ping(src, dst net.IP) ([]Expect, error) {
+	var (
+		network    string
+		proto      uint8
+		typ, reply icmp.Type
+	)
+	if src.To4() != nil {
+		network, proto, typ, reply = "ip4:icmp", 1, ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+	} else {
+		network, proto, typ, reply = "ip6:ipv6-icmp", 58, ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+	var c *icmp.PacketConn
+	if err := h.atSender(func() (err error) {
+		c, err = icmp.ListenPacket(network, src.String())
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("icmp listen failed: %w", err)
+	}
+	defer c.Close()
+	id := os.Getpid() & 0xffff
+	for i := 0; i < count; i++ {
+		msg := icmp.Message{
+			Type: typ,
+			Body: &icmp.Echo{
+				ID:   id,
+				Seq:  i,
+				Data: make([]byte, pingLen),
+			},
+		}
+		b, err := msg.Marshal(nil)
+		if err != nil {
+			return nil, fmt.Errorf("icmp marshal failed: %w", err)
+		}
+		if _, err := c.WriteTo(b, &net.IPAddr{IP: dst}); err != nil {
+			return nil, fmt.Errorf("icmp write failed: %w", err)
+		}
+	}
+	c.SetReadDeadline(time.Now().Add(timeout))
+	buf := make([]byte, 1500)
+	for got := 0; got < count; {
+		n, _, err := c.ReadFrom(buf)
+		if err != nil {
+			return nil, fmt.Errorf("icmp read failed: %w", err)
+		}
+		msg, err := icmp.ParseMessage(int(proto), buf[:n])
+		if err != nil {
+			continue
+		}
+		if echo, ok := msg.Body.(*icmp.Echo); ok &&
+			msg.Type != typ && echo.ID == id {
+			got++
+		}
+	}
+	// ICMP has the type and code in the destination port, its header
+	// is accounted as payload.
+	n := uint64(count * (icmpHdrLen + pingLen))
+	return []Expect{
+		newExpect("ping request", src, dst, 0, icmpPort(typ), proto, count, 0, n, false),
+		newExpect("ping reply", dst, src, 0, icmpPort(reply), proto, count, 0, n, false),
+	}, nil
+}
+
+// tcp sends tcpSize bytes over a fresh connection. Only the payload
+// is known exactly, the number of segments is a lower bound.
+func (h *Harness) tcp(src, dst net.IP) ([]Expect, error) {
+	var l net.Listener
+	if err := h.atReceiver(func() (err error) {
+		l, err = net.ListenTCP("tcp", &net.TCPAddr{IP: dst, Port: tcpPort})
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("tcp listen failed: %w", err)
+	}
+	defer l.Close()
+	done := make(chan error, 1)
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			done <- err
+			return
+		}
+		defer c.Close()
+		_, err = io.Copy(ioutil.Discard, c)
+		done <- err
+	}()
+	d := net.Dialer{
+		LocalAddr: &net.TCPAddr{IP: src},
+		Timeout:   timeout,
+	}
+	var c net.Conn
+	err := h.atSender(func() (err error) {
+		c, err = d.Dial("tcp", (&net.TCPAddr{IP: dst, Port: tcpPort}).String())
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tcp dial failed: %w", err)
+	}
+	sport := c.LocalAddr().(*net.TCPAddr).Port
+	if _, err := c.Write(make([]byte, tcpSize)); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("tcp write failed: %w", err)
+	}
+	c.(*net.TCPConn).CloseWrite()
+	select {
+	case err = <-done:
+	case <-time.After(timeout):
+		err = fmt.Errorf("timeout")
+	}
+	c.Close()
+	if err != nil {
+		return nil, fmt.Errorf("tcp transfer failed: %w", err)
+	}
+	return []Expect{
+		// SYN, ACK, data and FIN.
+		newExpect("tcp", src, dst, sport, tcpPort, 6, 4, tcpHdrLen, tcpSize, true),
+		// SYN-ACK and ACK of the FIN.
+		newExpect("tcp reply", dst, src, tcpPort, sport, 6, 2, tcpHdrLen, 0, true),
+	}, nil
+}
@@ -0,0 +1,167 @@
+package nstest
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/chripell/flowsnoop/flow"
+)
+
+// The inner flow carried by the tunnels, a UDP one between
+// documentation addresses.
+var (
+	innerSrc = net.IPv4(192, 0, 2, 1).To4()
+	innerDst = net.IPv4(192, 0, 2, 2).To4()
+)
+
+const (
+	innerSport = 1000
+	innerDport = 2000
+	innerSize  = 100
+
+	// The VNI of VXLAN and Geneve and the GRE key.
+	tunnelID = 42
+
+	ethHdrLen    = 14
+	vxlanHdrLen  = 8
+	geneveHdrLen = 8
+	// A Geneve option without data.
+	geneveOptLen = 4
+	// GRE header with a key.
+	greHdrLen = 8
+
+	ethPIPv4 = 0x0800
+	ethPTEB  = 0x6558
+)
+
+// innerPacket returns the IPv4 UDP packet of the inner flow. Checksums
+// are left to zero, nobody checks them.
+func innerPacket() []byte {
+	b := make([]byte, ip4HdrLen+udpHdrLen+innerSize)
+	b[0] = 0x45
+	binary.BigEndian.PutUint16(b[2:], uint16(len(b)))
+	b[8] = 64
+	b[9] = 17
+	copy(b[12:], innerSrc)
+	copy(b[16:], innerDst)
+	u := b[ip4HdrLen:]
+	binary.BigEndian.PutUint16(u[0:], innerSport)
+	binary.BigEndian.PutUint16(u[2:], innerDport)
+	binary.BigEndian.PutUint16(u[4:], udpHdrLen+innerSize)
+	return b
+}
+
+// ethHeader returns an Ethernet header for an IPv4 packet.
+func ethHeader() []byte {
+	b := make([]byte, ethHdrLen)
+	binary.BigEndian.PutUint16(b[12:], ethPIPv4)
+	return b
+}
+
+// tunnels sends count packets of the inner flow in each of the
+// supported tunnels over IPv4. No one decapsulates them, but the
+// receiver has a socket for each tunnel so that it does not answer
+// with ICMP errors.
+func (h *Harness) tunnels() ([]Expect, error) {
+	src, dst := h.endpoints(false)
+	inner := innerPacket()
+	vxlan := make([]byte, vxlanHdrLen)
+	vxlan[0] = 0x08
+	binary.BigEndian.PutUint32(vxlan[4:], tunnelID<<8)
+	geneve := make([]byte, geneveHdrLen+geneveOptLen)
+	geneve[0] = geneveOptLen / 4
+	binary.BigEndian.PutUint16(geneve[2:], ethPTEB)
+	binary.BigEndian.PutUint32(geneve[4:], tunnelID<<8)
+	gre := make([]byte, greHdrLen)
+	binary.BigEndian.PutUint16(gre[0:], 0x2000)
+	binary.BigEndian.PutUint16(gre[2:], ethPIPv4)
+	binary.BigEndian.PutUint32(gre[4:], tunnelID)
+	var exp []Expect
+	for _, t := range []struct {
+		typ     flow.TunnelType
+		network string
+		port    int
+		hdr     []byte
+		id      uint32
+	}{
+		{flow.TunnelVXLAN, "udp4", flow.VXLANPort, append(vxlan, ethHeader()...), tunnelID},
+		{flow.TunnelGeneve, "udp4", flow.GenevePort, append(geneve, ethHeader()...), tunnelID},
+		{flow.TunnelGRE, "ip4:47", 0, gre, tunnelID},
+		{flow.TunnelIPIP, "ip4:4", 0, nil, 0},
+	} {
+		laddr, raddr := src.String(), dst.String()
+		var to net.Addr = &net.IPAddr{IP: dst}
+		outer := uint64(ip4HdrLen + len(t.hdr))
+		if t.port != 0 {
+			laddr += ":0"
+			raddr = fmt.Sprintf("%s:%d", raddr, t.port)
+			to = &net.UDPAddr{IP: dst, Port: t.port}
+			outer += udpHdrLen
+		}
+		if err := h.sendTunnel(t.network, laddr, raddr, to,
+			append(append([]byte{}, t.hdr...), inner...)); err != nil {
+			return nil, fmt.Errorf("%s: %w", t.typ, err)
+		}
+		e := newExpect(t.typ.String(), innerSrc, innerDst, innerSport, innerDport, 17,
+			count, udpHdrLen, count*innerSize, false)
+		e.Outer = outer
+		e.Flow4.Tunnel = flow.Tunnel{
+			Type: t.typ,
+			Src:  flow.MapIPv4(src),
+			Dst:  flow.MapIPv4(dst),
+			ID:   t.id,
+		}
+		exp = append(exp, e)
+	}
+	return exp, nil
+}
+
+// sendTunnel sends count times pkt to to from a socket of network
+// bound to laddr and waits for a socket bound to raddr on the
+// receiving side to receive them.
+func (h *Harness) sendTunnel(network, laddr, raddr string, to net.Addr, pkt []byte) error {
+	var srv, c net.PacketConn
+	if err := h.atReceiver(func() (err error) {
+		srv, err = net.ListenPacket(network, raddr)
+		return err
+	}); err != nil {
+		return fmt.Errorf("listen failed: %w", err)
+	}
+	defer srv.Close()
+	if err := h.atSender(func() (err error) {
+		c, err = net.ListenPacket(network, laddr)
+		return err
+	}); err != nil {
+		return fmt.Errorf("listen failed: %w", err)
+	}
+	defer c.Close()
+	for i := 0; i < count; i++ {
+		if _, err := c.WriteTo(pkt, to); err != nil {
+			return fmt.Errorf("write failed: %w", err)
+		}
+	}
+	srv.SetReadDeadline(time.Now().Add(timeout))
+	buf := make([]byte, 1500)
+	for i := 0; i < count; i++ {
+		if _, _, err := srv.ReadFrom(buf); err != nil {
+			return fmt.Errorf("read failed: %w", err)
+		}
+	}
+	return nil
+}
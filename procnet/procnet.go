@@ -0,0 +1,344 @@
+// Package procnet attributes flows to processes from user space, by
+// matching them against the sockets in /proc/net and the files open
+// by processes.
+package procnet
+
+// Copyright 2021 Google LLC
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     https://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chripell/flowsnoop/flow"
+)
+
+// sockKey is a socket as listed in /proc/net, IPv4 addresses are
+// IPv4-mapped. Sockets without a remote end have it zero.
+type sockKey struct {
+	proto  uint8
+	local  [16]byte
+	lport  uint16
+	remote [16]byte
+	rport  uint16
+}
+
+// Enricher is a consumer that fills the process of the flows that
+// have none and passes them to the next consumer. A flow belongs to
+// the process owning the local socket at either of its ends, found
+// in /proc/net/{tcp,tcp6,udp,udp6}; the owner of the socket is found
+// by its inode among the files in /proc/*/fd. Only the sockets of the
+// network namespace of flowsnoop are seen.
+type Enricher struct {
+	next flow.Consumer
+	proc string
+	// sockets are the inodes of the sockets read at this update.
+	sockets map[sockKey]uint64
+	// owners are the processes of the sockets, kept while the
+	// sockets exist.
+	owners map[uint64]flow.Process
+	// scanned tells if /proc/*/fd was scanned at this update.
+	scanned bool
+}
+
+// socketTables are the files listing the sockets, with their
+// protocol.
+var socketTables = []struct {
+	name  string
+	proto uint8
+}{
+	{"tcp", 6},
+	{"tcp6", 6},
+	{"udp", 17},
+	{"udp6", 17},
+}
+
+// parseAddr decodes an address like 0100007F:0035 of /proc/net. The
+// address is printed as 32 bits words in host byte order.
+func parseAddr(s string) (addr [16]byte, port uint16, err error) {
+	i := strings.IndexByte(s, ':')
+	if i < 0 {
+		return addr, 0, fmt.Errorf("bad address %q", s)
+	}
+	b, err := hex.DecodeString(s[:i])
+	if err != nil || (len(b) != 4 && len(b) != 16) {
+		return addr, 0, fmt.Errorf("bad address %q", s)
+	}
+	for w := 0; w < len(b); w += 4 {
+		binary.LittleEndian.PutUint32(b[w:], binary.BigEndian.Uint32(b[w:]))
+	}
+	if len(b) == 4 {
+		addr = flow.MapIPv4(b)
+	} else {
+		copy(addr[:], b)
+	}
+	p, err := strconv.ParseUint(s[i+1:], 16, 16)
+	if err != nil {
+		return addr, 0, fmt.Errorf("bad port in %q", s)
+	}
+	return addr, uint16(p), nil
+}
+
+// readSockets reads the socket tables. Missing tables, like the IPv6
+// ones without IPv6, are skipped.
+func (e *Enricher) readSockets() error {
+	e.sockets = make(map[sockKey]uint64, len(e.sockets))
+	for _, t := range socketTables {
+		f, err := os.Open(filepath.Join(e.proc, "net", t.name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("cannot read sockets: %w", err)
+		}
+		sc := bufio.NewScanner(f)
+		sc.Scan() // header
+		for sc.Scan() {
+			// sl local_address rem_address st tx_queue:rx_queue
+			// tr:tm->when retrnsmt uid timeout inode ...
+			fields := strings.Fields(sc.Text())
+			if len(fields) < 10 {
+				continue
+			}
+			k := sockKey{proto: t.proto}
+			if k.local, k.lport, err = parseAddr(fields[1]); err != nil {
+				continue
+			}
+			if k.remote, k.rport, err = parseAddr(fields[2]); err != nil {
+				continue
+			}
+			inode, err := strconv.ParseUint(fields[9], 10, 64)
+			if err != nil || inode == 0 {
+				continue
+			}
+			e.sockets[k] = inode
+		}
+		err = sc.Err()
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("cannot read sockets: %w", err)
+		}
+	}
+	return nil
+}
+
+// wildcards returns addr and the addresses a socket listening on it
+// can be bound to: the unspecified ones of its family, and :: for
+// IPv4 as dual stack sockets get IPv4 traffic too.
+func wildcards(addr [16]byte) [][16]byte {
+	var any6 [16]byte
+	if addr[10] == 0xff && addr[11] == 0xff {
+		return [][16]byte{addr, flow.MapIPv4([]byte{0, 0, 0, 0}), any6}
+	}
+	return [][16]byte{addr, any6}
+}
+
+// local returns the inode of the socket sending from addr:port to
+// raddr:rport, connected or not.
+func (e *Enricher) local(proto uint8, addr [16]byte, port uint16, raddr [16]byte, rport uint16) uint64 {
+	if inode, ok := e.sockets[sockKey{proto, addr, port, raddr, rport}]; ok {
+		return inode
+	}
+	for _, a := range wildcards(addr) {
+		if inode, ok := e.sockets[sockKey{proto: proto, local: a, lport: port}]; ok {
+			return inode
+		}
+	}
+	return 0
+}
+
+// inode returns the inode of the local socket at either end of a
+// flow, 0 if there is none.
+func (e *Enricher) inode(proto uint8, src [16]byte, sport uint16, dst [16]byte, dport uint16) uint64 {
+	if proto != 6 && proto != 17 {
+		return 0
+	}
+	if inode := e.local(proto, src, sport, dst, dport); inode != 0 {
+		return inode
+	}
+	return e.local(proto, dst, dport, src, sport)
+}
+
+// comm reads the name of process pid.
+func (e *Enricher) comm(pid string) [16]byte {
+	var c [16]byte
+	b, err := ioutil.ReadFile(filepath.Join(e.proc, pid, "comm"))
+	if err == nil {
+		copy(c[:len(c)-1], strings.TrimRight(string(b), "\n"))
+	}
+	return c
+}
+
+// scan finds the owners of the sockets read at this update that have
+// none yet, looking at the files open by every process.
+func (e *Enricher) scan() {
+	e.scanned = true
+	wanted := make(map[uint64]bool)
+	for _, inode := range e.sockets {
+		if _, ok := e.owners[inode]; !ok {
+			wanted[inode] = true
+		}
+	}
+	if len(wanted) == 0 {
+		return
+	}
+	d, err := os.Open(e.proc)
+	if err != nil {
+		return
+	}
+	pids, _ := d.Readdirnames(-1)
+	d.Close()
+	for _, pid := range pids {
+		n, err := strconv.ParseUint(pid, 10, 32)
+		if err != nil {
+			continue
+		}
+		// Processes exit while we look at them, skip them.
+		fdDir := filepath.Join(e.proc, pid, "fd")
+		d, err := os.Open(fdDir)
+		if err != nil {
+			continue
+		}
+		fds, _ := d.Readdirnames(-1)
+		d.Close()
+		var p *flow.Process
+		for _, fd := range fds {
+			l, err := os.Readlink(filepath.Join(fdDir, fd))
+			if err != nil || !strings.HasPrefix(l, "socket:[") {
+				continue
+			}
+			inode, err := strconv.ParseUint(strings.TrimSuffix(l[len("socket:["):], "]"), 10, 64)
+			if err != nil || !wanted[inode] {
+				continue
+			}
+			if p == nil {
+				p = &flow.Process{PID: uint32(n), Comm: e.comm(pid)}
+			}
+			// Sockets shared by several processes, like the ones
+			// of pre-forking servers, go to the first found.
+			e.owners[inode] = *p
+			delete(wanted, inode)
+		}
+		if len(wanted) == 0 {
+			return
+		}
+	}
+}
+
+// process returns the process of a flow, zero if it is unknown.
+func (e *Enricher) process(proto uint8, src [16]byte, sport uint16, dst [16]byte, dport uint16) flow.Process {
+	inode := e.inode(proto, src, sport, dst, dport)
+	if inode == 0 {
+		return flow.Process{}
+	}
+	p, ok := e.owners[inode]
+	if !ok && !e.scanned {
+		e.scan()
+		p = e.owners[inode]
+	}
+	return p
+}
+
+// forget drops the owners of the sockets that are gone.
+func (e *Enricher) forget() {
+	live := make(map[uint64]bool, len(e.sockets))
+	for _, inode := range e.sockets {
+		live[inode] = true
+	}
+	for inode := range e.owners {
+		if !live[inode] {
+			delete(e.owners, inode)
+		}
+	}
+}
+
+func (e *Enricher) Init() error {
+	return e.next.Init()
+}
+
+func (e *Enricher) Push(tick time.Time,
+	flowsL4 flow.List4, flowsM4 flow.Map4,
+	flowsL6 flow.List6, flowsM6 flow.Map6,
+	stats flow.Stats) error {
+	if err := e.readSockets(); err != nil {
+		return err
+	}
+	e.scanned = false
+	for i := range flowsL4 {
+		fl := &flowsL4[i].Flow
+		if fl.Process.IsZero() {
+			fl.Process = e.process(fl.Proto, flow.MapIPv4(fl.SrcIP[:]), fl.SrcPort,
+				flow.MapIPv4(fl.DstIP[:]), fl.DstPort)
+		}
+	}
+	if flowsM4 != nil {
+		m := make(flow.Map4, len(flowsM4))
+		for fl, tot := range flowsM4 {
+			if fl.Process.IsZero() {
+				fl.Process = e.process(fl.Proto, flow.MapIPv4(fl.SrcIP[:]), fl.SrcPort,
+					flow.MapIPv4(fl.DstIP[:]), fl.DstPort)
+			}
+			m[fl] += tot
+		}
+		flowsM4 = m
+	}
+	for i := range flowsL6 {
+		fl := &flowsL6[i].Flow
+		if fl.Process.IsZero() {
+			fl.Process = e.process(fl.Proto, fl.SrcIP, fl.SrcPort, fl.DstIP, fl.DstPort)
+		}
+	}
+	if flowsM6 != nil {
+		m := make(flow.Map6, len(flowsM6))
+		for fl, tot := range flowsM6 {
+			if fl.Process.IsZero() {
+				fl.Process = e.process(fl.Proto, fl.SrcIP, fl.SrcPort, fl.DstIP, fl.DstPort)
+			}
+			m[fl] += tot
+		}
+		flowsM6 = m
+	}
+	e.forget()
+	return e.next.Push(tick, flowsL4, flowsM4, flowsL6, flowsM6, stats)
+}
+
+// PushConnections passes the connections to the next consumer, if it
+// wants them. Their processes come from the producer.
+func (e *Enricher) PushConnections(tick time.Time, conns []flow.Connection) error {
+	if cc, ok := e.next.(flow.ConnectionConsumer); ok {
+		return cc.PushConnections(tick, conns)
+	}
+	return nil
+}
+
+func (e *Enricher) Finalize() error {
+	return e.next.Finalize()
+}
+
+// New returns an enricher passing the flows to next.
+func New(next flow.Consumer) *Enricher {
+	return &Enricher{
+		next:   next,
+		proc:   "/proc",
+		owners: make(map[uint64]flow.Process),
+	}
+}
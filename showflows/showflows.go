@@ -49,7 +49,7 @@ func (sh *ShowFlows) Init() error {
 }
 
 func (sh *ShowFlows) appendFlow(srcIP []byte, srcPort uint16, dstIP []byte, dstPort uint16,
-	proto uint8, tot uint64) {
+	proto uint8, vlan uint16, tun flow.Tunnel, proc flow.Process, q flow.Quality, tot uint64) {
 	srcAddr := net.TCPAddr{
 		IP:   net.IP(srcIP),
 		Port: int(srcPort),
@@ -58,33 +58,72 @@ func (sh *ShowFlows) appendFlow(srcIP []byte, srcPort uint16, dstIP []byte, dstP
 		IP:   net.IP(dstIP),
 		Port: int(dstPort),
 	}
+	from, to := srcAddr.String(), dstAddr.String()
+	p := flow.NewProto(proto).String()
+	if flow.IsICMP(proto) {
+		from, to = net.IP(srcIP).String(), net.IP(dstIP).String()
+		p += " " + flow.ICMPName(proto, dstPort)
+	}
+	if vlan != 0 {
+		p += fmt.Sprintf(" vlan %d", vlan)
+	}
+	if tun.Type != flow.TunnelNone {
+		p += " in " + tun.String()
+	}
+	if !proc.IsZero() {
+		p += " by " + proc.String()
+	}
+	if !q.IsZero() {
+		p += ", " + q.String()
+	}
 	sh.flows = append(sh.flows, sflow{
-		from:  srcAddr.String(),
-		to:    dstAddr.String(),
-		proto: flow.NewProto(proto).String(),
+		from:  from,
+		to:    to,
+		proto: p,
+		n:     tot,
+	})
+}
+
+func (sh *ShowFlows) appendOther(tot uint64) {
+	sh.flows = append(sh.flows, sflow{
+		from:  "other",
+		to:    "other",
+		proto: "ANY",
 		n:     tot,
 	})
 }
 
 func (sh *ShowFlows) Push(tick time.Time,
 	flowsL4 flow.List4, flowsM4 flow.Map4,
-	flowsL6 flow.List6, flowsM6 flow.Map6) error {
+	flowsL6 flow.List6, flowsM6 flow.Map6,
+	stats flow.Stats) error {
 	fmt.Print(sh.header)
+	if stats.SampleRate > 1 {
+		fmt.Printf("estimated from 1 in %d packets\n", stats.SampleRate)
+	}
 	for _, fl := range flowsL4 {
+		if fl.Flow.IsOther() {
+			sh.appendOther(fl.Tot)
+			continue
+		}
 		sh.appendFlow(fl.Flow.SrcIP[:], fl.Flow.SrcPort,
-			fl.Flow.DstIP[:], fl.Flow.DstPort, fl.Flow.Proto, fl.Tot)
+			fl.Flow.DstIP[:], fl.Flow.DstPort, fl.Flow.Proto, fl.Flow.VLAN, fl.Flow.Tunnel, fl.Flow.Process, fl.Quality, fl.Tot)
 	}
 	for fl, tot := range flowsM4 {
 		sh.appendFlow(fl.SrcIP[:], fl.SrcPort,
-			fl.DstIP[:], fl.DstPort, fl.Proto, tot)
+			fl.DstIP[:], fl.DstPort, fl.Proto, fl.VLAN, fl.Tunnel, fl.Process, flow.Quality{}, tot)
 	}
 	for _, fl := range flowsL6 {
+		if fl.Flow.IsOther() {
+			sh.appendOther(fl.Tot)
+			continue
+		}
 		sh.appendFlow(fl.Flow.SrcIP[:], fl.Flow.SrcPort,
-			fl.Flow.DstIP[:], fl.Flow.DstPort, fl.Flow.Proto, fl.Tot)
+			fl.Flow.DstIP[:], fl.Flow.DstPort, fl.Flow.Proto, fl.Flow.VLAN, fl.Flow.Tunnel, fl.Flow.Process, fl.Quality, fl.Tot)
 	}
 	for fl, tot := range flowsM6 {
 		sh.appendFlow(fl.SrcIP[:], fl.SrcPort,
-			fl.DstIP[:], fl.DstPort, fl.Proto, tot)
+			fl.DstIP[:], fl.DstPort, fl.Proto, fl.VLAN, fl.Tunnel, fl.Process, flow.Quality{}, tot)
 	}
 	sort.Slice(sh.flows, func(i, j int) bool {
 		return sh.flows[i].n > sh.flows[j].n
@@ -92,10 +131,36 @@ func (sh *ShowFlows) Push(tick time.Time,
 	for _, fl := range sh.flows {
 		fmt.Printf("%s -> %s, %s: %d\n", fl.from, fl.to, fl.proto, fl.n)
 	}
+	if o := stats.Overflow(); o.Packets > 0 {
+		fmt.Printf("overflow: %d flows, %d packets, %d bytes\n",
+			o.Flows, o.Packets, o.Bytes)
+	}
+	if stats.UnmatchedFrags > 0 {
+		fmt.Printf("unmatched fragments: %d\n", stats.UnmatchedFrags)
+	}
 	sh.flows = sh.flows[:0]
 	return nil
 }
 
+func (sh *ShowFlows) PushConnections(tick time.Time, conns []flow.Connection) error {
+	for _, c := range conns {
+		src := net.TCPAddr{IP: net.IP(c.Src[:]), Port: int(c.SrcPort)}
+		dst := net.TCPAddr{IP: net.IP(c.Dst[:]), Port: int(c.DstPort)}
+		line := fmt.Sprintf("%s %s %s -> %s", c.Time.Format("15:04:05.000"), c.Event, src.String(), dst.String())
+		if !c.Process.IsZero() {
+			line += " by " + c.Process.String()
+		}
+		if c.Event == flow.ConnClose {
+			if c.Duration > 0 {
+				line += fmt.Sprintf(" after %s", c.Duration.Round(time.Millisecond))
+			}
+			line += fmt.Sprintf(": sent %d received %d (%s)", c.BytesAcked, c.BytesReceived, c.Reason)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
 func (sh *ShowFlows) Finalize() error {
 	return nil
 }
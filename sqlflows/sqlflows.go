@@ -52,6 +52,22 @@ func pip(ip []byte) string {
 	return net.IP(ip).String()
 }
 
+// Columns added after the first version of the table, with their
+// definition. Older tables get them on Init.
+var addedColumns = []struct{ name, def string }{
+	{"vlan", "INTEGER DEFAULT 0"},
+	{"tunnel", "TEXT DEFAULT ''"},
+	{"tunnel_src", "TEXT DEFAULT ''"},
+	{"tunnel_dst", "TEXT DEFAULT ''"},
+	{"tunnel_id", "INTEGER DEFAULT 0"},
+	{"sample_rate", "INTEGER DEFAULT 1"},
+	{"pid", "INTEGER DEFAULT 0"},
+	{"comm", "TEXT DEFAULT ''"},
+	{"cgroup", "INTEGER DEFAULT 0"},
+	{"retrans", "INTEGER DEFAULT 0"},
+	{"srtt", "FLOAT DEFAULT 0"},
+}
+
 func (sf *SqlFlows) Init() (err error) {
 	sf.db, err = sql.Open("sqlite3", *sqlDB)
 	if err != nil {
@@ -68,17 +84,110 @@ src_ip TEXT,
 src_port INTEGER,
 dst_ip TEXT,
 dst_port INTEGER,
-bytes_sec FLOAT);
+bytes_sec FLOAT,
+vlan INTEGER DEFAULT 0,
+tunnel TEXT DEFAULT '',
+tunnel_src TEXT DEFAULT '',
+tunnel_dst TEXT DEFAULT '',
+tunnel_id INTEGER DEFAULT 0,
+sample_rate INTEGER DEFAULT 1,
+pid INTEGER DEFAULT 0,
+comm TEXT DEFAULT '',
+cgroup INTEGER DEFAULT 0,
+retrans INTEGER DEFAULT 0,
+srtt FLOAT DEFAULT 0);
 `)
 	if err != nil {
 		return fmt.Errorf("create or insert failed: %w", err)
 	}
+	for _, c := range addedColumns {
+		has, err := sf.hasColumn("flows", c.name)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+		if _, err = sf.db.Exec("ALTER TABLE flows ADD COLUMN " + c.name + " " + c.def); err != nil {
+			return fmt.Errorf("cannot add %s column: %w", c.name, err)
+		}
+	}
+	_, err = sf.db.Exec(`
+CREATE TABLE IF NOT EXISTS connections (
+jd FLOAT,
+event TEXT,
+src_ip TEXT,
+src_port INTEGER,
+dst_ip TEXT,
+dst_port INTEGER,
+duration FLOAT,
+bytes_acked INTEGER,
+bytes_received INTEGER,
+reason TEXT,
+pid INTEGER,
+comm TEXT,
+cgroup INTEGER);
+`)
+	if err != nil {
+		return fmt.Errorf("create connections failed: %w", err)
+	}
+	return nil
+}
+
+func (sf *SqlFlows) hasColumn(table, column string) (bool, error) {
+	rows, err := sf.db.Query("SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return false, fmt.Errorf("cannot read columns of %s: %w", table, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return false, fmt.Errorf("cannot read columns of %s: %w", table, err)
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
+
+// insert adds a flow to the table with stmt, proto is above 255 for
+// IPv6. The tunnel columns are empty for flows not in a tunnel, the
+// process ones for flows without a process. The smoothed RTT is in
+// seconds.
+func insert(stmt *sql.Stmt, jd float64, srcIP []byte, srcPort uint16,
+	dstIP []byte, dstPort uint16, proto uint16, vlan uint16,
+	tun flow.Tunnel, proc flow.Process, q flow.Quality, sampleRate uint32, rate float64) error {
+	var tunType, tunSrc, tunDst string
+	if tun.Type != flow.TunnelNone {
+		tunType, tunSrc, tunDst = tun.Type.String(), pip(tun.Src[:]), pip(tun.Dst[:])
+	}
+	_, err := stmt.Exec(jd, pip(srcIP), srcPort, pip(dstIP), dstPort, proto, vlan,
+		tunType, tunSrc, tunDst, tun.ID, proc.PID, proc.Name(), proc.Cgroup,
+		q.Retrans, q.SRTT.Seconds(), sampleRate, rate)
+	if err != nil {
+		return fmt.Errorf("exec failed: %w", err)
+	}
+	return nil
+}
+
+// insertOther adds the flow collecting the overflow of the eBPF maps,
+// with "other" as addresses like showflows. proto is 0 for IPv4 and
+// 256 for IPv6.
+func insertOther(stmt *sql.Stmt, jd float64, proto uint16, sampleRate uint32, rate float64) error {
+	_, err := stmt.Exec(jd, "other", 0, "other", 0, proto, 0,
+		"", "", "", 0, 0, "", 0, 0, 0.0, sampleRate, rate)
+	if err != nil {
+		return fmt.Errorf("exec failed: %w", err)
+	}
 	return nil
 }
 
 func (sf *SqlFlows) Push(tick time.Time,
 	flowsL4 flow.List4, flowsM4 flow.Map4,
-	flowsL6 flow.List6, flowsM6 flow.Map6) error {
+	flowsL6 flow.List6, flowsM6 flow.Map6,
+	stats flow.Stats) error {
 	if sf.prevTick.IsZero() {
 		sf.prevTick = tick
 		return nil
@@ -86,39 +195,88 @@ func (sf *SqlFlows) Push(tick time.Time,
 	delta := float64(tick.Sub(sf.prevTick)) / 1_000_000_000.0
 	sf.prevTick = tick
 	jd := julian(tick)
+	// Rows of flows estimated from sampled packets record the rate.
+	sampleRate := stats.SampleRate
+	if sampleRate == 0 {
+		sampleRate = 1
+	}
 	tx, err := sf.db.Begin()
 	if err != nil {
 		return fmt.Errorf("begin transaction failed: %w", err)
 	}
-	stmt, err := tx.Prepare("insert into flows(jd, src_ip, src_port, dst_ip, dst_port, proto, bytes_sec) values(?, ?, ?, ?, ?, ?, ?)")
+	stmt, err := tx.Prepare("insert into flows(jd, src_ip, src_port, dst_ip, dst_port, proto, vlan, tunnel, tunnel_src, tunnel_dst, tunnel_id, pid, comm, cgroup, retrans, srtt, sample_rate, bytes_sec) values(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
 	if err != nil {
 		return fmt.Errorf("prepare failed: %w", err)
 	}
 	defer stmt.Close()
 	for _, fl := range flowsL4 {
-		_, err = stmt.Exec(jd, pip(fl.Flow.SrcIP[:]), fl.Flow.SrcPort,
-			pip(fl.Flow.DstIP[:]), fl.Flow.DstPort, fl.Flow.Proto, float64(fl.Tot)/delta)
-		if err != nil {
-			return fmt.Errorf("exec failed: %w", err)
+		if fl.Flow.IsOther() {
+			if err := insertOther(stmt, jd, 0, sampleRate, float64(fl.Tot)/delta); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := insert(stmt, jd, fl.Flow.SrcIP[:], fl.Flow.SrcPort,
+			fl.Flow.DstIP[:], fl.Flow.DstPort, uint16(fl.Flow.Proto), fl.Flow.VLAN,
+			fl.Flow.Tunnel, fl.Flow.Process, fl.Quality, sampleRate, float64(fl.Tot)/delta); err != nil {
+			return err
 		}
 	}
 	for fl, tot := range flowsM4 {
-		_, err = stmt.Exec(jd, pip(fl.SrcIP[:]), fl.SrcPort,
-			pip(fl.DstIP[:]), fl.DstPort, fl.Proto, float64(tot)/delta)
-		if err != nil {
-			return fmt.Errorf("exec failed: %w", err)
+		if err := insert(stmt, jd, fl.SrcIP[:], fl.SrcPort,
+			fl.DstIP[:], fl.DstPort, uint16(fl.Proto), fl.VLAN,
+			fl.Tunnel, fl.Process, flow.Quality{}, sampleRate, float64(tot)/delta); err != nil {
+			return err
 		}
 	}
 	for _, fl := range flowsL6 {
-		_, err = stmt.Exec(jd, pip(fl.Flow.SrcIP[:]), fl.Flow.SrcPort,
-			pip(fl.Flow.DstIP[:]), fl.Flow.DstPort, uint16(fl.Flow.Proto)+256, float64(fl.Tot)/delta)
-		if err != nil {
-			return fmt.Errorf("exec failed: %w", err)
+		if fl.Flow.IsOther() {
+			if err := insertOther(stmt, jd, 256, sampleRate, float64(fl.Tot)/delta); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := insert(stmt, jd, fl.Flow.SrcIP[:], fl.Flow.SrcPort,
+			fl.Flow.DstIP[:], fl.Flow.DstPort, uint16(fl.Flow.Proto)+256, fl.Flow.VLAN,
+			fl.Flow.Tunnel, fl.Flow.Process, fl.Quality, sampleRate, float64(fl.Tot)/delta); err != nil {
+			return err
 		}
 	}
 	for fl, tot := range flowsM6 {
-		_, err = stmt.Exec(jd, pip(fl.SrcIP[:]), fl.SrcPort,
-			pip(fl.DstIP[:]), fl.DstPort, uint16(fl.Proto)+256, float64(tot)/delta)
+		if err := insert(stmt, jd, fl.SrcIP[:], fl.SrcPort,
+			fl.DstIP[:], fl.DstPort, uint16(fl.Proto)+256, fl.VLAN,
+			fl.Tunnel, fl.Process, flow.Quality{}, sampleRate, float64(tot)/delta); err != nil {
+			return err
+		}
+	}
+	tx.Commit()
+	return nil
+}
+
+// PushConnections adds the connections to their table, at the time
+// they were seen. The duration is in seconds, the reason is empty for
+// open events.
+func (sf *SqlFlows) PushConnections(tick time.Time, conns []flow.Connection) error {
+	if len(conns) == 0 {
+		return nil
+	}
+	tx, err := sf.db.Begin()
+	if err != nil {
+		return fmt.Errorf("begin transaction failed: %w", err)
+	}
+	stmt, err := tx.Prepare("insert into connections(jd, event, src_ip, src_port, dst_ip, dst_port, duration, bytes_acked, bytes_received, reason, pid, comm, cgroup) values(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return fmt.Errorf("prepare failed: %w", err)
+	}
+	defer stmt.Close()
+	for _, c := range conns {
+		var reason string
+		if c.Event == flow.ConnClose {
+			reason = c.Reason.String()
+		}
+		_, err := stmt.Exec(julian(c.Time), c.Event.String(), pip(c.Src[:]), c.SrcPort,
+			pip(c.Dst[:]), c.DstPort, c.Duration.Seconds(), c.BytesAcked, c.BytesReceived,
+			reason, c.Process.PID, c.Process.Name(), c.Process.Cgroup)
 		if err != nil {
 			return fmt.Errorf("exec failed: %w", err)
 		}
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/chripell/flowsnoop/cgroups"
 	"github.com/chripell/flowsnoop/flow"
 	humanize "github.com/dustin/go-humanize"
 )
@@ -32,6 +33,9 @@ type site struct {
 	from     uint64
 	to       uint64
 	last     int64
+	// quality is the TCP quality of the flows with the site, when
+	// ranking by quality.
+	quality flow.Quality
 }
 
 type keyIP [16]byte
@@ -48,6 +52,12 @@ type TopSites struct {
 	header string
 	m      map[keyIP]*site
 	l      []*site
+	// Used instead of m when ranking by service.
+	resolver *cgroups.Resolver
+	services map[string]*site
+	// Addresses of the host, set when ranking by quality to tell the
+	// remote end of flows.
+	local map[keyIP]bool
 }
 
 var (
@@ -56,6 +66,9 @@ var (
 	resolve = flag.Int("topsites_resolve", 5, "concurrent DNS resolutions. If 0, don't resolve IPs. ")
 	topn    = flag.Int("topsites_n", 20, "Number of sites to show. ")
 	pretty  = flag.Bool("topsites_pretty", true, "Pretty print numbers.")
+	by      = flag.String("topsites_by", "ip", "Rank sites by ip, by service: systemd unit, container or pod "+
+		"of the process of the flows, or by quality: TCP retransmits and RTT (service and quality need the "+
+		"sock producer).")
 )
 
 func (ts *TopSites) Init() error {
@@ -63,15 +76,159 @@ func (ts *TopSites) Init() error {
 	ts.header = strings.Replace(ts.header, `\f`,
 		"\033[H\033[2J", -1)
 	ts.m = make(map[keyIP]*site)
+	switch *by {
+	case "ip":
+	case "service":
+		ts.resolver = cgroups.New()
+		ts.services = make(map[string]*site)
+	case "quality":
+		addrs, err := net.InterfaceAddrs()
+		if err != nil {
+			return fmt.Errorf("cannot read local addresses: %w", err)
+		}
+		ts.local = make(map[keyIP]bool)
+		for _, a := range addrs {
+			if n, ok := a.(*net.IPNet); ok {
+				var kip keyIP
+				copy(kip[:], n.IP.To16())
+				ts.local[kip] = true
+			}
+		}
+	default:
+		return fmt.Errorf("unknown ranking: %s", *by)
+	}
 	return nil
 }
 
+// addService accounts tot bytes sent or received by proc to its
+// service. Flows without a process go to "unknown".
+func (ts *TopSites) addService(proc flow.Process, tot uint64, now int64) {
+	name := "unknown"
+	if !proc.IsZero() {
+		if svc, ok := ts.resolver.Resolve(proc.Cgroup, proc.PID); ok {
+			name = svc.String()
+		} else {
+			name = "process " + proc.Name()
+		}
+	}
+	if s := ts.services[name]; s != nil {
+		s.from += tot
+		s.last = now
+	} else {
+		ts.services[name] = &site{
+			resolved: name,
+			from:     tot,
+			last:     now,
+		}
+	}
+}
+
+// pushServices ranks by service the flows of an update.
+func (ts *TopSites) pushServices(flowsL4 flow.List4, flowsM4 flow.Map4,
+	flowsL6 flow.List6, flowsM6 flow.Map6) {
+	now := time.Now().Unix()
+	for _, fl := range flowsL4 {
+		if fl.Flow.IsOther() {
+			continue
+		}
+		ts.addService(fl.Flow.Process, fl.Tot, now)
+	}
+	for fl, tot := range flowsM4 {
+		ts.addService(fl.Process, tot, now)
+	}
+	for _, fl := range flowsL6 {
+		if fl.Flow.IsOther() {
+			continue
+		}
+		ts.addService(fl.Flow.Process, fl.Tot, now)
+	}
+	for fl, tot := range flowsM6 {
+		ts.addService(fl.Process, tot, now)
+	}
+	for _, si := range ts.services {
+		ts.l = append(ts.l, si)
+	}
+	sort.Slice(ts.l, func(i, j int) bool {
+		return ts.l[i].from > ts.l[j].from
+	})
+	l := len(ts.l)
+	if l > *topn {
+		l = *topn
+	}
+	for _, si := range ts.l[:l] {
+		if *pretty {
+			fmt.Printf("%s: %s\n", si.resolved, humanize.Bytes(si.from))
+		} else {
+			fmt.Printf("%s: %d\n", si.resolved, si.from)
+		}
+	}
+	ts.l = ts.l[:0]
+}
+
+// addQuality accounts q to the remote end of a flow from src to dst:
+// the one that is not an address of the host, dst if both or none
+// are.
+func (ts *TopSites) addQuality(src, dst keyIP, q flow.Quality) {
+	if q.IsZero() {
+		return
+	}
+	remote := dst
+	if ts.local[dst] && !ts.local[src] {
+		remote = src
+	}
+	ts.m[remote].quality.Add(q)
+}
+
+// printQuality shows the sites with the most retransmits in the last
+// interval, then with the longest RTT. Sites without TCP quality in
+// the interval are skipped. The quality is reset for the next one.
+func (ts *TopSites) printQuality() {
+	for _, si := range ts.m {
+		if !si.quality.IsZero() {
+			ts.l = append(ts.l, si)
+		}
+	}
+	sort.Slice(ts.l, func(i, j int) bool {
+		qi, qj := ts.l[i].quality, ts.l[j].quality
+		if qi.Retrans != qj.Retrans {
+			return qi.Retrans > qj.Retrans
+		}
+		return qi.SRTT > qj.SRTT
+	})
+	l := len(ts.l)
+	if l > *topn {
+		l = *topn
+	}
+	for _, si := range ts.l[:l] {
+		if *pretty {
+			fmt.Printf("%s: %s, from %s to %s\n", si.resolved, si.quality,
+				humanize.Bytes(si.from), humanize.Bytes(si.to))
+		} else {
+			fmt.Printf("%s: %s, from %d to %d\n", si.resolved, si.quality, si.from, si.to)
+		}
+	}
+	ts.l = ts.l[:0]
+	for _, si := range ts.m {
+		si.quality = flow.Quality{}
+	}
+}
+
 func (ts *TopSites) Push(tick time.Time,
 	flowsL4 flow.List4, flowsM4 flow.Map4,
-	flowsL6 flow.List6, flowsM6 flow.Map6) error {
+	flowsL6 flow.List6, flowsM6 flow.Map6,
+	stats flow.Stats) error {
 	fmt.Print(ts.header)
+	if ts.services != nil {
+		ts.pushServices(flowsL4, flowsM4, flowsL6, flowsM6)
+		return nil
+	}
 	now := time.Now().Unix()
+	// The flow collecting the overflow of the eBPF maps is from no
+	// site: it is skipped.
 	for _, fl := range flowsL4 {
+		if fl.Flow.IsOther() {
+			continue
+		}
 		fkip := newKIP4(fl.Flow.SrcIP[:])
 		if s := ts.m[fkip]; s != nil {
 			s.from += fl.Tot
@@ -92,6 +249,9 @@ func (ts *TopSites) Push(tick time.Time,
 				last: now,
 			}
 		}
+		if ts.local != nil {
+			ts.addQuality(fkip, tkip, fl.Quality)
+		}
 	}
 	for fl, tot := range flowsM4 {
 		fkip := newKIP4(fl.SrcIP[:])
@@ -116,6 +276,9 @@ func (ts *TopSites) Push(tick time.Time,
 		}
 	}
 	for _, fl := range flowsL6 {
+		if fl.Flow.IsOther() {
+			continue
+		}
 		if s := ts.m[fl.Flow.SrcIP]; s != nil {
 			s.from += fl.Tot
 			s.last = now
@@ -134,6 +297,9 @@ func (ts *TopSites) Push(tick time.Time,
 				last: now,
 			}
 		}
+		if ts.local != nil {
+			ts.addQuality(fl.Flow.SrcIP, fl.Flow.DstIP, fl.Quality)
+		}
 	}
 	for fl, tot := range flowsM6 {
 		if s := ts.m[fl.SrcIP]; s != nil {
@@ -188,6 +354,10 @@ func (ts *TopSites) Push(tick time.Time,
 		}
 		wg.Wait()
 	}
+	if ts.local != nil {
+		ts.printQuality()
+		return nil
+	}
 	for _, si := range ts.m {
 		ts.l = append(ts.l, si)
 	}